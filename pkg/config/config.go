@@ -74,6 +74,19 @@ type JWTConfig struct {
 	RefreshTokenExpiry  string `mapstructure:"refresh_token_expiry"`
 	Issuer              string `mapstructure:"issuer"`
 	Audience            string `mapstructure:"audience"`
+	// SigningKeys sign access tokens with RS256 or EdDSA in place of
+	// access_token_secret, so verifying services only need the public keys
+	SigningKeys         []SigningKeyConfig `mapstructure:"signing_keys"`
+}
+
+// SigningKeyConfig holds an access token signing key. The key with the latest
+// active_from that has passed signs; all listed keys are published for
+// verification, so a key is rotated in by listing it ahead of its active_from
+// and retired by removing it once the tokens it signed have expired.
+type SigningKeyConfig struct {
+	ID             string `mapstructure:"kid"`
+	PrivateKeyFile string `mapstructure:"private_key_file"` // PEM encoded RSA (2048+ bits) or Ed25519 key
+	ActiveFrom     string `mapstructure:"active_from"`      // RFC 3339 time, empty means immediately
 }
 
 // LogConfig holds logging configuration
@@ -141,6 +154,12 @@ type Config interface {
 	Validate() error
 }
 
+// Defaulter can be implemented by service-specific configurations
+// to register defaults on top of the common ones
+type Defaulter interface {
+	SetDefaults()
+}
+
 // LoadConfig loads configuration from file and environment variables
 func LoadConfig[T Config](configPath, serviceName string) (T, error) {
 	var config T
@@ -163,6 +182,9 @@ func LoadConfig[T Config](configPath, serviceName string) (T, error) {
 
 	// Set defaults
 	setDefaults()
+	if d, ok := any(config).(Defaulter); ok {
+		d.SetDefaults()
+	}
 
 	// Read config file
 	if err := viper.ReadInConfig(); err != nil {
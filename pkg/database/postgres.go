@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// DriverName is the database/sql driver used for PostgreSQL
+const DriverName = "postgres"
+
+// NewPostgres opens a PostgreSQL connection pool and verifies connectivity
+func NewPostgres(ctx context.Context, cfg config.DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open(DriverName, cfg.GetDSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// Pool settings
+	if cfg.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.MaxLifetime != "" {
+		lifetime, err := time.ParseDuration(cfg.MaxLifetime)
+		if err != nil {
+			db.Close()
+			return nil, fmt.Errorf("invalid max_lifetime: %w", err)
+		}
+		db.SetConnMaxLifetime(lifetime)
+	}
+
+	// Verify connection
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(pingCtx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return db, nil
+}
+
+// WithTx runs fn in a transaction, committing if it returns nil and rolling back otherwise
+func WithTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// IsUniqueViolation reports whether err is a PostgreSQL unique constraint violation
+func IsUniqueViolation(err error) bool {
+	return pgErrorCode(err) == "23505"
+}
+
+// UniqueViolationConstraint returns the violated constraint name, or empty string
+func UniqueViolationConstraint(err error) string {
+	if pgErr := asPgError(err); pgErr != nil && pgErr.Code == "23505" {
+		return pgErr.Constraint
+	}
+	return ""
+}
+
+func pgErrorCode(err error) pq.ErrorCode {
+	if pgErr := asPgError(err); pgErr != nil {
+		return pgErr.Code
+	}
+	return ""
+}
+
+func asPgError(err error) *pq.Error {
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) {
+		return pgErr
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// NewRedis creates a Redis client from config and verifies connectivity
+func NewRedis(ctx context.Context, cfg config.RedisConfig) (*redis.Client, error) {
+	opts := &redis.Options{
+		Addr:       cfg.GetRedisAddr(),
+		Password:   cfg.Password,
+		DB:         cfg.DB,
+		MaxRetries: cfg.MaxRetries,
+		PoolSize:   cfg.PoolSize,
+	}
+
+	var err error
+	if opts.DialTimeout, err = parseRedisTimeout("dial_timeout", cfg.DialTimeout); err != nil {
+		return nil, err
+	}
+	if opts.ReadTimeout, err = parseRedisTimeout("read_timeout", cfg.ReadTimeout); err != nil {
+		return nil, err
+	}
+	if opts.WriteTimeout, err = parseRedisTimeout("write_timeout", cfg.WriteTimeout); err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(opts)
+
+	// Verify connection
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to ping redis: %w", err)
+	}
+
+	return client, nil
+}
+
+// parseRedisTimeout parses a timeout, zero means the client default
+func parseRedisTimeout(name, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid redis.%s: %w", name, err)
+	}
+	return d, nil
+}
@@ -20,12 +20,16 @@ const (
 	CodeForbidden        = "FORBIDDEN"
 	CodeRateLimit        = "RATE_LIMITED"
 	CodeTimeout          = "TIMEOUT"
+	CodeConflict         = "CONFLICT"
 	
 	// URL service specific
 	CodeInvalidURL       = "INVALID_URL"
 	CodeURLNotAccessible = "URL_NOT_ACCESSIBLE"
 	CodeCustomCodeTaken  = "CUSTOM_CODE_TAKEN"
 	CodeURLExpired       = "URL_EXPIRED"
+	CodeURLNotYetActive  = "URL_NOT_YET_ACTIVE"
+	CodeDomainNotVerified = "DOMAIN_NOT_VERIFIED"
+	CodeURLBlocked       = "URL_BLOCKED"
 	CodePasswordRequired = "PASSWORD_REQUIRED"
 	CodeInvalidPassword  = "INVALID_PASSWORD"
 	
@@ -38,6 +42,8 @@ const (
 	CodeExpiredToken     = "EXPIRED_TOKEN"
 	CodeInvalidAPIKey    = "INVALID_API_KEY"
 	CodeAPIKeyExpired    = "API_KEY_EXPIRED"
+	CodeTwoFactorRequired    = "TWO_FACTOR_REQUIRED"
+	CodeInvalidTwoFactorCode = "INVALID_TWO_FACTOR_CODE"
 	
 	// Analytics service specific
 	CodeInvalidDateRange = "INVALID_DATE_RANGE"
@@ -185,9 +191,10 @@ func (e *AppError) HTTPStatus() int {
 		return http.StatusBadRequest
 	case CodeNotFound:
 		return http.StatusNotFound
-	case CodeAlreadyExists:
+	case CodeAlreadyExists, CodeConflict:
 		return http.StatusConflict
-	case CodeUnauthorized, CodeInvalidCredentials, CodeInvalidToken, CodeExpiredToken:
+	case CodeUnauthorized, CodeInvalidCredentials, CodeInvalidToken, CodeExpiredToken,
+		CodeTwoFactorRequired, CodeInvalidTwoFactorCode:
 		return http.StatusUnauthorized
 	case CodeForbidden:
 		return http.StatusForbidden
@@ -197,11 +204,11 @@ func (e *AppError) HTTPStatus() int {
 		return http.StatusRequestTimeout
 	case CodeInvalidURL, CodeCustomCodeTaken, CodePasswordRequired, CodeInvalidPassword:
 		return http.StatusBadRequest
-	case CodeURLNotAccessible, CodeURLExpired:
+	case CodeURLNotAccessible, CodeURLExpired, CodeURLNotYetActive, CodeDomainNotVerified:
 		return http.StatusUnprocessableEntity
 	case CodeEmailTaken, CodeUsernameTaken:
 		return http.StatusConflict
-	case CodeEmailNotVerified:
+	case CodeEmailNotVerified, CodeURLBlocked:
 		return http.StatusForbidden
 	case CodeInvalidAPIKey, CodeAPIKeyExpired:
 		return http.StatusUnauthorized
@@ -225,15 +232,18 @@ func (e *AppError) GRPCStatus() *status.Status {
 		code = codes.NotFound
 	case CodeAlreadyExists, CodeEmailTaken, CodeUsernameTaken:
 		code = codes.AlreadyExists
-	case CodeUnauthorized, CodeInvalidCredentials, CodeInvalidToken, CodeExpiredToken, CodeInvalidAPIKey, CodeAPIKeyExpired:
+	case CodeUnauthorized, CodeInvalidCredentials, CodeInvalidToken, CodeExpiredToken, CodeInvalidAPIKey, CodeAPIKeyExpired,
+		CodeTwoFactorRequired, CodeInvalidTwoFactorCode:
 		code = codes.Unauthenticated
-	case CodeForbidden, CodeEmailNotVerified:
+	case CodeForbidden, CodeEmailNotVerified, CodeURLBlocked:
 		code = codes.PermissionDenied
 	case CodeRateLimit:
 		code = codes.ResourceExhausted
 	case CodeTimeout:
 		code = codes.DeadlineExceeded
-	case CodeURLNotAccessible:
+	case CodeConflict:
+		code = codes.Aborted
+	case CodeURLNotAccessible, CodeURLNotYetActive, CodeDomainNotVerified:
 		code = codes.FailedPrecondition
 	case CodeURLExpired:
 		code = codes.NotFound
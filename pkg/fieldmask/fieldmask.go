@@ -0,0 +1,68 @@
+// Package fieldmask applies google.protobuf.FieldMask style partial updates to
+// domain entities, so "leave unchanged" and "clear" can be told apart
+package fieldmask
+
+import (
+	"strings"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+)
+
+// Immutable paths are rejected for every entity
+var Immutable = []string{"id", "user_id", "created_at"}
+
+// Setters maps update paths to functions copying that field from the request
+// onto the entity. A path is updatable only if it has a setter.
+type Setters[T any] map[string]func(dst *T) error
+
+// Validate normalizes paths and checks each against setters. Duplicates are
+// dropped; immutable and unknown paths fail with a validation error whose
+// field is the offending path.
+func (s Setters[T]) Validate(paths []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(paths))
+	out := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			return nil, apperrors.Validation("update mask contains an empty path").WithField("update_mask")
+		}
+		if isImmutable(path) {
+			return nil, apperrors.Validationf("%s cannot be updated", path).WithField(path)
+		}
+		if _, ok := s[path]; !ok {
+			return nil, apperrors.Validationf("unknown field %q in update mask", path).WithField(path)
+		}
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		out = append(out, path)
+	}
+	return out, nil
+}
+
+// Apply validates paths and runs the setter of each on dst in order. Nothing is
+// applied when validation fails; a setter error stops the update and may leave
+// dst partially modified, so callers should apply to a copy or discard dst.
+func (s Setters[T]) Apply(dst *T, paths []string) error {
+	paths, err := s.Validate(paths)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := s[path](dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isImmutable reports whether path is, or is nested under, an immutable field
+func isImmutable(path string) bool {
+	for _, field := range Immutable {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
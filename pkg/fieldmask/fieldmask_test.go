@@ -0,0 +1,52 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+)
+
+type entity struct {
+	ID    string
+	Title string
+	Tags  []string
+}
+
+func setters(title string, tags []string) Setters[entity] {
+	return Setters[entity]{
+		"title": func(e *entity) error { e.Title = title; return nil },
+		"tags":  func(e *entity) error { e.Tags = tags; return nil },
+	}
+}
+
+func TestApply_OnlyMaskedFields(t *testing.T) {
+	e := &entity{ID: "a", Title: "Old", Tags: []string{"go"}}
+
+	require.NoError(t, setters("New", nil).Apply(e, []string{"tags", " tags "}))
+	assert.Equal(t, &entity{ID: "a", Title: "Old", Tags: nil}, e, "tags cleared, title left alone")
+}
+
+func TestApply_RejectsPaths(t *testing.T) {
+	cases := map[string]string{
+		"id":               "id",
+		"user_id":          "user_id",
+		"created_at.nanos": "created_at.nanos",
+		"click_count":      "click_count",
+		"":                 "update_mask",
+	}
+	for path, field := range cases {
+		t.Run(path, func(t *testing.T) {
+			e := &entity{Title: "Old"}
+			err := setters("New", nil).Apply(e, []string{"title", path})
+
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr)
+			assert.Equal(t, apperrors.CodeValidation, appErr.Code)
+			assert.Equal(t, field, appErr.Field)
+			assert.Equal(t, "Old", e.Title, "nothing applied")
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Package grpcserver holds the gRPC server plumbing shared by the services:
+// request logging, panic recovery and graceful shutdown
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+)
+
+// UnaryInterceptor logs requests and converts panics into internal errors
+func UnaryInterceptor(log *logger.Logger) grpc.UnaryServerInterceptor {
+	grpcLog := log.GRPC()
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		start := time.Now()
+
+		defer func() {
+			if r := recover(); r != nil {
+				grpcLog.Error("panic in handler", zap.String("method", info.FullMethod), zap.Any("panic", r))
+				err = apperrors.Internal("internal error")
+			}
+
+			fields := []zap.Field{
+				zap.String("method", info.FullMethod),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("code", status.Code(err).String()),
+			}
+			if err != nil {
+				grpcLog.Warn("request failed", append(fields, zap.Error(err))...)
+				return
+			}
+			grpcLog.Debug("request handled", fields...)
+		}()
+
+		return handler(ctx, req)
+	}
+}
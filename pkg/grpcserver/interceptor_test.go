@@ -0,0 +1,58 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	intercept := UnaryInterceptor(logger.Default("test"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	resp, err := intercept(context.Background(), "req", info, func(_ context.Context, req interface{}) (interface{}, error) {
+		return req.(string) + " handled", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "req handled", resp)
+
+	_, err = intercept(context.Background(), "req", info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, apperrors.NotFound("missing")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Panics become internal errors instead of crashing the server
+	_, err = intercept(context.Background(), "req", info, func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, err.Error(), "boom")
+}
+
+func TestServe_StopsOnCancel(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- Serve(ctx, grpc.NewServer(), lis, "1s", logger.Default("test"))
+	}()
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after cancel")
+	}
+}
@@ -0,0 +1,44 @@
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/url-shortener-microservices/pkg/logger"
+)
+
+// Serve serves until ctx is cancelled, then stops gracefully within shutdownTimeout
+func Serve(ctx context.Context, srv *grpc.Server, lis net.Listener, shutdownTimeout string, log *logger.Logger) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(lis)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	timeout, err := time.ParseDuration(shutdownTimeout)
+	if err != nil {
+		timeout = 30 * time.Second
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Warn("graceful gRPC shutdown timed out, forcing stop")
+		srv.Stop()
+	}
+	return nil
+}
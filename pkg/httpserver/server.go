@@ -0,0 +1,91 @@
+// Package httpserver runs HTTP servers with config-driven timeouts and graceful shutdown
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// Server wraps http.Server with config-driven timeouts and graceful shutdown
+type Server struct {
+	srv             *http.Server
+	shutdownTimeout time.Duration
+}
+
+// NewServer creates an HTTP server from the shared server config
+func NewServer(cfg config.ServerConfig, handler http.Handler) (*Server, error) {
+	readTimeout, err := parseDuration("read_timeout", cfg.ReadTimeout, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	writeTimeout, err := parseDuration("write_timeout", cfg.WriteTimeout, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	idleTimeout, err := parseDuration("idle_timeout", cfg.IdleTimeout, 120*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	shutdownTimeout, err := parseDuration("shutdown_timeout", cfg.ShutdownTimeout, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		srv: &http.Server{
+			Addr:              cfg.GetServerAddr(),
+			Handler:           handler,
+			ReadTimeout:       readTimeout,
+			ReadHeaderTimeout: readTimeout,
+			WriteTimeout:      writeTimeout,
+			IdleTimeout:       idleTimeout,
+		},
+		shutdownTimeout: shutdownTimeout,
+	}, nil
+}
+
+// Addr returns the listen address
+func (s *Server) Addr() string {
+	return s.srv.Addr
+}
+
+// Run serves until ctx is cancelled, then shuts down within the shutdown timeout
+func (s *Server) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := s.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(shutdownCtx); err != nil {
+		s.srv.Close()
+		return fmt.Errorf("http shutdown: %w", err)
+	}
+	return nil
+}
+
+func parseDuration(name, value string, def time.Duration) (time.Duration, error) {
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid server.%s: %w", name, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,101 @@
+package httpserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// freePort returns a TCP port nothing listens on
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// startServer runs a server whose handler blocks until release is closed
+func startServer(t *testing.T, shutdownTimeout string, release <-chan struct{}) (addr string, entered <-chan struct{}, cancel func(), result <-chan error) {
+	in := make(chan struct{}, 1)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		in <- struct{}{}
+		<-release
+		w.Write([]byte("done"))
+	})
+	cfg := config.ServerConfig{Host: "127.0.0.1", Port: freePort(t), ShutdownTimeout: shutdownTimeout}
+	srv, err := NewServer(cfg, handler)
+	require.NoError(t, err)
+
+	ctx, stop := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- srv.Run(ctx) }()
+	t.Cleanup(stop)
+
+	addr = "http://127.0.0.1:" + strconv.Itoa(cfg.Port)
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", srv.Addr())
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, time.Second, 5*time.Millisecond)
+	return addr, in, stop, errCh
+}
+
+func TestServer_Run_DrainsRequests(t *testing.T) {
+	release := make(chan struct{})
+	addr, entered, stop, result := startServer(t, "1s", release)
+
+	body := make(chan string, 1)
+	go func() {
+		resp, err := http.Get(addr)
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		body <- string(b)
+	}()
+	<-entered
+	stop()
+
+	// New connections are refused while the in-flight request finishes
+	require.Eventually(t, func() bool {
+		_, err := http.Get(addr)
+		return err != nil
+	}, time.Second, 5*time.Millisecond)
+	close(release)
+	assert.Equal(t, "done", <-body)
+	assert.NoError(t, <-result)
+}
+
+func TestServer_Run_ShutdownTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	addr, entered, stop, result := startServer(t, "50ms", release)
+
+	go http.Get(addr)
+	<-entered
+	stop()
+	select {
+	case err := <-result:
+		assert.ErrorContains(t, err, "http shutdown")
+	case <-time.After(time.Second):
+		t.Fatal("Run did not give up after the shutdown timeout")
+	}
+}
+
+func TestNewServer_InvalidTimeout(t *testing.T) {
+	_, err := NewServer(config.ServerConfig{ReadTimeout: "soon"}, http.NotFoundHandler())
+	assert.ErrorContains(t, err, "server.read_timeout")
+}
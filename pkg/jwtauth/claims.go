@@ -0,0 +1,35 @@
+// Package jwtauth holds the access token format shared by the user service,
+// which signs tokens, and the services that verify them. Access tokens are
+// signed with RS256 or EdDSA keys identified by kid; verifiers fetch the public
+// keys from the user service's JWKS endpoint and check tokens locally.
+package jwtauth
+
+import (
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Errors returned by Verifier
+var (
+	ErrInvalidToken = errors.New("token is invalid")
+	ErrExpiredToken = errors.New("token has expired")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+// Token types, carried in the typ claim
+const (
+	TypeAccess  = "access"
+	TypeRefresh = "refresh"
+)
+
+// Claims is the JWT payload of user service tokens
+type Claims struct {
+	jwt.RegisteredClaims
+	Type          string   `json:"typ"`
+	SessionID     string   `json:"sid,omitempty"`
+	Email         string   `json:"email,omitempty"`
+	Roles         []string `json:"roles,omitempty"`
+	IsPremium     bool     `json:"premium,omitempty"`
+	EmailVerified bool     `json:"email_verified,omitempty"` // Address verified when the token was issued
+}
@@ -0,0 +1,111 @@
+package jwtauth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// JWK is a public key in JSON Web Key form (RFC 7517, RFC 8037)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	ID        string `json:"kid"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	N         string `json:"n,omitempty"`   // RSA modulus
+	E         string `json:"e,omitempty"`   // RSA exponent
+	Curve     string `json:"crv,omitempty"` // OKP curve
+	X         string `json:"x,omitempty"`   // OKP public key
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewJWK encodes an RSA or Ed25519 public key
+func NewJWK(kid string, pub crypto.PublicKey) (JWK, error) {
+	alg, err := Algorithm(pub)
+	if err != nil {
+		return JWK{}, err
+	}
+
+	jwk := JWK{ID: kid, Use: "sig", Algorithm: alg}
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(k.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(k)
+	}
+	return jwk, nil
+}
+
+// VerificationKey decodes the key. Keys meant for encryption or another
+// algorithm than their type implies are rejected.
+func (k JWK) VerificationKey() (*VerificationKey, error) {
+	if k.Use != "" && k.Use != "sig" {
+		return nil, fmt.Errorf("key %q is not a signing key", k.ID)
+	}
+
+	var pub crypto.PublicKey
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid modulus: %w", k.ID, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("key %q: invalid exponent", k.ID)
+		}
+		pub = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("key %q: unsupported curve %q", k.ID, k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("key %q: invalid Ed25519 key", k.ID)
+		}
+		pub = ed25519.PublicKey(x)
+	default:
+		return nil, fmt.Errorf("key %q: unsupported key type %q", k.ID, k.KeyType)
+	}
+
+	alg, err := Algorithm(pub)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", k.ID, err)
+	}
+	if k.Algorithm != "" && k.Algorithm != alg {
+		return nil, fmt.Errorf("key %q: algorithm %q does not match key type", k.ID, k.Algorithm)
+	}
+	return &VerificationKey{ID: k.ID, Algorithm: alg, Key: pub}, nil
+}
+
+// verificationKeys decodes every signing key, skipping keys this package
+// cannot use so one unsupported entry does not hide the others
+func (s JWKS) verificationKeys() (map[string]*VerificationKey, error) {
+	keys := make(map[string]*VerificationKey, len(s.Keys))
+	for _, k := range s.Keys {
+		if k.ID == "" {
+			continue
+		}
+		vk, err := k.VerificationKey()
+		if err != nil {
+			continue
+		}
+		keys[k.ID] = vk
+	}
+	if len(keys) == 0 && len(s.Keys) > 0 {
+		return nil, errors.New("key set has no usable signing keys")
+	}
+	return keys, nil
+}
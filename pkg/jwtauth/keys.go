@@ -0,0 +1,184 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing algorithms, chosen by key type
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// MinRSABits is the smallest accepted RSA modulus
+const MinRSABits = 2048
+
+// ErrNoActiveKey is returned when every signing key is scheduled for later
+var ErrNoActiveKey = errors.New("no active signing key")
+
+// SigningKey is a private key identified by kid
+type SigningKey struct {
+	ID         string
+	Key        crypto.Signer // *rsa.PrivateKey or ed25519.PrivateKey
+	ActiveFrom time.Time     // Signing starts at this time, zero means immediately
+}
+
+// VerificationKey is a public key and the algorithm it verifies
+type VerificationKey struct {
+	ID        string
+	Algorithm string
+	Key       crypto.PublicKey
+}
+
+// KeySource looks up verification keys by kid
+type KeySource interface {
+	// Key returns the key with kid, or ErrUnknownKey
+	Key(ctx context.Context, kid string) (*VerificationKey, error)
+}
+
+// ParsePrivateKey reads a PEM encoded PKCS#8 RSA or Ed25519 key, or a PKCS#1
+// RSA key
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var (
+		key interface{}
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key %T", key)
+	}
+	if _, err := Algorithm(signer.Public()); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// Algorithm returns the JWS algorithm used with a public key
+func Algorithm(pub crypto.PublicKey) (string, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < MinRSABits {
+			return "", fmt.Errorf("RSA key must be at least %d bits", MinRSABits)
+		}
+		return AlgRS256, nil
+	case ed25519.PublicKey:
+		return AlgEdDSA, nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T, want RSA or Ed25519", pub)
+	}
+}
+
+func signingMethod(alg string) jwt.SigningMethod {
+	if alg == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// KeyRing holds the signing keys of an issuer. The key signing at a given time
+// is the one most recently activated; every key, including those scheduled for
+// later and those already superseded, is published so verifiers know a key
+// before its first token and keep accepting tokens signed by the previous one.
+// A superseded key can be dropped once the access tokens it signed expired.
+type KeyRing struct {
+	keys   []SigningKey // Ordered by ActiveFrom
+	public map[string]*VerificationKey
+	jwks   JWKS
+}
+
+// NewKeyRing creates a ring from keys with distinct IDs
+func NewKeyRing(keys []SigningKey) (*KeyRing, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one signing key is required")
+	}
+
+	r := &KeyRing{
+		keys:   append([]SigningKey(nil), keys...),
+		public: make(map[string]*VerificationKey, len(keys)),
+		jwks:   JWKS{Keys: make([]JWK, 0, len(keys))},
+	}
+	sort.SliceStable(r.keys, func(i, j int) bool { return r.keys[i].ActiveFrom.Before(r.keys[j].ActiveFrom) })
+
+	for _, k := range r.keys {
+		if k.ID == "" {
+			return nil, errors.New("signing key ID is required")
+		}
+		if _, ok := r.public[k.ID]; ok {
+			return nil, fmt.Errorf("duplicate signing key ID %q", k.ID)
+		}
+		jwk, err := NewJWK(k.ID, k.Key.Public())
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", k.ID, err)
+		}
+		r.public[k.ID] = &VerificationKey{ID: k.ID, Algorithm: jwk.Algorithm, Key: k.Key.Public()}
+		r.jwks.Keys = append(r.jwks.Keys, jwk)
+	}
+	return r, nil
+}
+
+// Current returns the key signing at now
+func (r *KeyRing) Current(now time.Time) (*SigningKey, error) {
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		if !r.keys[i].ActiveFrom.After(now) {
+			return &r.keys[i], nil
+		}
+	}
+	return nil, ErrNoActiveKey
+}
+
+// Sign signs c with the key current at now, naming it in the kid header
+func (r *KeyRing) Sign(c *Claims, now time.Time) (string, error) {
+	key, err := r.Current(now)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(signingMethod(r.public[key.ID].Algorithm), c)
+	token.Header["kid"] = key.ID
+	signed, err := token.SignedString(key.Key)
+	if err != nil {
+		return "", fmt.Errorf("sign token: %w", err)
+	}
+	return signed, nil
+}
+
+// Key implements KeySource
+func (r *KeyRing) Key(_ context.Context, kid string) (*VerificationKey, error) {
+	if k, ok := r.public[kid]; ok {
+		return k, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// JWKS returns the public keys of the ring
+func (r *KeyRing) JWKS() JWKS {
+	return r.jwks
+}
@@ -0,0 +1,140 @@
+package jwtauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// maxJWKSSize bounds the key set response body
+const maxJWKSSize = 1 << 20
+
+// RemoteKeySetOptions configures a RemoteKeySet
+type RemoteKeySetOptions struct {
+	URL                string        // JWKS endpoint of the user service
+	Client             *http.Client  // Defaults to a client with a 10s timeout
+	RefreshInterval    time.Duration // Age after which the set is fetched again, default 5m
+	MinRefreshInterval time.Duration // Least time between fetches, also for unknown kids, default 30s
+}
+
+// RemoteKeySet implements KeySource with a cached JWKS fetched over HTTP. An
+// unknown kid triggers a refetch, so keys published by a rotation are picked up
+// before the cache expires. While the endpoint is unreachable the last fetched
+// set keeps being used. Lookups never wait on the endpoint unless they need a
+// refetch, and concurrent refetches share one request.
+type RemoteKeySet struct {
+	opts  RemoteKeySetOptions
+	now   func() time.Time
+	group singleflight.Group
+
+	mu        sync.RWMutex
+	keys      map[string]*VerificationKey
+	fetched   time.Time // Last successful fetch
+	attempted time.Time // Last fetch, successful or not
+}
+
+// NewRemoteKeySet creates a key set fetched from opts.URL on first use
+func NewRemoteKeySet(opts RemoteKeySetOptions) *RemoteKeySet {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if opts.RefreshInterval <= 0 {
+		opts.RefreshInterval = 5 * time.Minute
+	}
+	if opts.MinRefreshInterval <= 0 {
+		opts.MinRefreshInterval = 30 * time.Second
+	}
+	return &RemoteKeySet{opts: opts, now: time.Now}
+}
+
+// Key implements KeySource
+func (s *RemoteKeySet) Key(ctx context.Context, kid string) (*VerificationKey, error) {
+	s.mu.RLock()
+	key, known := s.keys[kid]
+	fresh := known && s.now().Sub(s.fetched) < s.opts.RefreshInterval
+	s.mu.RUnlock()
+	if fresh {
+		return key, nil
+	}
+
+	keys, err := s.refresh(ctx)
+	if err != nil {
+		if known {
+			return key, nil
+		}
+		return nil, err
+	}
+	if key, known = keys[kid]; !known {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// refresh fetches the set again and returns it, or returns the cached set if
+// the last fetch was less than MinRefreshInterval ago
+func (s *RemoteKeySet) refresh(ctx context.Context) (map[string]*VerificationKey, error) {
+	// The fetch outlives a caller that gives up, the client timeout bounds it
+	ch := s.group.DoChan("", func() (interface{}, error) {
+		s.mu.Lock()
+		now := s.now()
+		if !s.attempted.IsZero() && now.Sub(s.attempted) < s.opts.MinRefreshInterval {
+			keys := s.keys
+			s.mu.Unlock()
+			return keys, nil
+		}
+		s.attempted = now
+		s.mu.Unlock()
+
+		keys, err := s.fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		s.keys, s.fetched = keys, now
+		s.mu.Unlock()
+		return keys, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(map[string]*VerificationKey), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *RemoteKeySet) fetch(ctx context.Context) (map[string]*VerificationKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.opts.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.opts.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxJWKSSize)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decode JWKS: %w", err)
+	}
+	keys, err := set.verificationKeys()
+	if err != nil {
+		return nil, fmt.Errorf("decode JWKS: %w", err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,153 @@
+package jwtauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteKeySet_Rotation(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	first, err := NewKeyRing([]SigningKey{{ID: "k1", Key: newEdKey(t)}})
+	require.NoError(t, err)
+	second, err := NewKeyRing([]SigningKey{{ID: "k1", Key: first.keys[0].Key}, {ID: "k2", Key: newEdKey(t), ActiveFrom: now}})
+	require.NoError(t, err)
+
+	var (
+		published atomic.Pointer[KeyRing]
+		fetches   atomic.Int32
+		down      atomic.Bool
+	)
+	published.Store(first)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(published.Load().JWKS())
+	}))
+	defer srv.Close()
+
+	keys := NewRemoteKeySet(RemoteKeySetOptions{URL: srv.URL})
+	keys.now = func() time.Time { return now }
+	v := NewVerifier(keys, testOptions)
+
+	token, err := first.Sign(accessClaims(now), now)
+	require.NoError(t, err)
+	_, err = v.VerifyAt(ctx, token, now)
+	require.NoError(t, err)
+	_, err = v.VerifyAt(ctx, token, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, fetches.Load(), "cached set is reused")
+
+	// A token signed by a key published after the last fetch triggers a refetch
+	published.Store(second)
+	now = now.Add(time.Minute)
+	rotated, err := second.Sign(accessClaims(now), now)
+	require.NoError(t, err)
+	_, err = v.VerifyAt(ctx, rotated, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, fetches.Load())
+
+	// Unknown kids do not hammer the endpoint
+	stranger, err := NewKeyRing([]SigningKey{{ID: "k3", Key: newEdKey(t)}})
+	require.NoError(t, err)
+	forged, err := stranger.Sign(accessClaims(now), now)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = v.VerifyAt(ctx, forged, now)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	}
+	assert.EqualValues(t, 2, fetches.Load())
+
+	// An outage keeps the cached keys in use
+	down.Store(true)
+	now = now.Add(10 * time.Minute)
+	_, err = v.VerifyAt(ctx, rotated, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, fetches.Load())
+}
+
+func TestRemoteKeySet_Unavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	ring, err := NewKeyRing([]SigningKey{{ID: "k1", Key: newEdKey(t)}})
+	require.NoError(t, err)
+	now := time.Now()
+	token, err := ring.Sign(accessClaims(now), now)
+	require.NoError(t, err)
+
+	_, err = NewVerifier(NewRemoteKeySet(RemoteKeySetOptions{URL: srv.URL}), testOptions).Verify(context.Background(), token)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidToken, "an unreachable key set is not the token's fault")
+}
+
+func TestRemoteKeySet_RefreshDoesNotBlockLookups(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	ring, err := NewKeyRing([]SigningKey{{ID: "k1", Key: newEdKey(t)}})
+	require.NoError(t, err)
+
+	var (
+		fetches atomic.Int32
+		slow    atomic.Bool
+	)
+	started, release := make(chan struct{}, 1), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		if slow.Load() {
+			started <- struct{}{}
+			<-release
+		}
+		_ = json.NewEncoder(w).Encode(ring.JWKS())
+	}))
+	defer srv.Close()
+
+	keys := NewRemoteKeySet(RemoteKeySetOptions{URL: srv.URL})
+	keys.now = func() time.Time { return now }
+	_, err = keys.Key(ctx, "k1")
+	require.NoError(t, err)
+
+	// Several lookups of an unknown kid wait on one slow refetch
+	slow.Store(true)
+	now = now.Add(time.Minute)
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = keys.Key(ctx, "k9")
+		}(i)
+	}
+	<-started
+
+	// Known keys are still served, and callers that give up stop waiting
+	key, err := keys.Key(ctx, "k1")
+	require.NoError(t, err)
+	assert.NotNil(t, key)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = keys.Key(cancelled, "k8")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(release)
+	wg.Wait()
+	for _, err := range errs {
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	}
+	assert.EqualValues(t, 2, fetches.Load(), "concurrent lookups share one fetch")
+}
@@ -0,0 +1,76 @@
+package jwtauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// VerifierOptions configures a Verifier
+type VerifierOptions struct {
+	Issuer   string // Required iss claim
+	Audience string // Required aud claim
+}
+
+// Verifier checks access tokens locally against the issuer's public keys
+type Verifier struct {
+	keys KeySource
+	opts VerifierOptions
+}
+
+// NewVerifier creates a verifier looking up keys in keys, usually a RemoteKeySet
+func NewVerifier(keys KeySource, opts VerifierOptions) *Verifier {
+	return &Verifier{keys: keys, opts: opts}
+}
+
+// Verify checks an access token and returns its claims. It returns
+// ErrExpiredToken or an error wrapping ErrInvalidToken for rejected tokens;
+// any other error means the keys could not be loaded.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	return v.VerifyAt(ctx, token, time.Now())
+}
+
+// VerifyAt is Verify at the given time
+func (v *Verifier) VerifyAt(ctx context.Context, token string, now time.Time) (*Claims, error) {
+	var (
+		c      Claims
+		keyErr error
+	)
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := v.keys.Key(ctx, kid)
+		if err != nil {
+			if !errors.Is(err, ErrUnknownKey) {
+				keyErr = err
+			}
+			return nil, err
+		}
+		// The key decides the algorithm, never the token header alone
+		if t.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("key %q does not verify %s", kid, t.Method.Alg())
+		}
+		return key.Key, nil
+	},
+		jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}),
+		jwt.WithIssuer(v.opts.Issuer),
+		jwt.WithAudience(v.opts.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	switch {
+	case keyErr != nil:
+		return nil, fmt.Errorf("load verification key: %w", keyErr)
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return nil, ErrExpiredToken
+	case err != nil:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if c.Type != TypeAccess || c.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	return &c, nil
+}
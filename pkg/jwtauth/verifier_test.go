@@ -0,0 +1,191 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOptions = VerifierOptions{Issuer: "url-shortener", Audience: "url-shortener-users"}
+
+func newEdKey(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, MinRSABits)
+	require.NoError(t, err)
+	return key
+}
+
+func accessClaims(now time.Time) *Claims {
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "token-1",
+			Subject:   "user-1",
+			Issuer:    testOptions.Issuer,
+			Audience:  jwt.ClaimStrings{testOptions.Audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(15 * time.Minute)),
+		},
+		Type:  TypeAccess,
+		Email: "ada@example.com",
+		Roles: []string{"user"},
+	}
+}
+
+func TestKeyRing_Rotation(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ring, err := NewKeyRing([]SigningKey{
+		{ID: "next", Key: newEdKey(t), ActiveFrom: now.Add(24 * time.Hour)},
+		{ID: "old", Key: newRSAKey(t)},
+		{ID: "current", Key: newEdKey(t), ActiveFrom: now.Add(-time.Hour)},
+	})
+	require.NoError(t, err)
+
+	key, err := ring.Current(now)
+	require.NoError(t, err)
+	assert.Equal(t, "current", key.ID)
+	key, err = ring.Current(now.Add(25 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "next", key.ID)
+	key, err = ring.Current(now.Add(-2 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "old", key.ID)
+
+	// Every key is published, the scheduled one included
+	var kids []string
+	for _, k := range ring.JWKS().Keys {
+		kids = append(kids, k.ID)
+	}
+	assert.ElementsMatch(t, []string{"old", "current", "next"}, kids)
+
+	_, err = NewKeyRing([]SigningKey{{ID: "a", Key: newEdKey(t)}, {ID: "a", Key: newEdKey(t)}})
+	assert.Error(t, err)
+
+	later, err := NewKeyRing([]SigningKey{{ID: "a", Key: newEdKey(t), ActiveFrom: now.Add(time.Hour)}})
+	require.NoError(t, err)
+	_, err = later.Current(now)
+	assert.ErrorIs(t, err, ErrNoActiveKey)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name string
+		key  SigningKey
+		alg  string
+	}{
+		{"rsa", SigningKey{ID: "rsa-1", Key: newRSAKey(t)}, AlgRS256},
+		{"ed25519", SigningKey{ID: "ed-1", Key: newEdKey(t)}, AlgEdDSA},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ring, err := NewKeyRing([]SigningKey{tc.key})
+			require.NoError(t, err)
+			token, err := ring.Sign(accessClaims(now), now)
+			require.NoError(t, err)
+
+			// Verify through the published key set, as other services do
+			body, err := json.Marshal(ring.JWKS())
+			require.NoError(t, err)
+			var set JWKS
+			require.NoError(t, json.Unmarshal(body, &set))
+			require.Len(t, set.Keys, 1)
+			assert.Equal(t, tc.alg, set.Keys[0].Algorithm)
+			keys, err := set.verificationKeys()
+			require.NoError(t, err)
+
+			claims, err := NewVerifier(staticKeys(keys), testOptions).VerifyAt(ctx, token, now.Add(time.Minute))
+			require.NoError(t, err)
+			assert.Equal(t, "user-1", claims.Subject)
+			assert.Equal(t, "ada@example.com", claims.Email)
+			assert.Equal(t, []string{"user"}, claims.Roles)
+
+			_, err = NewVerifier(ring, testOptions).VerifyAt(ctx, token, now.Add(16*time.Minute))
+			assert.ErrorIs(t, err, ErrExpiredToken)
+		})
+	}
+}
+
+func TestVerifier_Rejects(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	rsaKey := newRSAKey(t)
+	ring, err := NewKeyRing([]SigningKey{{ID: "rsa-1", Key: rsaKey}})
+	require.NoError(t, err)
+	stranger, err := NewKeyRing([]SigningKey{{ID: "rsa-1", Key: newRSAKey(t)}})
+	require.NoError(t, err)
+
+	sign := func(r *KeyRing, edit func(c *Claims)) string {
+		c := accessClaims(now)
+		edit(c)
+		token, err := r.Sign(c, now)
+		require.NoError(t, err)
+		return token
+	}
+
+	// An HS256 token keyed with the public key must not pass as RS256
+	der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	pub := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims(now))
+	confused.Header["kid"] = "rsa-1"
+	hmacToken, err := confused.SignedString(pub)
+	require.NoError(t, err)
+
+	tokens := map[string]string{
+		"foreign key":   sign(stranger, func(*Claims) {}),
+		"refresh token": sign(ring, func(c *Claims) { c.Type = TypeRefresh }),
+		"audience":      sign(ring, func(c *Claims) { c.Audience = jwt.ClaimStrings{"someone-else"} }),
+		"issuer":        sign(ring, func(c *Claims) { c.Issuer = "someone-else" }),
+		"no expiry":     sign(ring, func(c *Claims) { c.ExpiresAt = nil }),
+		"hmac":          hmacToken,
+		"garbage":       "not.a.token",
+	}
+	v := NewVerifier(ring, testOptions)
+	for name, token := range tokens {
+		_, err := v.VerifyAt(ctx, token, now)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	ed := newEdKey(t)
+	der, err := x509.MarshalPKCS8PrivateKey(ed)
+	require.NoError(t, err)
+	key, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.Equal(t, ed.Public(), key.Public())
+
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(small)}))
+	assert.Error(t, err)
+
+	_, err = ParsePrivateKey([]byte("not a key"))
+	assert.Error(t, err)
+}
+
+// staticKeys is a KeySource over decoded keys
+type staticKeys map[string]*VerificationKey
+
+func (s staticKeys) Key(_ context.Context, kid string) (*VerificationKey, error) {
+	if k, ok := s[kid]; ok {
+		return k, nil
+	}
+	return nil, ErrUnknownKey
+}
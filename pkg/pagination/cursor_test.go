@@ -0,0 +1,65 @@
+package pagination
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCodec(t *testing.T, secret string) *Codec {
+	c, err := NewCodec([]byte(strings.Repeat(secret, MinSecretLength)))
+	require.NoError(t, err)
+	return c
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	codec := newTestCodec(t, "a")
+	scope := Scope("user-1", "created_at", "desc")
+	cur := Cursor{Scope: scope, Keys: []string{"2024-01-02T03:04:05.123456Z", "abc123"}, Page: 2, Total: 41}
+
+	token, err := codec.Encode(cur)
+	require.NoError(t, err)
+
+	got, err := codec.Decode(token, scope)
+	require.NoError(t, err)
+	assert.Equal(t, cur, got)
+}
+
+func TestCodec_Rejects(t *testing.T) {
+	codec := newTestCodec(t, "a")
+	scope := Scope("user-1")
+	token, err := codec.Encode(Cursor{Scope: scope, Keys: []string{"abc"}, Total: 10})
+	require.NoError(t, err)
+
+	payload, sig, _ := strings.Cut(token, ".")
+	forged, err := newTestCodec(t, "b").Encode(Cursor{Scope: scope, Keys: []string{"abc"}, Total: 10})
+	require.NoError(t, err)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	cases := map[string]struct{ token, scope string }{
+		"other scope":     {token, Scope("user-2")},
+		"other secret":    {forged, scope},
+		"swapped payload": {forgedPayload + "x." + sig, scope},
+		"no signature":    {payload, scope},
+		"garbage":         {"%%%.%%%", scope},
+		"empty":           {"", scope},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := codec.Decode(tc.token, tc.scope)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestScope_IsUnambiguous(t *testing.T) {
+	assert.NotEqual(t, Scope("ab", "c"), Scope("a", "bc"))
+	assert.Equal(t, Scope("a", "b"), Scope("a", "b"))
+}
+
+func TestNewCodec_ShortSecret(t *testing.T) {
+	_, err := NewCodec([]byte("short"))
+	assert.Error(t, err)
+}
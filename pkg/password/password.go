@@ -0,0 +1,152 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Errors returned by Hasher
+var (
+	ErrMismatch       = errors.New("password does not match")
+	ErrInvalidHash    = errors.New("invalid password hash")
+	ErrPasswordLength = errors.New("password length out of range")
+)
+
+// MaxLength is the longest accepted password in bytes
+const MaxLength = 1024
+
+// Params holds argon2id cost parameters
+type Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultParams follows the OWASP argon2id baseline (19 MiB, 2 iterations, 1 lane)
+var DefaultParams = Params{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Hasher hashes passwords with argon2id and verifies argon2id or legacy bcrypt hashes
+type Hasher struct {
+	params Params
+}
+
+// NewHasher creates a hasher with the given argon2id parameters
+func NewHasher(params Params) *Hasher {
+	return &Hasher{params: params}
+}
+
+// Default returns a hasher using DefaultParams
+func Default() *Hasher {
+	return NewHasher(DefaultParams)
+}
+
+// Hash returns a PHC-formatted argon2id hash of password
+func (h *Hasher) Hash(password string) (string, error) {
+	if password == "" || len(password) > MaxLength {
+		return "", ErrPasswordLength
+	}
+
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify checks password against an encoded hash, returns ErrMismatch if it does not match
+func (h *Hasher) Verify(password, encoded string) error {
+	if len(password) > MaxLength {
+		return ErrMismatch
+	}
+
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		params, salt, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return err
+		}
+		other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, other) != 1 {
+			return ErrMismatch
+		}
+		return nil
+
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrMismatch
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidHash, err)
+		}
+		return nil
+
+	default:
+		return ErrInvalidHash
+	}
+}
+
+// NeedsRehash reports whether encoded uses a legacy algorithm or outdated parameters
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	params, _, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		uint32(len(key)) != h.params.KeyLength
+}
+
+// decodeArgon2id parses $argon2id$v=19$m=..,t=..,p=..$salt$key
+func decodeArgon2id(encoded string) (Params, []byte, []byte, error) {
+	var params Params
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrInvalidHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrInvalidHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrInvalidHash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
@@ -0,0 +1,18 @@
+// Package ratelimit throttles repeated failed attempts, such as password or
+// two-factor guesses, in process or shared through Redis
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// AttemptLimiter throttles repeated failed attempts per key
+type AttemptLimiter interface {
+	// Blocked returns how long key stays blocked, zero if attempts are allowed
+	Blocked(ctx context.Context, key string) (time.Duration, error)
+	// Fail records a failed attempt for key
+	Fail(ctx context.Context, key string) error
+	// Reset clears the failures recorded for key
+	Reset(ctx context.Context, key string) error
+}
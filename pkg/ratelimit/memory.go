@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepThreshold is the number of tracked keys above which expired windows are purged
+const sweepThreshold = 10000
+
+// MemoryLimiter is a fixed-window AttemptLimiter local to one process
+type MemoryLimiter struct {
+	max    int
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	windows map[string]*failureWindow
+}
+
+type failureWindow struct {
+	failures int
+	resetAt  time.Time
+}
+
+// NewMemoryLimiter blocks a key for the rest of window after max failures
+func NewMemoryLimiter(max int, window time.Duration) *MemoryLimiter {
+	return &MemoryLimiter{
+		max:     max,
+		window:  window,
+		now:     time.Now,
+		windows: make(map[string]*failureWindow),
+	}
+}
+
+// Blocked implements AttemptLimiter
+func (l *MemoryLimiter) Blocked(_ context.Context, key string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, ok := l.windows[key]
+	if !ok {
+		return 0, nil
+	}
+	now := l.now()
+	if !now.Before(w.resetAt) {
+		delete(l.windows, key)
+		return 0, nil
+	}
+	if w.failures < l.max {
+		return 0, nil
+	}
+	return w.resetAt.Sub(now), nil
+}
+
+// Fail implements AttemptLimiter
+func (l *MemoryLimiter) Fail(_ context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, ok := l.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		if len(l.windows) >= sweepThreshold {
+			l.sweep(now)
+		}
+		w = &failureWindow{resetAt: now.Add(l.window)}
+		l.windows[key] = w
+	}
+	w.failures++
+	return nil
+}
+
+// Reset implements AttemptLimiter
+func (l *MemoryLimiter) Reset(_ context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.windows, key)
+	return nil
+}
+
+func (l *MemoryLimiter) sweep(now time.Time) {
+	for key, w := range l.windows {
+		if !now.Before(w.resetAt) {
+			delete(l.windows, key)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewMemoryLimiter(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	require.NoError(t, l.Fail(ctx, "a"))
+	blocked, err := l.Blocked(ctx, "a")
+	require.NoError(t, err)
+	assert.Zero(t, blocked, "below max")
+
+	now = now.Add(20 * time.Second)
+	require.NoError(t, l.Fail(ctx, "a"))
+	blocked, err = l.Blocked(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, 40*time.Second, blocked, "blocked for the rest of the window started by the first failure")
+
+	blocked, err = l.Blocked(ctx, "b")
+	require.NoError(t, err)
+	assert.Zero(t, blocked, "keys are independent")
+
+	now = now.Add(40 * time.Second)
+	blocked, err = l.Blocked(ctx, "a")
+	require.NoError(t, err)
+	assert.Zero(t, blocked, "window expired")
+
+	require.NoError(t, l.Fail(ctx, "a"))
+	require.NoError(t, l.Fail(ctx, "a"))
+	require.NoError(t, l.Reset(ctx, "a"))
+	blocked, err = l.Blocked(ctx, "a")
+	require.NoError(t, err)
+	assert.Zero(t, blocked, "reset clears failures")
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisLimiter is a fixed-window AttemptLimiter shared by all replicas
+type RedisLimiter struct {
+	client    redis.UniversalClient
+	max       int64
+	window    time.Duration
+	keyPrefix string
+}
+
+// NewRedisLimiter blocks a key for the rest of window after max failures
+func NewRedisLimiter(client redis.UniversalClient, max int, window time.Duration, keyPrefix string) *RedisLimiter {
+	return &RedisLimiter{
+		client:    client,
+		max:       int64(max),
+		window:    window,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// Blocked implements AttemptLimiter
+func (l *RedisLimiter) Blocked(ctx context.Context, key string) (time.Duration, error) {
+	key = l.keyPrefix + key
+
+	pipe := l.client.Pipeline()
+	count := pipe.Get(ctx, key)
+	ttl := pipe.PTTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+
+	failures, err := count.Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if failures < l.max {
+		return 0, nil
+	}
+	// A key without expiry would block forever, treat it as a full window
+	if remaining := ttl.Val(); remaining > 0 {
+		return remaining, nil
+	}
+	return l.window, nil
+}
+
+// Fail implements AttemptLimiter
+func (l *RedisLimiter) Fail(ctx context.Context, key string) error {
+	key = l.keyPrefix + key
+
+	// The window starts at the first failure; NX keeps later failures from extending it
+	pipe := l.client.TxPipeline()
+	pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, l.window)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Reset implements AttemptLimiter
+func (l *RedisLimiter) Reset(ctx context.Context, key string) error {
+	return l.client.Del(ctx, l.keyPrefix+key).Err()
+}
@@ -0,0 +1,60 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors
+var rfcSecret = []byte("12345678901234567890")
+
+func TestCode_RFC6238(t *testing.T) {
+	// The RFC lists 8 digit codes, authenticator apps show their last 6
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, want := range vectors {
+		assert.Equal(t, want, Code(rfcSecret, Step(time.Unix(unix, 0))), unix)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code := Code(rfcSecret, Step(now))
+
+	step, ok := Match(rfcSecret, code, now, 1)
+	require.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	// One step of drift either way is tolerated, two are not
+	_, ok = Match(rfcSecret, code, now.Add(Period*time.Second), 1)
+	assert.True(t, ok)
+	_, ok = Match(rfcSecret, code, now.Add(-Period*time.Second), 1)
+	assert.True(t, ok)
+	_, ok = Match(rfcSecret, code, now.Add(2*Period*time.Second), 1)
+	assert.False(t, ok)
+
+	for _, bad := range []string{"", "12345", "1234567", "abcdef"} {
+		_, ok = Match(rfcSecret, bad, now, 1)
+		assert.False(t, ok, bad)
+	}
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("URL Shortener", "ada@example.com", rfcSecret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/URL Shortener:ada@example.com", u.Path)
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	assert.Equal(t, "URL Shortener", u.Query().Get("issuer"))
+}
@@ -10,6 +10,7 @@ import (
 	common "github.com/url-shortener-microservices/proto/gen/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -26,7 +27,7 @@ const (
 // URL entity
 type URL struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // Short code (e.g., "abc123")
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // Short code (e.g., "abc123"), "<domain>/<code>" on a custom domain
 	OriginalUrl string                 `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"` // Full original URL
 	ShortUrl    string                 `protobuf:"bytes,3,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`          // Complete short URL (domain + id)
 	UserId      string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // Owner user ID (empty if anonymous)
@@ -40,10 +41,14 @@ type URL struct {
 	ClickCount   int64                  `protobuf:"varint,10,opt,name=click_count,json=clickCount,proto3" json:"click_count,omitempty"`
 	LastAccessed *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_accessed,json=lastAccessed,proto3" json:"last_accessed,omitempty"`
 	// Features
-	IsActive      bool     `protobuf:"varint,12,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"` // Can be disabled by user/admin
-	IsCustom      bool     `protobuf:"varint,13,opt,name=is_custom,json=isCustom,proto3" json:"is_custom,omitempty"` // Custom short code vs generated
-	Password      string   `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`                  // Optional password protection (hashed)
-	Tags          []string `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                          // User-defined tags
+	IsActive      bool                   `protobuf:"varint,12,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`               // Can be disabled by user/admin
+	IsCustom      bool                   `protobuf:"varint,13,opt,name=is_custom,json=isCustom,proto3" json:"is_custom,omitempty"`               // Custom short code vs generated
+	Password      string                 `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`                                // Optional password protection (hashed)
+	Tags          []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`                                        // User-defined tags
+	MaxClicks     int64                  `protobuf:"varint,16,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`            // Clicks allowed before the link expires, 0 for unlimited
+	ActivatesAt   *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=activates_at,json=activatesAt,proto3" json:"activates_at,omitempty"`       // Optional: link resolves only from this time
+	Variants      []*Variant             `protobuf:"bytes,18,rep,name=variants,proto3" json:"variants,omitempty"`                                // A/B split destinations, empty for a single destination
+	BlockedReason string                 `protobuf:"bytes,19,opt,name=blocked_reason,json=blockedReason,proto3" json:"blocked_reason,omitempty"` // Why destination screening disabled the link, empty unless flagged
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -183,36 +188,1727 @@ func (x *URL) GetTags() []string {
 	return nil
 }
 
+func (x *URL) GetMaxClicks() int64 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *URL) GetActivatesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return nil
+}
+
+func (x *URL) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *URL) GetBlockedReason() string {
+	if x != nil {
+		return x.BlockedReason
+	}
+	return ""
+}
+
+// A/B split destination. Visitors no redirect rule matches are spread over a
+// link's variants by weight and stick to theirs via a cookie.
+type Variant struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Reported with clicks, e.g. "a"; defaults to a, b, c... by position
+	DestinationUrl string                 `protobuf:"bytes,2,opt,name=destination_url,json=destinationUrl,proto3" json:"destination_url,omitempty"`
+	Weight         int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"` // Relative share of visitors, 0 pauses the variant
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_url_url_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Variant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Variant) GetDestinationUrl() string {
+	if x != nil {
+		return x.DestinationUrl
+	}
+	return ""
+}
+
+func (x *Variant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Redirect rule: visitors matching every condition set go to destination_url.
+// A link's rules are evaluated in order, the first match wins and visitors no
+// rule matches go to original_url.
+type RedirectRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DestinationUrl   string                 `protobuf:"bytes,2,opt,name=destination_url,json=destinationUrl,proto3" json:"destination_url,omitempty"`
+	Countries        []string               `protobuf:"bytes,3,rep,name=countries,proto3" json:"countries,omitempty"`                                       // ISO 3166-1 alpha-2 codes, e.g. "DE"
+	DeviceTypes      []string               `protobuf:"bytes,4,rep,name=device_types,json=deviceTypes,proto3" json:"device_types,omitempty"`                // "desktop", "mobile", "tablet"
+	OperatingSystems []string               `protobuf:"bytes,5,rep,name=operating_systems,json=operatingSystems,proto3" json:"operating_systems,omitempty"` // "windows", "macos", "ios", "android", "linux", "chromeos"
+	Languages        []string               `protobuf:"bytes,6,rep,name=languages,proto3" json:"languages,omitempty"`                                       // Matched against the visitor's preferred language, "en" also matches "en-GB"
+	TimeWindow       *TimeWindow            `protobuf:"bytes,7,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	Disabled         bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"` // Skipped during evaluation
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RedirectRule) Reset() {
+	*x = RedirectRule{}
+	mi := &file_url_url_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectRule) ProtoMessage() {}
+
+func (x *RedirectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectRule.ProtoReflect.Descriptor instead.
+func (*RedirectRule) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RedirectRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetDestinationUrl() string {
+	if x != nil {
+		return x.DestinationUrl
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetDeviceTypes() []string {
+	if x != nil {
+		return x.DeviceTypes
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetOperatingSystems() []string {
+	if x != nil {
+		return x.OperatingSystems
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetTimeWindow() *TimeWindow {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RedirectRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Custom domain claimed for branded links. Links can be created on it once a
+// TXT record named verification_record with value verification_value exists.
+type Domain struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Hostname           string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsVerified         bool                   `protobuf:"varint,3,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerificationRecord string                 `protobuf:"bytes,4,opt,name=verification_record,json=verificationRecord,proto3" json:"verification_record,omitempty"` // e.g. "_shortener-verify.go.example.com"
+	VerificationValue  string                 `protobuf:"bytes,5,opt,name=verification_value,json=verificationValue,proto3" json:"verification_value,omitempty"`    // e.g. "shortener-verify=<token>"
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VerifiedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	CheckedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // Last verification attempt
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Domain) Reset() {
+	*x = Domain{}
+	mi := &file_url_url_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Domain) ProtoMessage() {}
+
+func (x *Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Domain.ProtoReflect.Descriptor instead.
+func (*Domain) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Domain) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Domain) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Domain) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *Domain) GetVerificationRecord() string {
+	if x != nil {
+		return x.VerificationRecord
+	}
+	return ""
+}
+
+func (x *Domain) GetVerificationValue() string {
+	if x != nil {
+		return x.VerificationValue
+	}
+	return ""
+}
+
+func (x *Domain) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Domain) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *Domain) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// Immutable record of one edit of a link
+type URLRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UrlId         string                 `protobuf:"bytes,2,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Who made the edit
+	Changes       []*FieldChange         `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	RestoredFrom  int64                  `protobuf:"varint,5,opt,name=restored_from,json=restoredFrom,proto3" json:"restored_from,omitempty"` // Revision the edit rolled back to, 0 for a plain edit
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *URLRevision) Reset() {
+	*x = URLRevision{}
+	mi := &file_url_url_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *URLRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URLRevision) ProtoMessage() {}
+
+func (x *URLRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URLRevision.ProtoReflect.Descriptor instead.
+func (*URLRevision) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *URLRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *URLRevision) GetUrlId() string {
+	if x != nil {
+		return x.UrlId
+	}
+	return ""
+}
+
+func (x *URLRevision) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *URLRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *URLRevision) GetRestoredFrom() int64 {
+	if x != nil {
+		return x.RestoredFrom
+	}
+	return 0
+}
+
+func (x *URLRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Field changed by an edit, values are JSON encoded
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                       // Update mask path, e.g. "original_url"
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // e.g. "\"https://example.com\"", passwords only as true or false
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_url_url_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// Daily time range a rule applies in
+type TimeWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`               // "HH:MM", inclusive
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`                   // "HH:MM", exclusive; before start wraps past midnight
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`         // IANA time zone, defaults to UTC
+	Weekdays      []int32                `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"` // Days the window starts on, 0 = Sunday; empty for every day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	mi := &file_url_url_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
 // Request messages
 type CreateURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OriginalUrl   string                 `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"` // Required: URL to shorten
-	CustomCode    string                 `protobuf:"bytes,2,opt,name=custom_code,json=customCode,proto3" json:"custom_code,omitempty"`    // Optional: preferred short code
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // Optional: user ID
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                // Optional: custom title
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                    // Optional: description
-	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`                          // Optional: password protection
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`       // Optional: expiration date
-	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                  // Optional: tags
+	OriginalUrl   string                 `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`  // Required: URL to shorten
+	CustomCode    string                 `protobuf:"bytes,2,opt,name=custom_code,json=customCode,proto3" json:"custom_code,omitempty"`     // Optional: preferred short code
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // Optional: user ID
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                 // Optional: custom title
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                     // Optional: description
+	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`                           // Optional: password protection
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`        // Optional: expiration date
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                   // Optional: tags
+	MaxClicks     int64                  `protobuf:"varint,9,opt,name=max_clicks,json=maxClicks,proto3" json:"max_clicks,omitempty"`       // Optional: click limit, 1 for a one-time link
+	ActivatesAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=activates_at,json=activatesAt,proto3" json:"activates_at,omitempty"` // Optional: scheduled activation, before expires_at
+	Variants      []*Variant             `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`                          // Optional: A/B split destinations, original_url stays the fallback
+	Domain        string                 `protobuf:"bytes,12,opt,name=domain,proto3" json:"domain,omitempty"`                              // Optional: verified custom domain of user_id, codes are unique per domain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateURLRequest) Reset() {
+	*x = CreateURLRequest{}
+	mi := &file_url_url_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateURLRequest) ProtoMessage() {}
+
+func (x *CreateURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateURLRequest.ProtoReflect.Descriptor instead.
+func (*CreateURLRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateURLRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *CreateURLRequest) GetCustomCode() string {
+	if x != nil {
+		return x.CustomCode
+	}
+	return ""
+}
+
+func (x *CreateURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateURLRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateURLRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateURLRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateURLRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateURLRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateURLRequest) GetMaxClicks() int64 {
+	if x != nil {
+		return x.MaxClicks
+	}
+	return 0
+}
+
+func (x *CreateURLRequest) GetActivatesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return nil
+}
+
+func (x *CreateURLRequest) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *CreateURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type CreateURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Url           *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateURLResponse) Reset() {
+	*x = CreateURLResponse{}
+	mi := &file_url_url_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateURLResponse) ProtoMessage() {}
+
+func (x *CreateURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateURLResponse.ProtoReflect.Descriptor instead.
+func (*CreateURLResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateURLResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateURLResponse) GetUrl() *URL {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+type GetURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // Short code
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                              // If password protected
+	IncludeStats  bool                   `protobuf:"varint,3,opt,name=include_stats,json=includeStats,proto3" json:"include_stats,omitempty"` // Include click statistics
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetURLRequest) Reset() {
+	*x = GetURLRequest{}
+	mi := &file_url_url_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetURLRequest) ProtoMessage() {}
+
+func (x *GetURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetURLRequest.ProtoReflect.Descriptor instead.
+func (*GetURLRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetURLRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetURLRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetURLRequest) GetIncludeStats() bool {
+	if x != nil {
+		return x.IncludeStats
+	}
+	return false
+}
+
+type GetURLResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Status           *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Url              *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	PasswordRequired bool                   `protobuf:"varint,3,opt,name=password_required,json=passwordRequired,proto3" json:"password_required,omitempty"` // True if password needed but not provided
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetURLResponse) Reset() {
+	*x = GetURLResponse{}
+	mi := &file_url_url_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetURLResponse) ProtoMessage() {}
+
+func (x *GetURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetURLResponse.ProtoReflect.Descriptor instead.
+func (*GetURLResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetURLResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetURLResponse) GetUrl() *URL {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *GetURLResponse) GetPasswordRequired() bool {
+	if x != nil {
+		return x.PasswordRequired
+	}
+	return false
+}
+
+type UpdateURLRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // Short code to update
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
+	// Optional fields to update
+	Title       *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Password    *string                `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"` // Set/change/remove password
+	IsActive    *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Tags        []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	MaxClicks   *int64                 `protobuf:"varint,10,opt,name=max_clicks,json=maxClicks,proto3,oneof" json:"max_clicks,omitempty"` // 0 removes the limit
+	ActivatesAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=activates_at,json=activatesAt,proto3,oneof" json:"activates_at,omitempty"`
+	Variants    []*Variant             `protobuf:"bytes,12,rep,name=variants,proto3" json:"variants,omitempty"`                                // Without a mask, replaces variants when non-empty
+	OriginalUrl *string                `protobuf:"bytes,13,opt,name=original_url,json=originalUrl,proto3,oneof" json:"original_url,omitempty"` // New destination
+	UserContext *common.UserContext    `protobuf:"bytes,14,opt,name=user_context,json=userContext,proto3" json:"user_context,omitempty"`       // Caller, premium accounts keep a longer edit history
+	// Fields to update. When set, listed fields take the request value even if
+	// unset or empty, which clears them; when unset, only present fields and
+	// non-empty tags are updated.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateURLRequest) Reset() {
+	*x = UpdateURLRequest{}
+	mi := &file_url_url_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLRequest) ProtoMessage() {}
+
+func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLRequest.ProtoReflect.Descriptor instead.
+func (*UpdateURLRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateURLRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateURLRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetMaxClicks() int64 {
+	if x != nil && x.MaxClicks != nil {
+		return *x.MaxClicks
+	}
+	return 0
+}
+
+func (x *UpdateURLRequest) GetActivatesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetOriginalUrl() string {
+	if x != nil && x.OriginalUrl != nil {
+		return *x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *UpdateURLRequest) GetUserContext() *common.UserContext {
+	if x != nil {
+		return x.UserContext
+	}
+	return nil
+}
+
+func (x *UpdateURLRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Url           *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateURLResponse) Reset() {
+	*x = UpdateURLResponse{}
+	mi := &file_url_url_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateURLResponse) ProtoMessage() {}
+
+func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateURLResponse.ProtoReflect.Descriptor instead.
+func (*UpdateURLResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateURLResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateURLResponse) GetUrl() *URL {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+type DeleteURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner or be admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteURLRequest) Reset() {
+	*x = DeleteURLRequest{}
+	mi := &file_url_url_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteURLRequest) ProtoMessage() {}
+
+func (x *DeleteURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteURLRequest.ProtoReflect.Descriptor instead.
+func (*DeleteURLRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteURLRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteURLResponse) Reset() {
+	*x = DeleteURLResponse{}
+	mi := &file_url_url_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteURLResponse) ProtoMessage() {}
+
+func (x *DeleteURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteURLResponse.ProtoReflect.Descriptor instead.
+func (*DeleteURLResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteURLResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// List URLs for a user
+type ListURLsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Search        string                    `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`                                    // Search in title, description, tags
+	Tags          []string                  `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                        // Filter by tags
+	ActiveOnly    bool                      `protobuf:"varint,5,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`         // Show only active URLs
+	MatchAllTags  bool                      `protobuf:"varint,6,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"` // Require every tag instead of any
+	ActivatesAt   *common.DateFilter        `protobuf:"bytes,7,opt,name=activates_at,json=activatesAt,proto3" json:"activates_at,omitempty"`       // Only URLs scheduled to activate in this window
+	PendingOnly   bool                      `protobuf:"varint,8,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`      // Only URLs not activated yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListURLsRequest) Reset() {
+	*x = ListURLsRequest{}
+	mi := &file_url_url_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListURLsRequest) ProtoMessage() {}
+
+func (x *ListURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListURLsRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListURLsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListURLsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListURLsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListURLsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListURLsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListURLsRequest) GetMatchAllTags() bool {
+	if x != nil {
+		return x.MatchAllTags
+	}
+	return false
+}
+
+func (x *ListURLsRequest) GetActivatesAt() *common.DateFilter {
+	if x != nil {
+		return x.ActivatesAt
+	}
+	return nil
+}
+
+func (x *ListURLsRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+type ListURLsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Status        *common.Response           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Urls          []*URL                     `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListURLsResponse) Reset() {
+	*x = ListURLsResponse{}
+	mi := &file_url_url_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListURLsResponse) ProtoMessage() {}
+
+func (x *ListURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListURLsResponse.ProtoReflect.Descriptor instead.
+func (*ListURLsResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListURLsResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListURLsResponse) GetUrls() []*URL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ListURLsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Validate URL request (check if URL is valid and accessible)
+type ValidateURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateURLRequest) Reset() {
+	*x = ValidateURLRequest{}
+	mi := &file_url_url_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateURLRequest) ProtoMessage() {}
+
+func (x *ValidateURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateURLRequest.ProtoReflect.Descriptor instead.
+func (*ValidateURLRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ValidateURLResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Status              *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IsValid             bool                   `protobuf:"varint,2,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	IsAccessible        bool                   `protobuf:"varint,3,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"`                     // Can be reached via HTTP
+	DetectedTitle       string                 `protobuf:"bytes,4,opt,name=detected_title,json=detectedTitle,proto3" json:"detected_title,omitempty"`                   // Scraped page title
+	ContentType         string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`                         // MIME type
+	StatusCode          int32                  `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`                           // HTTP status code
+	DetectedDescription string                 `protobuf:"bytes,7,opt,name=detected_description,json=detectedDescription,proto3" json:"detected_description,omitempty"` // og:description or meta description
+	DetectedImage       string                 `protobuf:"bytes,8,opt,name=detected_image,json=detectedImage,proto3" json:"detected_image,omitempty"`                   // og:image, absolute
+	FinalUrl            string                 `protobuf:"bytes,9,opt,name=final_url,json=finalUrl,proto3" json:"final_url,omitempty"`                                  // URL after following redirects
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ValidateURLResponse) Reset() {
+	*x = ValidateURLResponse{}
+	mi := &file_url_url_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateURLResponse) ProtoMessage() {}
+
+func (x *ValidateURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateURLResponse.ProtoReflect.Descriptor instead.
+func (*ValidateURLResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ValidateURLResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ValidateURLResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateURLResponse) GetIsAccessible() bool {
+	if x != nil {
+		return x.IsAccessible
+	}
+	return false
+}
+
+func (x *ValidateURLResponse) GetDetectedTitle() string {
+	if x != nil {
+		return x.DetectedTitle
+	}
+	return ""
+}
+
+func (x *ValidateURLResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ValidateURLResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ValidateURLResponse) GetDetectedDescription() string {
+	if x != nil {
+		return x.DetectedDescription
+	}
+	return ""
+}
+
+func (x *ValidateURLResponse) GetDetectedImage() string {
+	if x != nil {
+		return x.DetectedImage
+	}
+	return ""
+}
+
+func (x *ValidateURLResponse) GetFinalUrl() string {
+	if x != nil {
+		return x.FinalUrl
+	}
+	return ""
+}
+
+// Check if custom code is available
+type CheckAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomCode    string                 `protobuf:"bytes,1,opt,name=custom_code,json=customCode,proto3" json:"custom_code,omitempty"`
+	OriginalUrl   string                 `protobuf:"bytes,2,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"` // Optional: destination, its words seed suggestions
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`                              // Optional: custom domain to check on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityRequest) Reset() {
+	*x = CheckAvailabilityRequest{}
+	mi := &file_url_url_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckAvailabilityRequest) GetCustomCode() string {
+	if x != nil {
+		return x.CustomCode
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type CheckAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Suggestions   []string               `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // Available alternatives, best first, if not available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityResponse) Reset() {
+	*x = CheckAvailabilityResponse{}
+	mi := &file_url_url_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckAvailabilityResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CheckAvailabilityResponse) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *CheckAvailabilityResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Bulk operations for premium users
+type BulkCreateURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urls          []*CreateURLRequest    `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // Owner, must match user_context when set
+	UserContext   *common.UserContext    `protobuf:"bytes,3,opt,name=user_context,json=userContext,proto3" json:"user_context,omitempty"`       // Caller, must be premium
+	AllOrNothing  bool                   `protobuf:"varint,4,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"` // Create nothing if any item fails, otherwise best effort
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateURLRequest) Reset() {
+	*x = BulkCreateURLRequest{}
+	mi := &file_url_url_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateURLRequest) ProtoMessage() {}
+
+func (x *BulkCreateURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateURLRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateURLRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BulkCreateURLRequest) GetUrls() []*CreateURLRequest {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *BulkCreateURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkCreateURLRequest) GetUserContext() *common.UserContext {
+	if x != nil {
+		return x.UserContext
+	}
+	return nil
+}
+
+func (x *BulkCreateURLRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BulkCreateURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Urls          []*URL                 `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`     // Created URLs in request order
+	Errors        []*common.Error        `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"` // Per-URL errors, field is "urls[<index>]" or "urls[<index>].<field>"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateURLResponse) Reset() {
+	*x = BulkCreateURLResponse{}
+	mi := &file_url_url_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateURLResponse) ProtoMessage() {}
+
+func (x *BulkCreateURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateURLResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateURLResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BulkCreateURLResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *BulkCreateURLResponse) GetUrls() []*URL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *BulkCreateURLResponse) GetErrors() []*common.Error {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Analytics integration - increment click count
+type IncrementClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UrlId         string                 `protobuf:"bytes,1,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Referrer      string                 `protobuf:"bytes,4,opt,name=referrer,proto3" json:"referrer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateURLRequest) Reset() {
-	*x = CreateURLRequest{}
-	mi := &file_url_url_service_proto_msgTypes[1]
+func (x *IncrementClickRequest) Reset() {
+	*x = IncrementClickRequest{}
+	mi := &file_url_url_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateURLRequest) String() string {
+func (x *IncrementClickRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateURLRequest) ProtoMessage() {}
+func (*IncrementClickRequest) ProtoMessage() {}
 
-func (x *CreateURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[1]
+func (x *IncrementClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,90 +1919,287 @@ func (x *CreateURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateURLRequest.ProtoReflect.Descriptor instead.
-func (*CreateURLRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use IncrementClickRequest.ProtoReflect.Descriptor instead.
+func (*IncrementClickRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateURLRequest) GetOriginalUrl() string {
+func (x *IncrementClickRequest) GetUrlId() string {
 	if x != nil {
-		return x.OriginalUrl
+		return x.UrlId
 	}
 	return ""
 }
 
-func (x *CreateURLRequest) GetCustomCode() string {
+func (x *IncrementClickRequest) GetUserAgent() string {
 	if x != nil {
-		return x.CustomCode
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *CreateURLRequest) GetUserId() string {
+func (x *IncrementClickRequest) GetIpAddress() string {
 	if x != nil {
-		return x.UserId
+		return x.IpAddress
 	}
 	return ""
 }
 
-func (x *CreateURLRequest) GetTitle() string {
+func (x *IncrementClickRequest) GetReferrer() string {
 	if x != nil {
-		return x.Title
+		return x.Referrer
 	}
 	return ""
 }
 
-func (x *CreateURLRequest) GetDescription() string {
+type IncrementClickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NewCount      int64                  `protobuf:"varint,2,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementClickResponse) Reset() {
+	*x = IncrementClickResponse{}
+	mi := &file_url_url_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementClickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementClickResponse) ProtoMessage() {}
+
+func (x *IncrementClickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[24]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementClickResponse.ProtoReflect.Descriptor instead.
+func (*IncrementClickResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *IncrementClickResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *IncrementClickResponse) GetNewCount() int64 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+// Redirect rules, owner only
+type ListRedirectRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UrlId         string                 `protobuf:"bytes,1,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedirectRulesRequest) Reset() {
+	*x = ListRedirectRulesRequest{}
+	mi := &file_url_url_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedirectRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectRulesRequest) ProtoMessage() {}
+
+func (x *ListRedirectRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRedirectRulesRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRedirectRulesRequest) GetUrlId() string {
+	if x != nil {
+		return x.UrlId
 	}
 	return ""
 }
 
-func (x *CreateURLRequest) GetPassword() string {
+func (x *ListRedirectRulesRequest) GetUserId() string {
 	if x != nil {
-		return x.Password
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateURLRequest) GetExpiresAt() *timestamppb.Timestamp {
+type ListRedirectRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rules         []*RedirectRule        `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"` // In evaluation order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedirectRulesResponse) Reset() {
+	*x = ListRedirectRulesResponse{}
+	mi := &file_url_url_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedirectRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectRulesResponse) ProtoMessage() {}
+
+func (x *ListRedirectRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[26]
 	if x != nil {
-		return x.ExpiresAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRedirectRulesResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListRedirectRulesResponse) GetStatus() *common.Response {
+	if x != nil {
+		return x.Status
 	}
 	return nil
 }
 
-func (x *CreateURLRequest) GetTags() []string {
+func (x *ListRedirectRulesResponse) GetRules() []*RedirectRule {
 	if x != nil {
-		return x.Tags
+		return x.Rules
 	}
 	return nil
 }
 
-type CreateURLResponse struct {
+type CreateRedirectRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UrlId         string                 `protobuf:"bytes,1,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
+	Rule          *RedirectRule          `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`                   // id and timestamps are assigned
+	Position      *int32                 `protobuf:"varint,4,opt,name=position,proto3,oneof" json:"position,omitempty"`    // Index to insert at, appended when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRedirectRuleRequest) Reset() {
+	*x = CreateRedirectRuleRequest{}
+	mi := &file_url_url_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedirectRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedirectRuleRequest) ProtoMessage() {}
+
+func (x *CreateRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateRedirectRuleRequest) GetUrlId() string {
+	if x != nil {
+		return x.UrlId
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRedirectRuleRequest) GetRule() *RedirectRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *CreateRedirectRuleRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type CreateRedirectRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Url           *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Rule          *RedirectRule          `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateURLResponse) Reset() {
-	*x = CreateURLResponse{}
-	mi := &file_url_url_service_proto_msgTypes[2]
+func (x *CreateRedirectRuleResponse) Reset() {
+	*x = CreateRedirectRuleResponse{}
+	mi := &file_url_url_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateURLResponse) String() string {
+func (x *CreateRedirectRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateURLResponse) ProtoMessage() {}
+func (*CreateRedirectRuleResponse) ProtoMessage() {}
 
-func (x *CreateURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[2]
+func (x *CreateRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,49 +2210,53 @@ func (x *CreateURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateURLResponse.ProtoReflect.Descriptor instead.
-func (*CreateURLResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateURLResponse) GetStatus() *common.Response {
+func (x *CreateRedirectRuleResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *CreateURLResponse) GetUrl() *URL {
+func (x *CreateRedirectRuleResponse) GetRule() *RedirectRule {
 	if x != nil {
-		return x.Url
+		return x.Rule
 	}
 	return nil
 }
 
-type GetURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // Short code
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`                              // If password protected
-	IncludeStats  bool                   `protobuf:"varint,3,opt,name=include_stats,json=includeStats,proto3" json:"include_stats,omitempty"` // Include click statistics
+type UpdateRedirectRuleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UrlId  string                 `protobuf:"bytes,1,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
+	Rule   *RedirectRule          `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`                   // rule.id selects the rule
+	// Rule fields to update, and "position" to move the rule. When unset the
+	// rule is replaced by the request one and keeps its position unless set.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Position      *int32                 `protobuf:"varint,5,opt,name=position,proto3,oneof" json:"position,omitempty"` // New index in evaluation order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetURLRequest) Reset() {
-	*x = GetURLRequest{}
-	mi := &file_url_url_service_proto_msgTypes[3]
+func (x *UpdateRedirectRuleRequest) Reset() {
+	*x = UpdateRedirectRuleRequest{}
+	mi := &file_url_url_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetURLRequest) String() string {
+func (x *UpdateRedirectRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetURLRequest) ProtoMessage() {}
+func (*UpdateRedirectRuleRequest) ProtoMessage() {}
 
-func (x *GetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[3]
+func (x *UpdateRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,56 +2267,69 @@ func (x *GetURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetURLRequest.ProtoReflect.Descriptor instead.
-func (*GetURLRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdateRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetURLRequest) GetId() string {
+func (x *UpdateRedirectRuleRequest) GetUrlId() string {
 	if x != nil {
-		return x.Id
+		return x.UrlId
 	}
 	return ""
 }
 
-func (x *GetURLRequest) GetPassword() string {
+func (x *UpdateRedirectRuleRequest) GetUserId() string {
 	if x != nil {
-		return x.Password
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetURLRequest) GetIncludeStats() bool {
+func (x *UpdateRedirectRuleRequest) GetRule() *RedirectRule {
 	if x != nil {
-		return x.IncludeStats
+		return x.Rule
 	}
-	return false
+	return nil
 }
 
-type GetURLResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Status           *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Url              *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	PasswordRequired bool                   `protobuf:"varint,3,opt,name=password_required,json=passwordRequired,proto3" json:"password_required,omitempty"` // True if password needed but not provided
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *UpdateRedirectRuleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
 }
 
-func (x *GetURLResponse) Reset() {
-	*x = GetURLResponse{}
-	mi := &file_url_url_service_proto_msgTypes[4]
+func (x *UpdateRedirectRuleRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type UpdateRedirectRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rule          *RedirectRule          `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRedirectRuleResponse) Reset() {
+	*x = UpdateRedirectRuleResponse{}
+	mi := &file_url_url_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetURLResponse) String() string {
+func (x *UpdateRedirectRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetURLResponse) ProtoMessage() {}
+func (*UpdateRedirectRuleResponse) ProtoMessage() {}
 
-func (x *GetURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[4]
+func (x *UpdateRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,62 +2340,49 @@ func (x *GetURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetURLResponse.ProtoReflect.Descriptor instead.
-func (*GetURLResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetURLResponse) GetStatus() *common.Response {
+func (x *UpdateRedirectRuleResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *GetURLResponse) GetUrl() *URL {
+func (x *UpdateRedirectRuleResponse) GetRule() *RedirectRule {
 	if x != nil {
-		return x.Url
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *GetURLResponse) GetPasswordRequired() bool {
-	if x != nil {
-		return x.PasswordRequired
-	}
-	return false
-}
-
-type UpdateURLRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // Short code to update
-	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
-	// Optional fields to update
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Password      *string                `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"` // Set/change/remove password
-	IsActive      *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+type DeleteRedirectRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UrlId         string                 `protobuf:"bytes,1,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateURLRequest) Reset() {
-	*x = UpdateURLRequest{}
-	mi := &file_url_url_service_proto_msgTypes[5]
+func (x *DeleteRedirectRuleRequest) Reset() {
+	*x = DeleteRedirectRuleRequest{}
+	mi := &file_url_url_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateURLRequest) String() string {
+func (x *DeleteRedirectRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateURLRequest) ProtoMessage() {}
+func (*DeleteRedirectRuleRequest) ProtoMessage() {}
 
-func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[5]
+func (x *DeleteRedirectRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,90 +2393,54 @@ func (x *UpdateURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateURLRequest.ProtoReflect.Descriptor instead.
-func (*UpdateURLRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeleteRedirectRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRedirectRuleRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateURLRequest) GetId() string {
+func (x *DeleteRedirectRuleRequest) GetUrlId() string {
 	if x != nil {
-		return x.Id
+		return x.UrlId
 	}
 	return ""
 }
 
-func (x *UpdateURLRequest) GetUserId() string {
+func (x *DeleteRedirectRuleRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateURLRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdateURLRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateURLRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
-}
-
-func (x *UpdateURLRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
-	}
-	return false
-}
-
-func (x *UpdateURLRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *UpdateURLRequest) GetExpiresAt() *timestamppb.Timestamp {
+func (x *DeleteRedirectRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.RuleId
 	}
-	return nil
+	return ""
 }
 
-type UpdateURLResponse struct {
+type DeleteRedirectRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Url           *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateURLResponse) Reset() {
-	*x = UpdateURLResponse{}
-	mi := &file_url_url_service_proto_msgTypes[6]
+func (x *DeleteRedirectRuleResponse) Reset() {
+	*x = DeleteRedirectRuleResponse{}
+	mi := &file_url_url_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateURLResponse) String() string {
+func (x *DeleteRedirectRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateURLResponse) ProtoMessage() {}
+func (*DeleteRedirectRuleResponse) ProtoMessage() {}
 
-func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[6]
+func (x *DeleteRedirectRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,48 +2451,47 @@ func (x *UpdateURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateURLResponse.ProtoReflect.Descriptor instead.
-func (*UpdateURLResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DeleteRedirectRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRedirectRuleResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateURLResponse) GetStatus() *common.Response {
+func (x *DeleteRedirectRuleResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *UpdateURLResponse) GetUrl() *URL {
-	if x != nil {
-		return x.Url
-	}
-	return nil
-}
-
-type DeleteURLRequest struct {
+// QR code of a short URL, encoding its short_url with a src=qr marker
+type GetQRCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner or be admin
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`         // "png" (default) or "svg"
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`            // Pixels per side, defaults to the configured size
+	Level         string                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`           // Error correction "L", "M", "Q" or "H"
+	Foreground    string                 `protobuf:"bytes,5,opt,name=foreground,proto3" json:"foreground,omitempty"` // Hex colour, defaults to "#000000"
+	Background    string                 `protobuf:"bytes,6,opt,name=background,proto3" json:"background,omitempty"` // Hex colour, defaults to "#ffffff"
+	Logo          bool                   `protobuf:"varint,7,opt,name=logo,proto3" json:"logo,omitempty"`            // Overlay the configured logo, forces level "H"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteURLRequest) Reset() {
-	*x = DeleteURLRequest{}
-	mi := &file_url_url_service_proto_msgTypes[7]
+func (x *GetQRCodeRequest) Reset() {
+	*x = GetQRCodeRequest{}
+	mi := &file_url_url_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteURLRequest) String() string {
+func (x *GetQRCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteURLRequest) ProtoMessage() {}
+func (*GetQRCodeRequest) ProtoMessage() {}
 
-func (x *DeleteURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[7]
+func (x *GetQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,47 +2502,84 @@ func (x *DeleteURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteURLRequest.ProtoReflect.Descriptor instead.
-func (*DeleteURLRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeleteURLRequest) GetId() string {
+func (x *GetQRCodeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteURLRequest) GetUserId() string {
+func (x *GetQRCodeRequest) GetFormat() string {
 	if x != nil {
-		return x.UserId
+		return x.Format
 	}
 	return ""
 }
 
-type DeleteURLResponse struct {
+func (x *GetQRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetQRCodeRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetForeground() string {
+	if x != nil {
+		return x.Foreground
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetBackground() string {
+	if x != nil {
+		return x.Background
+	}
+	return ""
+}
+
+func (x *GetQRCodeRequest) GetLogo() bool {
+	if x != nil {
+		return x.Logo
+	}
+	return false
+}
+
+type GetQRCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // "image/png" or "image/svg+xml"
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteURLResponse) Reset() {
-	*x = DeleteURLResponse{}
-	mi := &file_url_url_service_proto_msgTypes[8]
+func (x *GetQRCodeResponse) Reset() {
+	*x = GetQRCodeResponse{}
+	mi := &file_url_url_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteURLResponse) String() string {
+func (x *GetQRCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteURLResponse) ProtoMessage() {}
+func (*GetQRCodeResponse) ProtoMessage() {}
 
-func (x *DeleteURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[8]
+func (x *GetQRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,45 +2590,55 @@ func (x *DeleteURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteURLResponse.ProtoReflect.Descriptor instead.
-func (*DeleteURLResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetQRCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetQRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteURLResponse) GetStatus() *common.Response {
+func (x *GetQRCodeResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-// List URLs for a user
-type ListURLsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	UserId        string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Search        string                    `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`                            // Search in title, description, tags
-	Tags          []string                  `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                // Filter by tags
-	ActiveOnly    bool                      `protobuf:"varint,5,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Show only active URLs
+func (x *GetQRCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetQRCodeResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Custom domains
+type ListDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListURLsRequest) Reset() {
-	*x = ListURLsRequest{}
-	mi := &file_url_url_service_proto_msgTypes[9]
+func (x *ListDomainsRequest) Reset() {
+	*x = ListDomainsRequest{}
+	mi := &file_url_url_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListURLsRequest) String() string {
+func (x *ListDomainsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListURLsRequest) ProtoMessage() {}
+func (*ListDomainsRequest) ProtoMessage() {}
 
-func (x *ListURLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[9]
+func (x *ListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,70 +2649,41 @@ func (x *ListURLsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListURLsRequest.ProtoReflect.Descriptor instead.
-func (*ListURLsRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListURLsRequest) GetUserId() string {
+func (x *ListDomainsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
-
-func (x *ListURLsRequest) GetPagination() *common.PaginationRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *ListURLsRequest) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
-}
-
-func (x *ListURLsRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *ListURLsRequest) GetActiveOnly() bool {
-	if x != nil {
-		return x.ActiveOnly
-	}
-	return false
-}
-
-type ListURLsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Status        *common.Response           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Urls          []*URL                     `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
-	Pagination    *common.PaginationResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+
+type ListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Domains       []*Domain              `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListURLsResponse) Reset() {
-	*x = ListURLsResponse{}
-	mi := &file_url_url_service_proto_msgTypes[10]
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	mi := &file_url_url_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListURLsResponse) String() string {
+func (x *ListDomainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListURLsResponse) ProtoMessage() {}
+func (*ListDomainsResponse) ProtoMessage() {}
 
-func (x *ListURLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[10]
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,55 +2694,48 @@ func (x *ListURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListURLsResponse.ProtoReflect.Descriptor instead.
-func (*ListURLsResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListURLsResponse) GetStatus() *common.Response {
+func (x *ListDomainsResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *ListURLsResponse) GetUrls() []*URL {
-	if x != nil {
-		return x.Urls
-	}
-	return nil
-}
-
-func (x *ListURLsResponse) GetPagination() *common.PaginationResponse {
+func (x *ListDomainsResponse) GetDomains() []*Domain {
 	if x != nil {
-		return x.Pagination
+		return x.Domains
 	}
 	return nil
 }
 
-// Validate URL request (check if URL is valid and accessible)
-type ValidateURLRequest struct {
+type AddDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"` // e.g. "go.example.com", claimable until another user verifies it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateURLRequest) Reset() {
-	*x = ValidateURLRequest{}
-	mi := &file_url_url_service_proto_msgTypes[11]
+func (x *AddDomainRequest) Reset() {
+	*x = AddDomainRequest{}
+	mi := &file_url_url_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateURLRequest) String() string {
+func (x *AddDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateURLRequest) ProtoMessage() {}
+func (*AddDomainRequest) ProtoMessage() {}
 
-func (x *ValidateURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[11]
+func (x *AddDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,45 +2746,48 @@ func (x *ValidateURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateURLRequest.ProtoReflect.Descriptor instead.
-func (*ValidateURLRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AddDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ValidateURLRequest) GetUrl() string {
+func (x *AddDomainRequest) GetUserId() string {
 	if x != nil {
-		return x.Url
+		return x.UserId
 	}
 	return ""
 }
 
-type ValidateURLResponse struct {
+func (x *AddDomainRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type AddDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IsValid       bool                   `protobuf:"varint,2,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	IsAccessible  bool                   `protobuf:"varint,3,opt,name=is_accessible,json=isAccessible,proto3" json:"is_accessible,omitempty"`   // Can be reached via HTTP
-	DetectedTitle string                 `protobuf:"bytes,4,opt,name=detected_title,json=detectedTitle,proto3" json:"detected_title,omitempty"` // Scraped page title
-	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`       // MIME type
-	StatusCode    int32                  `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`         // HTTP status code
+	Domain        *Domain                `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateURLResponse) Reset() {
-	*x = ValidateURLResponse{}
-	mi := &file_url_url_service_proto_msgTypes[12]
+func (x *AddDomainResponse) Reset() {
+	*x = AddDomainResponse{}
+	mi := &file_url_url_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateURLResponse) String() string {
+func (x *AddDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateURLResponse) ProtoMessage() {}
+func (*AddDomainResponse) ProtoMessage() {}
 
-func (x *ValidateURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[12]
+func (x *AddDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,76 +2798,48 @@ func (x *ValidateURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateURLResponse.ProtoReflect.Descriptor instead.
-func (*ValidateURLResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AddDomainResponse.ProtoReflect.Descriptor instead.
+func (*AddDomainResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ValidateURLResponse) GetStatus() *common.Response {
+func (x *AddDomainResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *ValidateURLResponse) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
-}
-
-func (x *ValidateURLResponse) GetIsAccessible() bool {
-	if x != nil {
-		return x.IsAccessible
-	}
-	return false
-}
-
-func (x *ValidateURLResponse) GetDetectedTitle() string {
-	if x != nil {
-		return x.DetectedTitle
-	}
-	return ""
-}
-
-func (x *ValidateURLResponse) GetContentType() string {
-	if x != nil {
-		return x.ContentType
-	}
-	return ""
-}
-
-func (x *ValidateURLResponse) GetStatusCode() int32 {
+func (x *AddDomainResponse) GetDomain() *Domain {
 	if x != nil {
-		return x.StatusCode
+		return x.Domain
 	}
-	return 0
+	return nil
 }
 
-// Check if custom code is available
-type CheckAvailabilityRequest struct {
+type VerifyDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomCode    string                 `protobuf:"bytes,1,opt,name=custom_code,json=customCode,proto3" json:"custom_code,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAvailabilityRequest) Reset() {
-	*x = CheckAvailabilityRequest{}
-	mi := &file_url_url_service_proto_msgTypes[13]
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	mi := &file_url_url_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityRequest) String() string {
+func (x *VerifyDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityRequest) ProtoMessage() {}
+func (*VerifyDomainRequest) ProtoMessage() {}
 
-func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[13]
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,42 +2850,48 @@ func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CheckAvailabilityRequest) GetCustomCode() string {
+func (x *VerifyDomainRequest) GetUserId() string {
 	if x != nil {
-		return x.CustomCode
+		return x.UserId
 	}
 	return ""
 }
 
-type CheckAvailabilityResponse struct {
+func (x *VerifyDomainRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type VerifyDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IsAvailable   bool                   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
-	Suggestions   []string               `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // Alternative suggestions if not available
+	Domain        *Domain                `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"` // Fails with DOMAIN_NOT_VERIFIED until the TXT record is visible
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAvailabilityResponse) Reset() {
-	*x = CheckAvailabilityResponse{}
-	mi := &file_url_url_service_proto_msgTypes[14]
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	mi := &file_url_url_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityResponse) String() string {
+func (x *VerifyDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityResponse) ProtoMessage() {}
+func (*VerifyDomainResponse) ProtoMessage() {}
 
-func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[14]
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,56 +2902,49 @@ func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CheckAvailabilityResponse) GetStatus() *common.Response {
+func (x *VerifyDomainResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *CheckAvailabilityResponse) GetIsAvailable() bool {
-	if x != nil {
-		return x.IsAvailable
-	}
-	return false
-}
-
-func (x *CheckAvailabilityResponse) GetSuggestions() []string {
+func (x *VerifyDomainResponse) GetDomain() *Domain {
 	if x != nil {
-		return x.Suggestions
+		return x.Domain
 	}
 	return nil
 }
 
-// Bulk operations for premium users
-type BulkCreateURLRequest struct {
+// Edit history
+type ListURLRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Urls          []*CreateURLRequest    `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must match owner
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateURLRequest) Reset() {
-	*x = BulkCreateURLRequest{}
-	mi := &file_url_url_service_proto_msgTypes[15]
+func (x *ListURLRevisionsRequest) Reset() {
+	*x = ListURLRevisionsRequest{}
+	mi := &file_url_url_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateURLRequest) String() string {
+func (x *ListURLRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateURLRequest) ProtoMessage() {}
+func (*ListURLRevisionsRequest) ProtoMessage() {}
 
-func (x *BulkCreateURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[15]
+func (x *ListURLRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,49 +2955,48 @@ func (x *BulkCreateURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateURLRequest.ProtoReflect.Descriptor instead.
-func (*BulkCreateURLRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListURLRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListURLRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *BulkCreateURLRequest) GetUrls() []*CreateURLRequest {
+func (x *ListURLRevisionsRequest) GetId() string {
 	if x != nil {
-		return x.Urls
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *BulkCreateURLRequest) GetUserId() string {
+func (x *ListURLRevisionsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type BulkCreateURLResponse struct {
+type ListURLRevisionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Urls          []*URL                 `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
-	Errors        []*common.Error        `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"` // Per-URL errors
+	Revisions     []*URLRevision         `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"` // Newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateURLResponse) Reset() {
-	*x = BulkCreateURLResponse{}
-	mi := &file_url_url_service_proto_msgTypes[16]
+func (x *ListURLRevisionsResponse) Reset() {
+	*x = ListURLRevisionsResponse{}
+	mi := &file_url_url_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateURLResponse) String() string {
+func (x *ListURLRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateURLResponse) ProtoMessage() {}
+func (*ListURLRevisionsResponse) ProtoMessage() {}
 
-func (x *BulkCreateURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[16]
+func (x *ListURLRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,58 +3007,50 @@ func (x *BulkCreateURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateURLResponse.ProtoReflect.Descriptor instead.
-func (*BulkCreateURLResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListURLRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListURLRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BulkCreateURLResponse) GetStatus() *common.Response {
+func (x *ListURLRevisionsResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *BulkCreateURLResponse) GetUrls() []*URL {
-	if x != nil {
-		return x.Urls
-	}
-	return nil
-}
-
-func (x *BulkCreateURLResponse) GetErrors() []*common.Error {
+func (x *ListURLRevisionsResponse) GetRevisions() []*URLRevision {
 	if x != nil {
-		return x.Errors
+		return x.Revisions
 	}
 	return nil
 }
 
-// Analytics integration - increment click count
-type IncrementClickRequest struct {
+type RestoreURLRevisionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UrlId         string                 `protobuf:"bytes,1,opt,name=url_id,json=urlId,proto3" json:"url_id,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	Referrer      string                 `protobuf:"bytes,4,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // Must match owner
+	RevisionId    int64                  `protobuf:"varint,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"` // Fields return to their values right after this revision
+	UserContext   *common.UserContext    `protobuf:"bytes,4,opt,name=user_context,json=userContext,proto3" json:"user_context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncrementClickRequest) Reset() {
-	*x = IncrementClickRequest{}
-	mi := &file_url_url_service_proto_msgTypes[17]
+func (x *RestoreURLRevisionRequest) Reset() {
+	*x = RestoreURLRevisionRequest{}
+	mi := &file_url_url_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncrementClickRequest) String() string {
+func (x *RestoreURLRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementClickRequest) ProtoMessage() {}
+func (*RestoreURLRevisionRequest) ProtoMessage() {}
 
-func (x *IncrementClickRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[17]
+func (x *RestoreURLRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,62 +3061,62 @@ func (x *IncrementClickRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementClickRequest.ProtoReflect.Descriptor instead.
-func (*IncrementClickRequest) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RestoreURLRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreURLRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *IncrementClickRequest) GetUrlId() string {
+func (x *RestoreURLRevisionRequest) GetId() string {
 	if x != nil {
-		return x.UrlId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *IncrementClickRequest) GetUserAgent() string {
+func (x *RestoreURLRevisionRequest) GetUserId() string {
 	if x != nil {
-		return x.UserAgent
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *IncrementClickRequest) GetIpAddress() string {
+func (x *RestoreURLRevisionRequest) GetRevisionId() int64 {
 	if x != nil {
-		return x.IpAddress
+		return x.RevisionId
 	}
-	return ""
+	return 0
 }
 
-func (x *IncrementClickRequest) GetReferrer() string {
+func (x *RestoreURLRevisionRequest) GetUserContext() *common.UserContext {
 	if x != nil {
-		return x.Referrer
+		return x.UserContext
 	}
-	return ""
+	return nil
 }
 
-type IncrementClickResponse struct {
+type RestoreURLRevisionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *common.Response       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	NewCount      int64                  `protobuf:"varint,2,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	Url           *URL                   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncrementClickResponse) Reset() {
-	*x = IncrementClickResponse{}
-	mi := &file_url_url_service_proto_msgTypes[18]
+func (x *RestoreURLRevisionResponse) Reset() {
+	*x = RestoreURLRevisionResponse{}
+	mi := &file_url_url_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncrementClickResponse) String() string {
+func (x *RestoreURLRevisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementClickResponse) ProtoMessage() {}
+func (*RestoreURLRevisionResponse) ProtoMessage() {}
 
-func (x *IncrementClickResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_url_url_service_proto_msgTypes[18]
+func (x *RestoreURLRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_url_url_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,266 +3127,605 @@ func (x *IncrementClickResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementClickResponse.ProtoReflect.Descriptor instead.
-func (*IncrementClickResponse) Descriptor() ([]byte, []int) {
-	return file_url_url_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RestoreURLRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreURLRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_url_url_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *IncrementClickResponse) GetStatus() *common.Response {
+func (x *RestoreURLRevisionResponse) GetStatus() *common.Response {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *IncrementClickResponse) GetNewCount() int64 {
+func (x *RestoreURLRevisionResponse) GetUrl() *URL {
 	if x != nil {
-		return x.NewCount
+		return x.Url
 	}
-	return 0
+	return nil
 }
 
 var File_url_url_service_proto protoreflect.FileDescriptor
 
 var file_url_url_service_proto_rawDesc = string([]byte{
 	0x0a, 0x15, 0x75, 0x72, 0x6c, 0x2f, 0x75, 0x72, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x1f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xa3, 0x04, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x20, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0d,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x73, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x72, 0x6c, 0x2e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x97, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf1,
+	0x02, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x2f, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d,
+	0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a,
+	0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x22, 0xb2, 0x03, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12,
+	0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x73, 0x41, 0x74, 0x12, 0x28,
+	0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x22, 0x59, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75,
+	0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x60, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x83, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52,
+	0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0xb8, 0x05, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x12, 0x42,
+	0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x06, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x73, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0c,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x59, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x59,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c,
+	0x2e, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x3b, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52,
+	0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x35, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x73, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52,
+	0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xe1, 0x02, 0x0a, 0x13,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x22,
+	0x76, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x72,
+	0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x36, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
+	0x5f, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22,
+	0x86, 0x01, 0x0a, 0x15, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x12, 0x25, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x72, 0x22, 0x5f, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x6e, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0xa0, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75,
+	0x72, 0x6c, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55,
-	0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x52,
-	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
-	0xd8, 0x02, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x72, 0x6c, 0x2e,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x22, 0x64, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x75, 0x72, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xb8, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x02, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x59, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x22, 0x74, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x3b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x3d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x2d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x66, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x25, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x07,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x62, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x22, 0x4a, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x65, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x42, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75,
-	0x72, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x26, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x64,
-	0x65, 0x22, 0x8a, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x62, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a,
-	0x0a, 0x14, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x75, 0x72, 0x6c,
-	0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x42,
-	0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
-	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75,
-	0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x06,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
-	0x06, 0x75, 0x72, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75,
-	0x72, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x22, 0x5f,
-	0x0a, 0x16, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32,
-	0x9d, 0x05, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a,
-	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x15, 0x2e, 0x75, 0x72,
-	0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x47, 0x65,
-	0x74, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x52,
-	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x15, 0x2e, 0x75, 0x72, 0x6c,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52,
-	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c,
-	0x73, 0x12, 0x14, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e,
-	0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x52, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e,
-	0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x12, 0x1a,
-	0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c,
-	0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x75, 0x72, 0x6c,
-	0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x72,
-	0x6c, 0x2d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2d, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x75, 0x72, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x52, 0x4c,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x32, 0x9d, 0x0b, 0x0a, 0x0a, 0x55, 0x52, 0x4c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52,
+	0x4c, 0x12, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x12, 0x2e, 0x75, 0x72, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c,
+	0x12, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x15, 0x2e, 0x75,
+	0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x14, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x75,
+	0x72, 0x6c, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x75, 0x72,
+	0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x72, 0x6c,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x19, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x41, 0x64, 0x64,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x75, 0x72, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x75,
+	0x72, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6c, 0x69, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x75, 0x72, 0x6c, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x72, 0x6c, 0x2d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x75, 0x72, 0x6c, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1565,85 +3740,174 @@ func file_url_url_service_proto_rawDescGZIP() []byte {
 	return file_url_url_service_proto_rawDescData
 }
 
-var file_url_url_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_url_url_service_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_url_url_service_proto_goTypes = []any{
 	(*URL)(nil),                        // 0: url.URL
-	(*CreateURLRequest)(nil),           // 1: url.CreateURLRequest
-	(*CreateURLResponse)(nil),          // 2: url.CreateURLResponse
-	(*GetURLRequest)(nil),              // 3: url.GetURLRequest
-	(*GetURLResponse)(nil),             // 4: url.GetURLResponse
-	(*UpdateURLRequest)(nil),           // 5: url.UpdateURLRequest
-	(*UpdateURLResponse)(nil),          // 6: url.UpdateURLResponse
-	(*DeleteURLRequest)(nil),           // 7: url.DeleteURLRequest
-	(*DeleteURLResponse)(nil),          // 8: url.DeleteURLResponse
-	(*ListURLsRequest)(nil),            // 9: url.ListURLsRequest
-	(*ListURLsResponse)(nil),           // 10: url.ListURLsResponse
-	(*ValidateURLRequest)(nil),         // 11: url.ValidateURLRequest
-	(*ValidateURLResponse)(nil),        // 12: url.ValidateURLResponse
-	(*CheckAvailabilityRequest)(nil),   // 13: url.CheckAvailabilityRequest
-	(*CheckAvailabilityResponse)(nil),  // 14: url.CheckAvailabilityResponse
-	(*BulkCreateURLRequest)(nil),       // 15: url.BulkCreateURLRequest
-	(*BulkCreateURLResponse)(nil),      // 16: url.BulkCreateURLResponse
-	(*IncrementClickRequest)(nil),      // 17: url.IncrementClickRequest
-	(*IncrementClickResponse)(nil),     // 18: url.IncrementClickResponse
-	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
-	(*common.Response)(nil),            // 20: common.Response
-	(*common.PaginationRequest)(nil),   // 21: common.PaginationRequest
-	(*common.PaginationResponse)(nil),  // 22: common.PaginationResponse
-	(*common.Error)(nil),               // 23: common.Error
-	(*common.HealthCheckRequest)(nil),  // 24: common.HealthCheckRequest
-	(*common.HealthCheckResponse)(nil), // 25: common.HealthCheckResponse
+	(*Variant)(nil),                    // 1: url.Variant
+	(*RedirectRule)(nil),               // 2: url.RedirectRule
+	(*Domain)(nil),                     // 3: url.Domain
+	(*URLRevision)(nil),                // 4: url.URLRevision
+	(*FieldChange)(nil),                // 5: url.FieldChange
+	(*TimeWindow)(nil),                 // 6: url.TimeWindow
+	(*CreateURLRequest)(nil),           // 7: url.CreateURLRequest
+	(*CreateURLResponse)(nil),          // 8: url.CreateURLResponse
+	(*GetURLRequest)(nil),              // 9: url.GetURLRequest
+	(*GetURLResponse)(nil),             // 10: url.GetURLResponse
+	(*UpdateURLRequest)(nil),           // 11: url.UpdateURLRequest
+	(*UpdateURLResponse)(nil),          // 12: url.UpdateURLResponse
+	(*DeleteURLRequest)(nil),           // 13: url.DeleteURLRequest
+	(*DeleteURLResponse)(nil),          // 14: url.DeleteURLResponse
+	(*ListURLsRequest)(nil),            // 15: url.ListURLsRequest
+	(*ListURLsResponse)(nil),           // 16: url.ListURLsResponse
+	(*ValidateURLRequest)(nil),         // 17: url.ValidateURLRequest
+	(*ValidateURLResponse)(nil),        // 18: url.ValidateURLResponse
+	(*CheckAvailabilityRequest)(nil),   // 19: url.CheckAvailabilityRequest
+	(*CheckAvailabilityResponse)(nil),  // 20: url.CheckAvailabilityResponse
+	(*BulkCreateURLRequest)(nil),       // 21: url.BulkCreateURLRequest
+	(*BulkCreateURLResponse)(nil),      // 22: url.BulkCreateURLResponse
+	(*IncrementClickRequest)(nil),      // 23: url.IncrementClickRequest
+	(*IncrementClickResponse)(nil),     // 24: url.IncrementClickResponse
+	(*ListRedirectRulesRequest)(nil),   // 25: url.ListRedirectRulesRequest
+	(*ListRedirectRulesResponse)(nil),  // 26: url.ListRedirectRulesResponse
+	(*CreateRedirectRuleRequest)(nil),  // 27: url.CreateRedirectRuleRequest
+	(*CreateRedirectRuleResponse)(nil), // 28: url.CreateRedirectRuleResponse
+	(*UpdateRedirectRuleRequest)(nil),  // 29: url.UpdateRedirectRuleRequest
+	(*UpdateRedirectRuleResponse)(nil), // 30: url.UpdateRedirectRuleResponse
+	(*DeleteRedirectRuleRequest)(nil),  // 31: url.DeleteRedirectRuleRequest
+	(*DeleteRedirectRuleResponse)(nil), // 32: url.DeleteRedirectRuleResponse
+	(*GetQRCodeRequest)(nil),           // 33: url.GetQRCodeRequest
+	(*GetQRCodeResponse)(nil),          // 34: url.GetQRCodeResponse
+	(*ListDomainsRequest)(nil),         // 35: url.ListDomainsRequest
+	(*ListDomainsResponse)(nil),        // 36: url.ListDomainsResponse
+	(*AddDomainRequest)(nil),           // 37: url.AddDomainRequest
+	(*AddDomainResponse)(nil),          // 38: url.AddDomainResponse
+	(*VerifyDomainRequest)(nil),        // 39: url.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),       // 40: url.VerifyDomainResponse
+	(*ListURLRevisionsRequest)(nil),    // 41: url.ListURLRevisionsRequest
+	(*ListURLRevisionsResponse)(nil),   // 42: url.ListURLRevisionsResponse
+	(*RestoreURLRevisionRequest)(nil),  // 43: url.RestoreURLRevisionRequest
+	(*RestoreURLRevisionResponse)(nil), // 44: url.RestoreURLRevisionResponse
+	(*timestamppb.Timestamp)(nil),      // 45: google.protobuf.Timestamp
+	(*common.Response)(nil),            // 46: common.Response
+	(*common.UserContext)(nil),         // 47: common.UserContext
+	(*fieldmaskpb.FieldMask)(nil),      // 48: google.protobuf.FieldMask
+	(*common.PaginationRequest)(nil),   // 49: common.PaginationRequest
+	(*common.DateFilter)(nil),          // 50: common.DateFilter
+	(*common.PaginationResponse)(nil),  // 51: common.PaginationResponse
+	(*common.Error)(nil),               // 52: common.Error
+	(*common.HealthCheckRequest)(nil),  // 53: common.HealthCheckRequest
+	(*common.HealthCheckResponse)(nil), // 54: common.HealthCheckResponse
 }
 var file_url_url_service_proto_depIdxs = []int32{
-	19, // 0: url.URL.created_at:type_name -> google.protobuf.Timestamp
-	19, // 1: url.URL.expires_at:type_name -> google.protobuf.Timestamp
-	19, // 2: url.URL.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 3: url.URL.last_accessed:type_name -> google.protobuf.Timestamp
-	19, // 4: url.CreateURLRequest.expires_at:type_name -> google.protobuf.Timestamp
-	20, // 5: url.CreateURLResponse.status:type_name -> common.Response
-	0,  // 6: url.CreateURLResponse.url:type_name -> url.URL
-	20, // 7: url.GetURLResponse.status:type_name -> common.Response
-	0,  // 8: url.GetURLResponse.url:type_name -> url.URL
-	19, // 9: url.UpdateURLRequest.expires_at:type_name -> google.protobuf.Timestamp
-	20, // 10: url.UpdateURLResponse.status:type_name -> common.Response
-	0,  // 11: url.UpdateURLResponse.url:type_name -> url.URL
-	20, // 12: url.DeleteURLResponse.status:type_name -> common.Response
-	21, // 13: url.ListURLsRequest.pagination:type_name -> common.PaginationRequest
-	20, // 14: url.ListURLsResponse.status:type_name -> common.Response
-	0,  // 15: url.ListURLsResponse.urls:type_name -> url.URL
-	22, // 16: url.ListURLsResponse.pagination:type_name -> common.PaginationResponse
-	20, // 17: url.ValidateURLResponse.status:type_name -> common.Response
-	20, // 18: url.CheckAvailabilityResponse.status:type_name -> common.Response
-	1,  // 19: url.BulkCreateURLRequest.urls:type_name -> url.CreateURLRequest
-	20, // 20: url.BulkCreateURLResponse.status:type_name -> common.Response
-	0,  // 21: url.BulkCreateURLResponse.urls:type_name -> url.URL
-	23, // 22: url.BulkCreateURLResponse.errors:type_name -> common.Error
-	20, // 23: url.IncrementClickResponse.status:type_name -> common.Response
-	1,  // 24: url.URLService.CreateURL:input_type -> url.CreateURLRequest
-	3,  // 25: url.URLService.GetURL:input_type -> url.GetURLRequest
-	5,  // 26: url.URLService.UpdateURL:input_type -> url.UpdateURLRequest
-	7,  // 27: url.URLService.DeleteURL:input_type -> url.DeleteURLRequest
-	9,  // 28: url.URLService.ListURLs:input_type -> url.ListURLsRequest
-	11, // 29: url.URLService.ValidateURL:input_type -> url.ValidateURLRequest
-	13, // 30: url.URLService.CheckAvailability:input_type -> url.CheckAvailabilityRequest
-	15, // 31: url.URLService.BulkCreateURL:input_type -> url.BulkCreateURLRequest
-	17, // 32: url.URLService.IncrementClick:input_type -> url.IncrementClickRequest
-	24, // 33: url.URLService.HealthCheck:input_type -> common.HealthCheckRequest
-	2,  // 34: url.URLService.CreateURL:output_type -> url.CreateURLResponse
-	4,  // 35: url.URLService.GetURL:output_type -> url.GetURLResponse
-	6,  // 36: url.URLService.UpdateURL:output_type -> url.UpdateURLResponse
-	8,  // 37: url.URLService.DeleteURL:output_type -> url.DeleteURLResponse
-	10, // 38: url.URLService.ListURLs:output_type -> url.ListURLsResponse
-	12, // 39: url.URLService.ValidateURL:output_type -> url.ValidateURLResponse
-	14, // 40: url.URLService.CheckAvailability:output_type -> url.CheckAvailabilityResponse
-	16, // 41: url.URLService.BulkCreateURL:output_type -> url.BulkCreateURLResponse
-	18, // 42: url.URLService.IncrementClick:output_type -> url.IncrementClickResponse
-	25, // 43: url.URLService.HealthCheck:output_type -> common.HealthCheckResponse
-	34, // [34:44] is the sub-list for method output_type
-	24, // [24:34] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	45, // 0: url.URL.created_at:type_name -> google.protobuf.Timestamp
+	45, // 1: url.URL.expires_at:type_name -> google.protobuf.Timestamp
+	45, // 2: url.URL.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 3: url.URL.last_accessed:type_name -> google.protobuf.Timestamp
+	45, // 4: url.URL.activates_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: url.URL.variants:type_name -> url.Variant
+	6,  // 6: url.RedirectRule.time_window:type_name -> url.TimeWindow
+	45, // 7: url.RedirectRule.created_at:type_name -> google.protobuf.Timestamp
+	45, // 8: url.RedirectRule.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 9: url.Domain.created_at:type_name -> google.protobuf.Timestamp
+	45, // 10: url.Domain.verified_at:type_name -> google.protobuf.Timestamp
+	45, // 11: url.Domain.checked_at:type_name -> google.protobuf.Timestamp
+	5,  // 12: url.URLRevision.changes:type_name -> url.FieldChange
+	45, // 13: url.URLRevision.created_at:type_name -> google.protobuf.Timestamp
+	45, // 14: url.CreateURLRequest.expires_at:type_name -> google.protobuf.Timestamp
+	45, // 15: url.CreateURLRequest.activates_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: url.CreateURLRequest.variants:type_name -> url.Variant
+	46, // 17: url.CreateURLResponse.status:type_name -> common.Response
+	0,  // 18: url.CreateURLResponse.url:type_name -> url.URL
+	46, // 19: url.GetURLResponse.status:type_name -> common.Response
+	0,  // 20: url.GetURLResponse.url:type_name -> url.URL
+	45, // 21: url.UpdateURLRequest.expires_at:type_name -> google.protobuf.Timestamp
+	45, // 22: url.UpdateURLRequest.activates_at:type_name -> google.protobuf.Timestamp
+	1,  // 23: url.UpdateURLRequest.variants:type_name -> url.Variant
+	47, // 24: url.UpdateURLRequest.user_context:type_name -> common.UserContext
+	48, // 25: url.UpdateURLRequest.update_mask:type_name -> google.protobuf.FieldMask
+	46, // 26: url.UpdateURLResponse.status:type_name -> common.Response
+	0,  // 27: url.UpdateURLResponse.url:type_name -> url.URL
+	46, // 28: url.DeleteURLResponse.status:type_name -> common.Response
+	49, // 29: url.ListURLsRequest.pagination:type_name -> common.PaginationRequest
+	50, // 30: url.ListURLsRequest.activates_at:type_name -> common.DateFilter
+	46, // 31: url.ListURLsResponse.status:type_name -> common.Response
+	0,  // 32: url.ListURLsResponse.urls:type_name -> url.URL
+	51, // 33: url.ListURLsResponse.pagination:type_name -> common.PaginationResponse
+	46, // 34: url.ValidateURLResponse.status:type_name -> common.Response
+	46, // 35: url.CheckAvailabilityResponse.status:type_name -> common.Response
+	7,  // 36: url.BulkCreateURLRequest.urls:type_name -> url.CreateURLRequest
+	47, // 37: url.BulkCreateURLRequest.user_context:type_name -> common.UserContext
+	46, // 38: url.BulkCreateURLResponse.status:type_name -> common.Response
+	0,  // 39: url.BulkCreateURLResponse.urls:type_name -> url.URL
+	52, // 40: url.BulkCreateURLResponse.errors:type_name -> common.Error
+	46, // 41: url.IncrementClickResponse.status:type_name -> common.Response
+	46, // 42: url.ListRedirectRulesResponse.status:type_name -> common.Response
+	2,  // 43: url.ListRedirectRulesResponse.rules:type_name -> url.RedirectRule
+	2,  // 44: url.CreateRedirectRuleRequest.rule:type_name -> url.RedirectRule
+	46, // 45: url.CreateRedirectRuleResponse.status:type_name -> common.Response
+	2,  // 46: url.CreateRedirectRuleResponse.rule:type_name -> url.RedirectRule
+	2,  // 47: url.UpdateRedirectRuleRequest.rule:type_name -> url.RedirectRule
+	48, // 48: url.UpdateRedirectRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	46, // 49: url.UpdateRedirectRuleResponse.status:type_name -> common.Response
+	2,  // 50: url.UpdateRedirectRuleResponse.rule:type_name -> url.RedirectRule
+	46, // 51: url.DeleteRedirectRuleResponse.status:type_name -> common.Response
+	46, // 52: url.GetQRCodeResponse.status:type_name -> common.Response
+	46, // 53: url.ListDomainsResponse.status:type_name -> common.Response
+	3,  // 54: url.ListDomainsResponse.domains:type_name -> url.Domain
+	46, // 55: url.AddDomainResponse.status:type_name -> common.Response
+	3,  // 56: url.AddDomainResponse.domain:type_name -> url.Domain
+	46, // 57: url.VerifyDomainResponse.status:type_name -> common.Response
+	3,  // 58: url.VerifyDomainResponse.domain:type_name -> url.Domain
+	46, // 59: url.ListURLRevisionsResponse.status:type_name -> common.Response
+	4,  // 60: url.ListURLRevisionsResponse.revisions:type_name -> url.URLRevision
+	47, // 61: url.RestoreURLRevisionRequest.user_context:type_name -> common.UserContext
+	46, // 62: url.RestoreURLRevisionResponse.status:type_name -> common.Response
+	0,  // 63: url.RestoreURLRevisionResponse.url:type_name -> url.URL
+	7,  // 64: url.URLService.CreateURL:input_type -> url.CreateURLRequest
+	9,  // 65: url.URLService.GetURL:input_type -> url.GetURLRequest
+	11, // 66: url.URLService.UpdateURL:input_type -> url.UpdateURLRequest
+	13, // 67: url.URLService.DeleteURL:input_type -> url.DeleteURLRequest
+	15, // 68: url.URLService.ListURLs:input_type -> url.ListURLsRequest
+	17, // 69: url.URLService.ValidateURL:input_type -> url.ValidateURLRequest
+	19, // 70: url.URLService.CheckAvailability:input_type -> url.CheckAvailabilityRequest
+	33, // 71: url.URLService.GetQRCode:input_type -> url.GetQRCodeRequest
+	21, // 72: url.URLService.BulkCreateURL:input_type -> url.BulkCreateURLRequest
+	25, // 73: url.URLService.ListRedirectRules:input_type -> url.ListRedirectRulesRequest
+	27, // 74: url.URLService.CreateRedirectRule:input_type -> url.CreateRedirectRuleRequest
+	29, // 75: url.URLService.UpdateRedirectRule:input_type -> url.UpdateRedirectRuleRequest
+	31, // 76: url.URLService.DeleteRedirectRule:input_type -> url.DeleteRedirectRuleRequest
+	35, // 77: url.URLService.ListDomains:input_type -> url.ListDomainsRequest
+	37, // 78: url.URLService.AddDomain:input_type -> url.AddDomainRequest
+	39, // 79: url.URLService.VerifyDomain:input_type -> url.VerifyDomainRequest
+	41, // 80: url.URLService.ListURLRevisions:input_type -> url.ListURLRevisionsRequest
+	43, // 81: url.URLService.RestoreURLRevision:input_type -> url.RestoreURLRevisionRequest
+	23, // 82: url.URLService.IncrementClick:input_type -> url.IncrementClickRequest
+	53, // 83: url.URLService.HealthCheck:input_type -> common.HealthCheckRequest
+	8,  // 84: url.URLService.CreateURL:output_type -> url.CreateURLResponse
+	10, // 85: url.URLService.GetURL:output_type -> url.GetURLResponse
+	12, // 86: url.URLService.UpdateURL:output_type -> url.UpdateURLResponse
+	14, // 87: url.URLService.DeleteURL:output_type -> url.DeleteURLResponse
+	16, // 88: url.URLService.ListURLs:output_type -> url.ListURLsResponse
+	18, // 89: url.URLService.ValidateURL:output_type -> url.ValidateURLResponse
+	20, // 90: url.URLService.CheckAvailability:output_type -> url.CheckAvailabilityResponse
+	34, // 91: url.URLService.GetQRCode:output_type -> url.GetQRCodeResponse
+	22, // 92: url.URLService.BulkCreateURL:output_type -> url.BulkCreateURLResponse
+	26, // 93: url.URLService.ListRedirectRules:output_type -> url.ListRedirectRulesResponse
+	28, // 94: url.URLService.CreateRedirectRule:output_type -> url.CreateRedirectRuleResponse
+	30, // 95: url.URLService.UpdateRedirectRule:output_type -> url.UpdateRedirectRuleResponse
+	32, // 96: url.URLService.DeleteRedirectRule:output_type -> url.DeleteRedirectRuleResponse
+	36, // 97: url.URLService.ListDomains:output_type -> url.ListDomainsResponse
+	38, // 98: url.URLService.AddDomain:output_type -> url.AddDomainResponse
+	40, // 99: url.URLService.VerifyDomain:output_type -> url.VerifyDomainResponse
+	42, // 100: url.URLService.ListURLRevisions:output_type -> url.ListURLRevisionsResponse
+	44, // 101: url.URLService.RestoreURLRevision:output_type -> url.RestoreURLRevisionResponse
+	24, // 102: url.URLService.IncrementClick:output_type -> url.IncrementClickResponse
+	54, // 103: url.URLService.HealthCheck:output_type -> common.HealthCheckResponse
+	84, // [84:104] is the sub-list for method output_type
+	64, // [64:84] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_url_url_service_proto_init() }
@@ -1651,14 +3915,16 @@ func file_url_url_service_proto_init() {
 	if File_url_url_service_proto != nil {
 		return
 	}
-	file_url_url_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_url_url_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_url_url_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_url_url_service_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_url_url_service_proto_rawDesc), len(file_url_url_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
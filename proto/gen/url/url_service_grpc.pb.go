@@ -20,16 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	URLService_CreateURL_FullMethodName         = "/url.URLService/CreateURL"
-	URLService_GetURL_FullMethodName            = "/url.URLService/GetURL"
-	URLService_UpdateURL_FullMethodName         = "/url.URLService/UpdateURL"
-	URLService_DeleteURL_FullMethodName         = "/url.URLService/DeleteURL"
-	URLService_ListURLs_FullMethodName          = "/url.URLService/ListURLs"
-	URLService_ValidateURL_FullMethodName       = "/url.URLService/ValidateURL"
-	URLService_CheckAvailability_FullMethodName = "/url.URLService/CheckAvailability"
-	URLService_BulkCreateURL_FullMethodName     = "/url.URLService/BulkCreateURL"
-	URLService_IncrementClick_FullMethodName    = "/url.URLService/IncrementClick"
-	URLService_HealthCheck_FullMethodName       = "/url.URLService/HealthCheck"
+	URLService_CreateURL_FullMethodName          = "/url.URLService/CreateURL"
+	URLService_GetURL_FullMethodName             = "/url.URLService/GetURL"
+	URLService_UpdateURL_FullMethodName          = "/url.URLService/UpdateURL"
+	URLService_DeleteURL_FullMethodName          = "/url.URLService/DeleteURL"
+	URLService_ListURLs_FullMethodName           = "/url.URLService/ListURLs"
+	URLService_ValidateURL_FullMethodName        = "/url.URLService/ValidateURL"
+	URLService_CheckAvailability_FullMethodName  = "/url.URLService/CheckAvailability"
+	URLService_GetQRCode_FullMethodName          = "/url.URLService/GetQRCode"
+	URLService_BulkCreateURL_FullMethodName      = "/url.URLService/BulkCreateURL"
+	URLService_ListRedirectRules_FullMethodName  = "/url.URLService/ListRedirectRules"
+	URLService_CreateRedirectRule_FullMethodName = "/url.URLService/CreateRedirectRule"
+	URLService_UpdateRedirectRule_FullMethodName = "/url.URLService/UpdateRedirectRule"
+	URLService_DeleteRedirectRule_FullMethodName = "/url.URLService/DeleteRedirectRule"
+	URLService_ListDomains_FullMethodName        = "/url.URLService/ListDomains"
+	URLService_AddDomain_FullMethodName          = "/url.URLService/AddDomain"
+	URLService_VerifyDomain_FullMethodName       = "/url.URLService/VerifyDomain"
+	URLService_ListURLRevisions_FullMethodName   = "/url.URLService/ListURLRevisions"
+	URLService_RestoreURLRevision_FullMethodName = "/url.URLService/RestoreURLRevision"
+	URLService_IncrementClick_FullMethodName     = "/url.URLService/IncrementClick"
+	URLService_HealthCheck_FullMethodName        = "/url.URLService/HealthCheck"
 )
 
 // URLServiceClient is the client API for URLService service.
@@ -48,8 +58,21 @@ type URLServiceClient interface {
 	// Utility operations
 	ValidateURL(ctx context.Context, in *ValidateURLRequest, opts ...grpc.CallOption) (*ValidateURLResponse, error)
 	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error)
+	GetQRCode(ctx context.Context, in *GetQRCodeRequest, opts ...grpc.CallOption) (*GetQRCodeResponse, error)
 	// Bulk operations
 	BulkCreateURL(ctx context.Context, in *BulkCreateURLRequest, opts ...grpc.CallOption) (*BulkCreateURLResponse, error)
+	// Conditional redirects
+	ListRedirectRules(ctx context.Context, in *ListRedirectRulesRequest, opts ...grpc.CallOption) (*ListRedirectRulesResponse, error)
+	CreateRedirectRule(ctx context.Context, in *CreateRedirectRuleRequest, opts ...grpc.CallOption) (*CreateRedirectRuleResponse, error)
+	UpdateRedirectRule(ctx context.Context, in *UpdateRedirectRuleRequest, opts ...grpc.CallOption) (*UpdateRedirectRuleResponse, error)
+	DeleteRedirectRule(ctx context.Context, in *DeleteRedirectRuleRequest, opts ...grpc.CallOption) (*DeleteRedirectRuleResponse, error)
+	// Custom domains
+	ListDomains(ctx context.Context, in *ListDomainsRequest, opts ...grpc.CallOption) (*ListDomainsResponse, error)
+	AddDomain(ctx context.Context, in *AddDomainRequest, opts ...grpc.CallOption) (*AddDomainResponse, error)
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error)
+	// Edit history
+	ListURLRevisions(ctx context.Context, in *ListURLRevisionsRequest, opts ...grpc.CallOption) (*ListURLRevisionsResponse, error)
+	RestoreURLRevision(ctx context.Context, in *RestoreURLRevisionRequest, opts ...grpc.CallOption) (*RestoreURLRevisionResponse, error)
 	// Analytics integration
 	IncrementClick(ctx context.Context, in *IncrementClickRequest, opts ...grpc.CallOption) (*IncrementClickResponse, error)
 	// Health check
@@ -134,6 +157,16 @@ func (c *uRLServiceClient) CheckAvailability(ctx context.Context, in *CheckAvail
 	return out, nil
 }
 
+func (c *uRLServiceClient) GetQRCode(ctx context.Context, in *GetQRCodeRequest, opts ...grpc.CallOption) (*GetQRCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQRCodeResponse)
+	err := c.cc.Invoke(ctx, URLService_GetQRCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uRLServiceClient) BulkCreateURL(ctx context.Context, in *BulkCreateURLRequest, opts ...grpc.CallOption) (*BulkCreateURLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BulkCreateURLResponse)
@@ -144,6 +177,96 @@ func (c *uRLServiceClient) BulkCreateURL(ctx context.Context, in *BulkCreateURLR
 	return out, nil
 }
 
+func (c *uRLServiceClient) ListRedirectRules(ctx context.Context, in *ListRedirectRulesRequest, opts ...grpc.CallOption) (*ListRedirectRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRedirectRulesResponse)
+	err := c.cc.Invoke(ctx, URLService_ListRedirectRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) CreateRedirectRule(ctx context.Context, in *CreateRedirectRuleRequest, opts ...grpc.CallOption) (*CreateRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, URLService_CreateRedirectRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) UpdateRedirectRule(ctx context.Context, in *UpdateRedirectRuleRequest, opts ...grpc.CallOption) (*UpdateRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, URLService_UpdateRedirectRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) DeleteRedirectRule(ctx context.Context, in *DeleteRedirectRuleRequest, opts ...grpc.CallOption) (*DeleteRedirectRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRedirectRuleResponse)
+	err := c.cc.Invoke(ctx, URLService_DeleteRedirectRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) ListDomains(ctx context.Context, in *ListDomainsRequest, opts ...grpc.CallOption) (*ListDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDomainsResponse)
+	err := c.cc.Invoke(ctx, URLService_ListDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) AddDomain(ctx context.Context, in *AddDomainRequest, opts ...grpc.CallOption) (*AddDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddDomainResponse)
+	err := c.cc.Invoke(ctx, URLService_AddDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyDomainResponse)
+	err := c.cc.Invoke(ctx, URLService_VerifyDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) ListURLRevisions(ctx context.Context, in *ListURLRevisionsRequest, opts ...grpc.CallOption) (*ListURLRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListURLRevisionsResponse)
+	err := c.cc.Invoke(ctx, URLService_ListURLRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uRLServiceClient) RestoreURLRevision(ctx context.Context, in *RestoreURLRevisionRequest, opts ...grpc.CallOption) (*RestoreURLRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreURLRevisionResponse)
+	err := c.cc.Invoke(ctx, URLService_RestoreURLRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uRLServiceClient) IncrementClick(ctx context.Context, in *IncrementClickRequest, opts ...grpc.CallOption) (*IncrementClickResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IncrementClickResponse)
@@ -180,8 +303,21 @@ type URLServiceServer interface {
 	// Utility operations
 	ValidateURL(context.Context, *ValidateURLRequest) (*ValidateURLResponse, error)
 	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
+	GetQRCode(context.Context, *GetQRCodeRequest) (*GetQRCodeResponse, error)
 	// Bulk operations
 	BulkCreateURL(context.Context, *BulkCreateURLRequest) (*BulkCreateURLResponse, error)
+	// Conditional redirects
+	ListRedirectRules(context.Context, *ListRedirectRulesRequest) (*ListRedirectRulesResponse, error)
+	CreateRedirectRule(context.Context, *CreateRedirectRuleRequest) (*CreateRedirectRuleResponse, error)
+	UpdateRedirectRule(context.Context, *UpdateRedirectRuleRequest) (*UpdateRedirectRuleResponse, error)
+	DeleteRedirectRule(context.Context, *DeleteRedirectRuleRequest) (*DeleteRedirectRuleResponse, error)
+	// Custom domains
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	AddDomain(context.Context, *AddDomainRequest) (*AddDomainResponse, error)
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error)
+	// Edit history
+	ListURLRevisions(context.Context, *ListURLRevisionsRequest) (*ListURLRevisionsResponse, error)
+	RestoreURLRevision(context.Context, *RestoreURLRevisionRequest) (*RestoreURLRevisionResponse, error)
 	// Analytics integration
 	IncrementClick(context.Context, *IncrementClickRequest) (*IncrementClickResponse, error)
 	// Health check
@@ -217,9 +353,39 @@ func (UnimplementedURLServiceServer) ValidateURL(context.Context, *ValidateURLRe
 func (UnimplementedURLServiceServer) CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
 }
+func (UnimplementedURLServiceServer) GetQRCode(context.Context, *GetQRCodeRequest) (*GetQRCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQRCode not implemented")
+}
 func (UnimplementedURLServiceServer) BulkCreateURL(context.Context, *BulkCreateURLRequest) (*BulkCreateURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateURL not implemented")
 }
+func (UnimplementedURLServiceServer) ListRedirectRules(context.Context, *ListRedirectRulesRequest) (*ListRedirectRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRedirectRules not implemented")
+}
+func (UnimplementedURLServiceServer) CreateRedirectRule(context.Context, *CreateRedirectRuleRequest) (*CreateRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRedirectRule not implemented")
+}
+func (UnimplementedURLServiceServer) UpdateRedirectRule(context.Context, *UpdateRedirectRuleRequest) (*UpdateRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedirectRule not implemented")
+}
+func (UnimplementedURLServiceServer) DeleteRedirectRule(context.Context, *DeleteRedirectRuleRequest) (*DeleteRedirectRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRedirectRule not implemented")
+}
+func (UnimplementedURLServiceServer) ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomains not implemented")
+}
+func (UnimplementedURLServiceServer) AddDomain(context.Context, *AddDomainRequest) (*AddDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDomain not implemented")
+}
+func (UnimplementedURLServiceServer) VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
+func (UnimplementedURLServiceServer) ListURLRevisions(context.Context, *ListURLRevisionsRequest) (*ListURLRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListURLRevisions not implemented")
+}
+func (UnimplementedURLServiceServer) RestoreURLRevision(context.Context, *RestoreURLRevisionRequest) (*RestoreURLRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreURLRevision not implemented")
+}
 func (UnimplementedURLServiceServer) IncrementClick(context.Context, *IncrementClickRequest) (*IncrementClickResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncrementClick not implemented")
 }
@@ -373,6 +539,24 @@ func _URLService_CheckAvailability_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _URLService_GetQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).GetQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_GetQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).GetQRCode(ctx, req.(*GetQRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _URLService_BulkCreateURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BulkCreateURLRequest)
 	if err := dec(in); err != nil {
@@ -391,6 +575,168 @@ func _URLService_BulkCreateURL_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _URLService_ListRedirectRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedirectRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).ListRedirectRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_ListRedirectRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).ListRedirectRules(ctx, req.(*ListRedirectRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_CreateRedirectRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).CreateRedirectRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_CreateRedirectRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).CreateRedirectRule(ctx, req.(*CreateRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_UpdateRedirectRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).UpdateRedirectRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_UpdateRedirectRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).UpdateRedirectRule(ctx, req.(*UpdateRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_DeleteRedirectRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRedirectRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).DeleteRedirectRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_DeleteRedirectRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).DeleteRedirectRule(ctx, req.(*DeleteRedirectRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_ListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).ListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_ListDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).ListDomains(ctx, req.(*ListDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_AddDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).AddDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_AddDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).AddDomain(ctx, req.(*AddDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_VerifyDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_ListURLRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListURLRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).ListURLRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_ListURLRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).ListURLRevisions(ctx, req.(*ListURLRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _URLService_RestoreURLRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreURLRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(URLServiceServer).RestoreURLRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: URLService_RestoreURLRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(URLServiceServer).RestoreURLRevision(ctx, req.(*RestoreURLRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _URLService_IncrementClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IncrementClickRequest)
 	if err := dec(in); err != nil {
@@ -462,10 +808,50 @@ var URLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAvailability",
 			Handler:    _URLService_CheckAvailability_Handler,
 		},
+		{
+			MethodName: "GetQRCode",
+			Handler:    _URLService_GetQRCode_Handler,
+		},
 		{
 			MethodName: "BulkCreateURL",
 			Handler:    _URLService_BulkCreateURL_Handler,
 		},
+		{
+			MethodName: "ListRedirectRules",
+			Handler:    _URLService_ListRedirectRules_Handler,
+		},
+		{
+			MethodName: "CreateRedirectRule",
+			Handler:    _URLService_CreateRedirectRule_Handler,
+		},
+		{
+			MethodName: "UpdateRedirectRule",
+			Handler:    _URLService_UpdateRedirectRule_Handler,
+		},
+		{
+			MethodName: "DeleteRedirectRule",
+			Handler:    _URLService_DeleteRedirectRule_Handler,
+		},
+		{
+			MethodName: "ListDomains",
+			Handler:    _URLService_ListDomains_Handler,
+		},
+		{
+			MethodName: "AddDomain",
+			Handler:    _URLService_AddDomain_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _URLService_VerifyDomain_Handler,
+		},
+		{
+			MethodName: "ListURLRevisions",
+			Handler:    _URLService_ListURLRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreURLRevision",
+			Handler:    _URLService_RestoreURLRevision_Handler,
+		},
 		{
 			MethodName: "IncrementClick",
 			Handler:    _URLService_IncrementClick_Handler,
@@ -20,29 +20,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Register_FullMethodName           = "/user.UserService/Register"
-	UserService_Login_FullMethodName              = "/user.UserService/Login"
-	UserService_RefreshToken_FullMethodName       = "/user.UserService/RefreshToken"
-	UserService_OAuthLogin_FullMethodName         = "/user.UserService/OAuthLogin"
-	UserService_VerifyEmail_FullMethodName        = "/user.UserService/VerifyEmail"
-	UserService_ResendVerification_FullMethodName = "/user.UserService/ResendVerification"
-	UserService_ChangePassword_FullMethodName     = "/user.UserService/ChangePassword"
-	UserService_ForgotPassword_FullMethodName     = "/user.UserService/ForgotPassword"
-	UserService_ResetPassword_FullMethodName      = "/user.UserService/ResetPassword"
-	UserService_GetUser_FullMethodName            = "/user.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName         = "/user.UserService/UpdateUser"
-	UserService_CreateAPIKey_FullMethodName       = "/user.UserService/CreateAPIKey"
-	UserService_ListAPIKeys_FullMethodName        = "/user.UserService/ListAPIKeys"
-	UserService_RevokeAPIKey_FullMethodName       = "/user.UserService/RevokeAPIKey"
-	UserService_GetRateLimit_FullMethodName       = "/user.UserService/GetRateLimit"
-	UserService_IncrementRateLimit_FullMethodName = "/user.UserService/IncrementRateLimit"
-	UserService_ValidateToken_FullMethodName      = "/user.UserService/ValidateToken"
-	UserService_ValidateAPIKey_FullMethodName     = "/user.UserService/ValidateAPIKey"
-	UserService_UpgradeToPremium_FullMethodName   = "/user.UserService/UpgradeToPremium"
-	UserService_GetSubscription_FullMethodName    = "/user.UserService/GetSubscription"
-	UserService_ListUsers_FullMethodName          = "/user.UserService/ListUsers"
-	UserService_BanUser_FullMethodName            = "/user.UserService/BanUser"
-	UserService_HealthCheck_FullMethodName        = "/user.UserService/HealthCheck"
+	UserService_Register_FullMethodName                = "/user.UserService/Register"
+	UserService_Login_FullMethodName                   = "/user.UserService/Login"
+	UserService_RefreshToken_FullMethodName            = "/user.UserService/RefreshToken"
+	UserService_OAuthLogin_FullMethodName              = "/user.UserService/OAuthLogin"
+	UserService_ListSessions_FullMethodName            = "/user.UserService/ListSessions"
+	UserService_RevokeSession_FullMethodName           = "/user.UserService/RevokeSession"
+	UserService_RevokeAllSessions_FullMethodName       = "/user.UserService/RevokeAllSessions"
+	UserService_BeginTwoFactorSetup_FullMethodName     = "/user.UserService/BeginTwoFactorSetup"
+	UserService_ConfirmTwoFactorSetup_FullMethodName   = "/user.UserService/ConfirmTwoFactorSetup"
+	UserService_RegenerateRecoveryCodes_FullMethodName = "/user.UserService/RegenerateRecoveryCodes"
+	UserService_DisableTwoFactor_FullMethodName        = "/user.UserService/DisableTwoFactor"
+	UserService_VerifyEmail_FullMethodName             = "/user.UserService/VerifyEmail"
+	UserService_ResendVerification_FullMethodName      = "/user.UserService/ResendVerification"
+	UserService_ChangePassword_FullMethodName          = "/user.UserService/ChangePassword"
+	UserService_ForgotPassword_FullMethodName          = "/user.UserService/ForgotPassword"
+	UserService_ResetPassword_FullMethodName           = "/user.UserService/ResetPassword"
+	UserService_GetUser_FullMethodName                 = "/user.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName              = "/user.UserService/UpdateUser"
+	UserService_CreateAPIKey_FullMethodName            = "/user.UserService/CreateAPIKey"
+	UserService_ListAPIKeys_FullMethodName             = "/user.UserService/ListAPIKeys"
+	UserService_RevokeAPIKey_FullMethodName            = "/user.UserService/RevokeAPIKey"
+	UserService_GetRateLimit_FullMethodName            = "/user.UserService/GetRateLimit"
+	UserService_IncrementRateLimit_FullMethodName      = "/user.UserService/IncrementRateLimit"
+	UserService_ValidateToken_FullMethodName           = "/user.UserService/ValidateToken"
+	UserService_ValidateAPIKey_FullMethodName          = "/user.UserService/ValidateAPIKey"
+	UserService_UpgradeToPremium_FullMethodName        = "/user.UserService/UpgradeToPremium"
+	UserService_GetSubscription_FullMethodName         = "/user.UserService/GetSubscription"
+	UserService_ListUsers_FullMethodName               = "/user.UserService/ListUsers"
+	UserService_BanUser_FullMethodName                 = "/user.UserService/BanUser"
+	UserService_HealthCheck_FullMethodName             = "/user.UserService/HealthCheck"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -56,6 +63,15 @@ type UserServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	OAuthLogin(ctx context.Context, in *OAuthLoginRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
+	// Sessions
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
+	// Two-factor authentication
+	BeginTwoFactorSetup(ctx context.Context, in *BeginTwoFactorSetupRequest, opts ...grpc.CallOption) (*BeginTwoFactorSetupResponse, error)
+	ConfirmTwoFactorSetup(ctx context.Context, in *ConfirmTwoFactorSetupRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorSetupResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
 	// Email verification
 	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
 	ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...grpc.CallOption) (*ResendVerificationResponse, error)
@@ -134,6 +150,76 @@ func (c *userServiceClient) OAuthLogin(ctx context.Context, in *OAuthLoginReques
 	return out, nil
 }
 
+func (c *userServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginTwoFactorSetup(ctx context.Context, in *BeginTwoFactorSetupRequest, opts ...grpc.CallOption) (*BeginTwoFactorSetupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTwoFactorSetupResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginTwoFactorSetup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmTwoFactorSetup(ctx context.Context, in *ConfirmTwoFactorSetupRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorSetupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorSetupResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmTwoFactorSetup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, UserService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerifyEmailResponse)
@@ -335,6 +421,15 @@ type UserServiceServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error)
+	// Sessions
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
+	// Two-factor authentication
+	BeginTwoFactorSetup(context.Context, *BeginTwoFactorSetupRequest) (*BeginTwoFactorSetupResponse, error)
+	ConfirmTwoFactorSetup(context.Context, *ConfirmTwoFactorSetupRequest) (*ConfirmTwoFactorSetupResponse, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
 	// Email verification
 	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
 	ResendVerification(context.Context, *ResendVerificationRequest) (*ResendVerificationResponse, error)
@@ -385,6 +480,27 @@ func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshToke
 func (UnimplementedUserServiceServer) OAuthLogin(context.Context, *OAuthLoginRequest) (*OAuthLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OAuthLogin not implemented")
 }
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedUserServiceServer) BeginTwoFactorSetup(context.Context, *BeginTwoFactorSetupRequest) (*BeginTwoFactorSetupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTwoFactorSetup not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmTwoFactorSetup(context.Context, *ConfirmTwoFactorSetupRequest) (*ConfirmTwoFactorSetupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactorSetup not implemented")
+}
+func (UnimplementedUserServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedUserServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
 func (UnimplementedUserServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
 }
@@ -535,6 +651,132 @@ func _UserService_OAuthLogin_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginTwoFactorSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTwoFactorSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginTwoFactorSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginTwoFactorSetup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginTwoFactorSetup(ctx, req.(*BeginTwoFactorSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmTwoFactorSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmTwoFactorSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmTwoFactorSetup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmTwoFactorSetup(ctx, req.(*ConfirmTwoFactorSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyEmailRequest)
 	if err := dec(in); err != nil {
@@ -900,6 +1142,34 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OAuthLogin",
 			Handler:    _UserService_OAuthLogin_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _UserService_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "BeginTwoFactorSetup",
+			Handler:    _UserService_BeginTwoFactorSetup_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactorSetup",
+			Handler:    _UserService_ConfirmTwoFactorSetup_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _UserService_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _UserService_DisableTwoFactor_Handler,
+		},
 		{
 			MethodName: "VerifyEmail",
 			Handler:    _UserService_VerifyEmail_Handler,
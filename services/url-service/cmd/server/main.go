@@ -0,0 +1,518 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"flag"
+	"fmt"
+	"html/template"
+	"image"
+	"net"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/pkg/grpcserver"
+	"github.com/url-shortener-microservices/pkg/httpserver"
+	"github.com/url-shortener-microservices/pkg/jwtauth"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/pkg/ratelimit"
+	analyticspb "github.com/url-shortener-microservices/proto/gen/analytics"
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/delivery/grpchandler"
+	"github.com/url-shortener-microservices/services/url-service/internal/delivery/httphandler"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/shortcode"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/analyticsclient"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/cache"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/events"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/fetcher"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/geo"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/postgres"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/qr"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/screening"
+	"github.com/url-shortener-microservices/services/url-service/internal/infrastructure/userclient"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to config directory")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logger.Fatal("failed to load config", zap.Error(err))
+	}
+
+	log, err := logger.NewLogger(logger.Config{
+		Level:       cfg.Log.Level,
+		Format:      cfg.Log.Format,
+		Output:      cfg.Log.Output,
+		FilePath:    cfg.Log.FilePath,
+		MaxSize:     cfg.Log.MaxSize,
+		MaxBackups:  cfg.Log.MaxBackups,
+		MaxAge:      cfg.Log.MaxAge,
+		Compress:    cfg.Log.Compress,
+		ServiceName: config.ServiceName,
+	})
+	if err != nil {
+		logger.Fatal("failed to create logger", zap.Error(err))
+	}
+	logger.SetGlobalLogger(log)
+	defer log.Sync()
+
+	if err := run(cfg, log); err != nil {
+		log.Fatal("server stopped with error", zap.Error(err))
+	}
+}
+
+func run(cfg config.Config, log *logger.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	db, err := database.NewPostgres(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	codes, err := shortcode.NewAllocator(shortcode.Options{
+		Strategy:    cfg.ShortCode.Strategy,
+		Length:      cfg.ShortCode.Length,
+		Alphabet:    cfg.ShortCode.Alphabet,
+		MaxAttempts: cfg.ShortCode.MaxAttempts,
+		Salt:        cfg.ShortCode.Salt,
+		Reserved:    cfg.ShortCode.Reserved,
+		Profanity:   cfg.ShortCode.Profanity,
+	}, postgres.NewCodeSequence(db))
+	if err != nil {
+		return err
+	}
+
+	// Redis is opened once and shared by every component configured to use it
+	var rdb *redis.Client
+	if (cfg.Cache.Enabled && cfg.Cache.Store == "redis") || cfg.Password.AttemptStore == "redis" ||
+		(cfg.Expiration.Enabled && cfg.Expiration.Publisher == "redis") {
+		if rdb, err = database.NewRedis(ctx, cfg.Redis); err != nil {
+			return err
+		}
+		defer rdb.Close()
+	}
+
+	guard, err := newPasswordGuard(cfg.Password, rdb, log)
+	if err != nil {
+		return err
+	}
+
+	var index *application.CodeIndex
+	if cfg.Availability.Enabled {
+		index = newCodeIndex(cfg.Availability, db, log)
+	}
+
+	pages, err := newFetcher(cfg.Fetch)
+	if err != nil {
+		return err
+	}
+
+	cursorSecret, err := secretOrRandom(cfg.URL.CursorSecret, "url.cursor_secret", "pagination cursors", log)
+	if err != nil {
+		return err
+	}
+	cursors, err := pagination.NewCodec(cursorSecret)
+	if err != nil {
+		return err
+	}
+
+	baseHost := baseURLHost(cfg.URL.BaseURL)
+	domainRepo := postgres.NewDomainRepository(db)
+	domains := newDomainService(cfg.Domains, domainRepo, baseHost, log)
+	var linkDomains domain.DomainRepository
+	if cfg.Domains.MaxPerUser > 0 {
+		linkDomains = domainRepo
+	}
+	screener, err := newScreener(cfg.Screening, log)
+	if err != nil {
+		return err
+	}
+	var linkScreener domain.DestinationScreener
+	if screener != nil {
+		linkScreener = screener
+	}
+	urls := newURLRepository(cfg, db, rdb, log)
+	service := application.NewURLService(urls, codes, index, pages, guard, cursors, linkDomains, linkScreener, cfg.URL, log)
+
+	qrCodes, err := newQRCodeService(cfg, service, rdb, log)
+	if err != nil {
+		return err
+	}
+
+	ips, err := httphandler.NewClientIPResolver(cfg.Server.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	comingSoon, err := newComingSoon(cfg.ComingSoon)
+	if err != nil {
+		return err
+	}
+	geoLocator, err := newGeoLocator(cfg.Geo, log)
+	if err != nil {
+		return err
+	}
+	clicks, closeClicks, err := newClickRecorder(cfg.AnalyticsService)
+	if err != nil {
+		return err
+	}
+	defer closeClicks()
+	redirects := httphandler.NewRedirectHandler(service, ips, log.HTTPMiddleware(), httphandler.RedirectOptions{
+		RedirectCode:  cfg.URL.RedirectCode,
+		SecureCookies: strings.HasPrefix(cfg.URL.BaseURL, "https://"),
+		ComingSoon:    comingSoon,
+		DefaultHost:   baseHost,
+		CountryHeader: cfg.Geo.CountryHeader,
+		Geo:           geoLocator,
+		Analytics:     clicks,
+		QRCodes:       qrCodes,
+	})
+	httpServer, err := httpserver.NewServer(cfg.Server, httphandler.NewRouter(cfg.Server, redirects, ips, log.HTTPMiddleware()))
+	if err != nil {
+		return err
+	}
+	defer redirects.Wait()
+
+	interceptors := []grpc.UnaryServerInterceptor{grpcserver.UnaryInterceptor(log)}
+	if verifier := newTokenVerifier(cfg); verifier != nil {
+		interceptors = append(interceptors, grpchandler.AuthInterceptor(verifier))
+	} else {
+		log.Warn("auth.jwks_url is not set, trusting the user_id of gRPC requests")
+	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	urlpb.RegisterURLServiceServer(grpcServer, grpchandler.NewServer(service, domains, qrCodes))
+
+	lis, err := net.Listen("tcp", cfg.GRPC.GetGRPCAddr())
+	if err != nil {
+		return err
+	}
+
+	owners, closeOwners, err := newOwnerDirectory(cfg.UserService)
+	if err != nil {
+		return err
+	}
+	defer closeOwners()
+
+	g, ctx := errgroup.WithContext(ctx)
+	if index != nil {
+		g.Go(func() error {
+			return index.Run(ctx)
+		})
+	}
+	if cfg.Expiration.Enabled {
+		sweeper := newExpirationSweeper(cfg.Expiration, db, rdb, owners, log)
+		g.Go(func() error {
+			return sweeper.Run(ctx)
+		})
+	}
+	if screener != nil {
+		g.Go(func() error {
+			return screener.Run(ctx)
+		})
+		if rescanner := newRescanner(cfg.Screening, db, screener, urls, log); rescanner != nil {
+			g.Go(func() error {
+				return rescanner.Run(ctx)
+			})
+		}
+	}
+	g.Go(func() error {
+		log.Info("HTTP server listening", zap.String("addr", httpServer.Addr()))
+		return httpServer.Run(ctx)
+	})
+	g.Go(func() error {
+		log.Info("gRPC server listening", zap.String("addr", lis.Addr().String()))
+		return grpcserver.Serve(ctx, grpcServer, lis, cfg.Server.ShutdownTimeout, log)
+	})
+
+	err = g.Wait()
+	log.Info("servers stopped")
+	return err
+}
+
+// newURLRepository builds the Postgres repository, fronted by a cache when enabled
+func newURLRepository(cfg config.Config, db *sql.DB, rdb *redis.Client, log *logger.Logger) domain.URLRepository {
+	repo := postgres.NewURLRepository(db)
+	if !cfg.Cache.Enabled {
+		return repo
+	}
+
+	store := newCacheStore(cfg.Cache, rdb)
+	if cfg.Cache.Store == "memory" {
+		log.Warn("cache.store is memory, which only suits a single instance: other replicas serve changed URLs until cache.ttl passes")
+	}
+
+	// Durations were checked by config validation
+	ttl, _ := time.ParseDuration(cfg.Cache.TTL)
+	negativeTTL, _ := time.ParseDuration(cfg.Cache.NegativeTTL)
+
+	cached := cache.NewURLRepository(repo, store, cache.Options{
+		KeyPrefix:   cfg.Cache.KeyPrefix,
+		TTL:         ttl,
+		NegativeTTL: negativeTTL,
+	}, log.Cache())
+	return cached
+}
+
+// newCacheStore returns the configured cache store, in memory when the cache is
+// disabled and Redis therefore not connected
+func newCacheStore(cfg config.CacheConfig, rdb *redis.Client) cache.Store {
+	if cfg.Enabled && cfg.Store == "redis" {
+		return cache.NewRedisStore(rdb)
+	}
+	return cache.NewMemoryStore(cfg.MaxEntries)
+}
+
+// newQRCodeService builds the QR code service, caching images in the cache store
+func newQRCodeService(cfg config.Config, urls *application.URLService, rdb *redis.Client, log *logger.Logger) (*application.QRCodeService, error) {
+	var logo image.Image
+	if cfg.QR.LogoFile != "" {
+		var err error
+		if logo, err = qr.LoadLogo(cfg.QR.LogoFile); err != nil {
+			return nil, fmt.Errorf("qr.logo_file: %w", err)
+		}
+	}
+	renderer, err := qr.NewRenderer(logo)
+	if err != nil {
+		return nil, err
+	}
+
+	var r domain.QRRenderer = renderer
+	// Duration was checked by config validation
+	if ttl, _ := time.ParseDuration(cfg.QR.CacheTTL); ttl > 0 {
+		r = cache.NewQRRenderer(renderer, newCacheStore(cfg.Cache, rdb), "qr:", ttl, log.Cache())
+	}
+	return application.NewQRCodeService(urls, r, cfg.QR, log), nil
+}
+
+// newCodeIndex builds the short code availability index on the Postgres repository
+func newCodeIndex(cfg config.AvailabilityConfig, db *sql.DB, log *logger.Logger) *application.CodeIndex {
+	// Durations were checked by config validation
+	refresh, _ := time.ParseDuration(cfg.RefreshInterval)
+
+	return application.NewCodeIndex(postgres.NewURLRepository(db), application.CodeIndexOptions{
+		Capacity:          cfg.BloomCapacity,
+		FalsePositiveRate: cfg.BloomFPRate,
+		RefreshInterval:   refresh,
+	}, log)
+}
+
+// newFetcher builds the SSRF-safe destination fetcher
+func newFetcher(cfg config.FetchConfig) (*fetcher.Fetcher, error) {
+	allowed, err := fetcher.ParseAllowed(cfg.AllowedNetworks)
+	if err != nil {
+		return nil, fmt.Errorf("fetch.allowed_networks: %w", err)
+	}
+	// Durations were checked by config validation
+	timeout, _ := time.ParseDuration(cfg.Timeout)
+
+	return fetcher.New(fetcher.Options{
+		Timeout:      timeout,
+		MaxRedirects: cfg.MaxRedirects,
+		MaxBodySize:  cfg.MaxBodyBytes,
+		UserAgent:    cfg.UserAgent,
+		Allowed:      allowed,
+	}), nil
+}
+
+// newComingSoon loads the response for scheduled links, including a custom page template
+func newComingSoon(cfg config.ComingSoonConfig) (httphandler.ComingSoonOptions, error) {
+	opts := httphandler.ComingSoonOptions{Status: cfg.Status, RedirectURL: cfg.RedirectURL}
+	if cfg.TemplateFile != "" {
+		tmpl, err := template.ParseFiles(cfg.TemplateFile)
+		if err != nil {
+			return opts, fmt.Errorf("coming_soon.template_file: %w", err)
+		}
+		opts.Template = tmpl
+	}
+	return opts, nil
+}
+
+// newGeoLocator loads the IP to country table for redirect rules, nil if none is configured
+func newGeoLocator(cfg config.GeoConfig, log *logger.Logger) (domain.GeoLocator, error) {
+	if cfg.DatabaseFile == "" {
+		return nil, nil
+	}
+	table, err := geo.LoadTable(cfg.DatabaseFile)
+	if err != nil {
+		return nil, fmt.Errorf("geo.database_file: %w", err)
+	}
+	log.Info("geo table loaded", zap.String("file", cfg.DatabaseFile), zap.Int("ranges", table.Len()))
+	return table, nil
+}
+
+// newScreener loads the destination screener, nil when screening is disabled
+func newScreener(cfg config.ScreeningConfig, log *logger.Logger) (*screening.Screener, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	reload, _ := time.ParseDuration(cfg.ReloadInterval)
+	screener, err := screening.New(screening.Options{
+		BlocklistFile:  cfg.BlocklistFile,
+		ReloadInterval: reload,
+		BlockIPHosts:   cfg.BlockIPHosts,
+	}, log.WithComponent("screening"))
+	if err != nil {
+		return nil, fmt.Errorf("screening.blocklist_file: %w", err)
+	}
+	return screener, nil
+}
+
+// newRescanner builds the re-scan job on the Postgres repository, nil when
+// re-scans are disabled. Blocked URLs are dropped from the cache if urls has one.
+func newRescanner(cfg config.ScreeningConfig, db *sql.DB, screener domain.DestinationScreener, urls domain.URLRepository,
+	log *logger.Logger) *application.Rescanner {
+	// Durations were checked by config validation
+	interval, _ := time.ParseDuration(cfg.RescanInterval)
+	if interval <= 0 {
+		return nil
+	}
+	maxAge, _ := time.ParseDuration(cfg.RescanAge)
+
+	invalidator, _ := urls.(application.URLInvalidator)
+	return application.NewRescanner(postgres.NewURLRepository(db), screener, invalidator, application.RescanOptions{
+		Interval:  interval,
+		MaxAge:    maxAge,
+		BatchSize: cfg.BatchSize,
+	}, log)
+}
+
+// newDomainService builds the custom domain service, verifying ownership through the system resolver
+func newDomainService(cfg config.DomainsConfig, repo domain.DomainRepository, baseHost string, log *logger.Logger) *application.DomainService {
+	timeout, _ := time.ParseDuration(cfg.LookupTimeout)
+	return application.NewDomainService(repo, net.DefaultResolver, application.DomainOptions{
+		MaxPerUser:    cfg.MaxPerUser,
+		LookupTimeout: timeout,
+		ReservedHosts: []string{baseHost},
+	}, log)
+}
+
+// baseURLHost returns the lower-case hostname of the validated base URL
+func baseURLHost(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// newPasswordGuard builds the password guard with the configured attempt limiter
+func newPasswordGuard(cfg config.PasswordConfig, rdb *redis.Client, log *logger.Logger) (*application.PasswordGuard, error) {
+	// Durations were checked by config validation
+	ttl, _ := time.ParseDuration(cfg.UnlockTTL)
+	window, _ := time.ParseDuration(cfg.AttemptWindow)
+
+	var attempts ratelimit.AttemptLimiter
+	switch cfg.AttemptStore {
+	case "redis":
+		attempts = ratelimit.NewRedisLimiter(rdb, cfg.MaxAttempts, window, cfg.KeyPrefix)
+	default:
+		attempts = ratelimit.NewMemoryLimiter(cfg.MaxAttempts, window)
+	}
+
+	secret, err := secretOrRandom(cfg.UnlockSecret, "password.unlock_secret", "unlock cookies", log)
+	if err != nil {
+		return nil, err
+	}
+
+	return application.NewPasswordGuard(password.Default(), attempts, secret, ttl), nil
+}
+
+// secretOrRandom returns the configured secret, or a random one with a warning
+// that whatever it signs will not survive restarts or work across replicas
+func secretOrRandom(value, key, signs string, log *logger.Logger) ([]byte, error) {
+	if value != "" {
+		return []byte(value), nil
+	}
+
+	log.Warn(key + " is not set, " + signs + " will not survive restarts or work across replicas")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// newOwnerDirectory connects to the user service, returns nil when no address is configured
+func newOwnerDirectory(cfg config.UserServiceConfig) (domain.OwnerDirectory, func(), error) {
+	if cfg.Addr == "" {
+		return nil, func() {}, nil
+	}
+
+	conn, err := grpc.NewClient(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	timeout, _ := time.ParseDuration(cfg.Timeout)
+	return userclient.NewOwnerDirectory(userpb.NewUserServiceClient(conn), timeout), func() { conn.Close() }, nil
+}
+
+// newTokenVerifier checks access tokens against the user service key set, nil when auth.jwks_url is not set
+func newTokenVerifier(cfg config.Config) *jwtauth.Verifier {
+	if cfg.Auth.JWKSURL == "" {
+		return nil
+	}
+	// Duration was checked by config validation
+	refresh, _ := time.ParseDuration(cfg.Auth.RefreshInterval)
+	keys := jwtauth.NewRemoteKeySet(jwtauth.RemoteKeySetOptions{URL: cfg.Auth.JWKSURL, RefreshInterval: refresh})
+	return jwtauth.NewVerifier(keys, jwtauth.VerifierOptions{Issuer: cfg.JWT.Issuer, Audience: cfg.JWT.Audience})
+}
+
+// newClickRecorder connects to the analytics service, returning nil when it is not configured
+func newClickRecorder(cfg config.AnalyticsServiceConfig) (domain.ClickRecorder, func(), error) {
+	if cfg.Addr == "" {
+		return nil, func() {}, nil
+	}
+
+	conn, err := grpc.NewClient(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	timeout, _ := time.ParseDuration(cfg.Timeout)
+	return analyticsclient.NewClickRecorder(analyticspb.NewAnalyticsServiceClient(conn), timeout), func() { conn.Close() }, nil
+}
+
+// newExpirationSweeper builds the sweeper on the Postgres repository and outbox,
+// relaying events to the configured publisher
+func newExpirationSweeper(cfg config.ExpirationConfig, db *sql.DB, rdb *redis.Client, owners domain.OwnerDirectory,
+	log *logger.Logger) *application.ExpirationSweeper {
+	interval, _ := time.ParseDuration(cfg.Interval)
+
+	var publisher domain.EventPublisher = events.NewLogPublisher(log.Queue())
+	if cfg.Publisher == "redis" {
+		publisher = events.NewRedisPublisher(rdb, cfg.Stream, cfg.StreamMaxLen)
+	}
+
+	return application.NewExpirationSweeper(
+		postgres.NewURLRepository(db),
+		postgres.NewOutbox(db),
+		publisher,
+		owners,
+		application.ExpirationOptions{
+			Interval:     interval,
+			BatchSize:    cfg.BatchSize,
+			NotifyBefore: time.Duration(cfg.NotifyDays) * 24 * time.Hour,
+		},
+		log,
+	)
+}
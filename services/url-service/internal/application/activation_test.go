@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+)
+
+func TestURLService_ScheduledActivation(t *testing.T) {
+	svc := newBulkService(t, newMemoryRepo())
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	launch := now.Add(time.Hour)
+	u, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com/launch", ActivatesAt: &launch})
+	require.NoError(t, err)
+
+	_, err = svc.GetURL(ctx, GetURLInput{ID: u.ID})
+	appErr := apperrors.AsAppError(err)
+	require.NotNil(t, appErr)
+	assert.Equal(t, apperrors.CodeURLNotYetActive, appErr.Code)
+	assert.Equal(t, launch, appErr.Details["activates_at"])
+
+	now = launch
+	_, err = svc.GetURL(ctx, GetURLInput{ID: u.ID})
+	assert.NoError(t, err)
+}
+
+func TestURLService_ActivationWindow(t *testing.T) {
+	svc := newBulkService(t, newMemoryRepo())
+	ctx := context.Background()
+
+	start := time.Now().Add(2 * time.Hour)
+	end := start.Add(-time.Hour)
+	_, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com", ActivatesAt: &start, ExpiresAt: &end})
+	assert.Equal(t, "activates_at", apperrors.AsAppError(err).Field)
+
+	end = start.Add(time.Hour)
+	u, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com", ActivatesAt: &start, ExpiresAt: &end})
+	require.NoError(t, err)
+
+	// Moving expiration before an existing activation is rejected too
+	early := start.Add(-time.Minute)
+	u.UserID = "user-1"
+	_, err = svc.UpdateURL(ctx, UpdateURLInput{ID: u.ID, UserID: "user-1", ExpiresAt: &early})
+	assert.Equal(t, "activates_at", apperrors.AsAppError(err).Field)
+}
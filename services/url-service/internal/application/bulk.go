@@ -0,0 +1,302 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// errBulkRollback aborts an all-or-nothing transaction once an item failed
+var errBulkRollback = errors.New("bulk create rolled back")
+
+// errRolledBack is reported for valid items of an all-or-nothing request that
+// were not created because another item failed
+var errRolledBack = apperrors.New(apperrors.CodeConflict, "rolled back because another item failed")
+
+// BulkCreateURLInput holds data for creating many short URLs at once
+type BulkCreateURLInput struct {
+	User         *domain.UserContext // Caller, must be premium
+	UserID       string              // Owner, must match User when set
+	Items        []CreateURLInput
+	AllOrNothing bool // Create nothing if any item fails
+}
+
+// BulkCreateResult holds the created URLs in input order and one error per
+// failed item, whose Field starts with the item index, e.g. "urls[3].custom_code".
+// Every item is in exactly one of the two lists; when an all-or-nothing request
+// fails its valid items are reported as rolled back.
+type BulkCreateResult struct {
+	URLs   []*domain.URL
+	Errors []*apperrors.AppError
+}
+
+// bulkDomain is the checked custom domain shared by the items naming it
+type bulkDomain struct {
+	host string
+	err  error
+}
+
+// bulkItem tracks one item through validation and insertion
+type bulkItem struct {
+	index    int
+	url      *domain.URL
+	password string // Plain password, hashed before insertion
+	host     string // Custom domain, empty for the default domain
+	attempt  int    // Next code generation attempt, generated codes only
+	err      error
+	done     bool
+}
+
+// BulkCreateURL creates many URLs with batched inserts. Invalid items are reported
+// per index; in all-or-nothing mode any failure rolls back the whole request.
+func (s *URLService) BulkCreateURL(ctx context.Context, in BulkCreateURLInput) (*BulkCreateResult, error) {
+	owner, err := bulkOwner(in)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.Items) == 0 {
+		return nil, apperrors.Validation("urls is required").WithField("urls")
+	}
+	if len(in.Items) > s.cfg.MaxBulkSize {
+		return nil, apperrors.Validationf("at most %d urls can be created at once", s.cfg.MaxBulkSize).WithField("urls")
+	}
+
+	now := s.now().UTC()
+	items := make([]*bulkItem, len(in.Items))
+	customs := make(map[string]int)
+	hosts := make(map[string]bulkDomain)
+	for i, item := range in.Items {
+		// Titles are not scraped here, fetching thousands of pages would stall the request
+		item.UserID = owner
+		d, ok := hosts[item.Domain]
+		if !ok {
+			d.host, d.err = s.linkDomain(ctx, item.Domain, owner)
+			hosts[item.Domain] = d
+		}
+		if d.err != nil {
+			items[i] = &bulkItem{index: i, err: d.err}
+			continue
+		}
+
+		u, err := s.newURL(item, d.host, now)
+		items[i] = &bulkItem{index: i, url: u, password: item.Password, host: d.host, err: err}
+		if err != nil || !u.IsCustom {
+			continue
+		}
+		if first, dup := customs[u.ID]; dup {
+			items[i].err = apperrors.Newf(apperrors.CodeCustomCodeTaken, "short code %q is already used by item %d", item.CustomCode, first).
+				WithField("custom_code")
+			continue
+		}
+		customs[u.ID] = i
+	}
+
+	pending := validItems(items)
+	// Hashing is slow on purpose, so it runs before any transaction is opened
+	if !in.AllOrNothing || len(pending) == len(items) {
+		s.hashPasswords(ctx, pending)
+		pending = validItems(pending)
+	}
+
+	switch {
+	case in.AllOrNothing && len(pending) < len(items):
+		// Nothing is written when validation already failed
+		for _, item := range pending {
+			item.err = errRolledBack
+		}
+	case in.AllOrNothing:
+		err := s.repo.Bulk(ctx, func(w domain.BulkWriter) error {
+			if err := s.insertItems(ctx, w, pending); err != nil {
+				return err
+			}
+			for _, item := range pending {
+				if item.err != nil {
+					return errBulkRollback
+				}
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errBulkRollback) {
+			return nil, s.internal(err, "failed to create urls")
+		}
+		if err != nil {
+			for _, item := range pending {
+				item.done = false
+				if item.err == nil {
+					item.err = errRolledBack
+				}
+			}
+		}
+	default:
+		for start := 0; start < len(pending); start += s.cfg.BulkBatchSize {
+			batch := pending[start:min(start+s.cfg.BulkBatchSize, len(pending))]
+			err := s.repo.Bulk(ctx, func(w domain.BulkWriter) error {
+				return s.insertItems(ctx, w, batch)
+			})
+			if err != nil {
+				// The batch was rolled back, later batches may still succeed
+				s.logger.Error("failed to create url batch", zap.Int("first_item", batch[0].index), zap.Error(err))
+				for _, item := range batch {
+					item.done = false
+					item.err = apperrors.Wrap(err, apperrors.CodeInternal, "failed to create url")
+				}
+			}
+		}
+	}
+
+	res := &BulkCreateResult{}
+	for _, item := range items {
+		switch {
+		case item.err != nil:
+			res.Errors = append(res.Errors, itemError(item.index, item.err))
+		case item.done:
+			res.URLs = append(res.URLs, item.url)
+		}
+	}
+	for _, u := range res.URLs {
+		s.indexCode(u.ID)
+	}
+	return res, nil
+}
+
+// validItems returns the items without an error
+func validItems(items []*bulkItem) []*bulkItem {
+	valid := make([]*bulkItem, 0, len(items))
+	for _, item := range items {
+		if item.err == nil {
+			valid = append(valid, item)
+		}
+	}
+	return valid
+}
+
+// hashPasswords hashes the passwords of items in parallel, one worker per CPU,
+// setting err on items whose password is rejected
+func (s *URLService) hashPasswords(ctx context.Context, items []*bulkItem) {
+	var g errgroup.Group
+	g.SetLimit(runtime.GOMAXPROCS(0))
+	for _, item := range items {
+		if item.password == "" {
+			continue
+		}
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				item.err = err
+				return nil
+			}
+			item.err = s.hashPassword(item.url, item.password)
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// insertItems inserts items through w, drawing new codes for generated ones that
+// collide. Items end up done or with err set; only repository failures are returned.
+func (s *URLService) insertItems(ctx context.Context, w domain.BulkWriter, items []*bulkItem) error {
+	pending := items
+	for len(pending) > 0 {
+		batch := make([]*bulkItem, 0, len(pending))
+		for _, item := range pending {
+			if !item.url.IsCustom && !s.assignCode(ctx, item) {
+				continue
+			}
+			batch = append(batch, item)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		urls := make([]*domain.URL, len(batch))
+		for i, item := range batch {
+			urls[i] = item.url
+			item.done = true
+		}
+		skipped, err := w.InsertMany(ctx, urls)
+		if err != nil {
+			return err
+		}
+
+		pending = nil
+		for _, i := range skipped {
+			item := batch[i]
+			item.done = false
+			switch {
+			case item.url.IsCustom:
+				item.err = apperrors.Newf(apperrors.CodeCustomCodeTaken, "short code %q is already taken", item.url.Code()).
+					WithField("custom_code")
+			case s.codes.Deterministic() && item.url.PasswordHash == "":
+				// Deterministic codes for the same URL and owner point at an equivalent link
+				if existing, ok := s.reusable(ctx, item.url.ID, item.url); ok {
+					item.url = existing
+					item.done = true
+					continue
+				}
+				pending = append(pending, item)
+			default:
+				pending = append(pending, item)
+			}
+		}
+	}
+	return nil
+}
+
+// assignCode gives a generated item its next candidate code, reporting false
+// and setting err when the attempt budget is spent
+func (s *URLService) assignCode(ctx context.Context, item *bulkItem) bool {
+	for item.attempt < s.codes.MaxAttempts() {
+		code, ok, err := s.codes.Candidate(ctx, item.url.OriginalURL, item.attempt)
+		item.attempt++
+		if err != nil {
+			item.err = s.internal(err, "failed to generate short code")
+			return false
+		}
+		if ok {
+			item.url.ID = domain.QualifiedID(item.host, code)
+			return true
+		}
+	}
+	item.err = apperrors.Internal("failed to allocate a unique short code")
+	return false
+}
+
+// bulkOwner checks that the caller may bulk create and returns the owner of the new URLs
+func bulkOwner(in BulkCreateURLInput) (string, error) {
+	if in.User == nil || in.User.UserID == "" {
+		return "", apperrors.Unauthorized("user context is required").WithField("user_context")
+	}
+	if !in.User.IsPremium {
+		return "", apperrors.Forbidden("bulk creation requires a premium account")
+	}
+	if in.UserID != "" && in.UserID != in.User.UserID {
+		return "", apperrors.Forbidden("cannot create urls for another user").WithField("user_id")
+	}
+	return in.User.UserID, nil
+}
+
+// itemError copies err as an AppError whose field is prefixed with the item index
+func itemError(index int, err error) *apperrors.AppError {
+	appErr := apperrors.AsAppError(err)
+	if appErr == nil {
+		appErr = apperrors.Wrap(err, apperrors.CodeInternal, "failed to create url")
+	}
+
+	field := fmt.Sprintf("urls[%d]", index)
+	if appErr.Field != "" {
+		field += "." + appErr.Field
+	}
+	return &apperrors.AppError{
+		Code:    appErr.Code,
+		Message: appErr.Message,
+		Field:   field,
+		Details: appErr.Details,
+		Cause:   appErr.Cause,
+	}
+}
@@ -0,0 +1,218 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/shortcode"
+)
+
+func newBulkService(t *testing.T, repo domain.URLRepository) *URLService {
+	codes, err := shortcode.NewAllocator(shortcode.Options{Strategy: shortcode.StrategyCounter}, shortcode.NewMemorySequence(1))
+	require.NoError(t, err)
+
+	cfg := config.URLConfig{MaxURLLength: 2048, MaxBulkSize: 10, BulkBatchSize: 2, MaxRedirectRules: 3}
+	return NewURLService(repo, codes, nil, nil, nil, nil, nil, nil, cfg, logger.Default("test"))
+}
+
+var premium = &domain.UserContext{UserID: "user-1", IsPremium: true}
+
+func TestURLService_BulkCreateURL_BestEffort(t *testing.T) {
+	repo := newMemoryRepo("taken")
+	svc := newBulkService(t, repo)
+
+	res, err := svc.BulkCreateURL(context.Background(), BulkCreateURLInput{
+		User: premium,
+		Items: []CreateURLInput{
+			{OriginalURL: "https://example.com/a"},
+			{OriginalURL: "not a url"},
+			{OriginalURL: "https://example.com/b", CustomCode: "taken"},
+			{OriginalURL: "https://example.com/c", CustomCode: "mine"},
+			{OriginalURL: "https://example.com/d", CustomCode: "mine"},
+			{OriginalURL: "https://example.com/e"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res.URLs, 3)
+	assert.Equal(t, "https://example.com/a", res.URLs[0].OriginalURL)
+	assert.Equal(t, "mine", res.URLs[1].ID)
+	assert.Equal(t, "https://example.com/e", res.URLs[2].OriginalURL)
+	for _, u := range res.URLs {
+		assert.Equal(t, "user-1", u.UserID)
+	}
+
+	fields := make(map[string]string)
+	for _, e := range res.Errors {
+		fields[e.Field] = e.Code
+	}
+	assert.Equal(t, map[string]string{
+		"urls[1].original_url": apperrors.CodeInvalidURL,
+		"urls[2].custom_code":  apperrors.CodeCustomCodeTaken,
+		"urls[4].custom_code":  apperrors.CodeCustomCodeTaken,
+	}, fields)
+	assert.Len(t, repo.urls, 4)
+}
+
+func TestURLService_BulkCreateURL_AllOrNothing(t *testing.T) {
+	repo := newMemoryRepo("taken")
+	svc := newBulkService(t, repo)
+
+	res, err := svc.BulkCreateURL(context.Background(), BulkCreateURLInput{
+		User:         premium,
+		AllOrNothing: true,
+		Items: []CreateURLInput{
+			{OriginalURL: "https://example.com/a"},
+			{OriginalURL: "https://example.com/b", CustomCode: "taken"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, res.URLs)
+	// The valid item is reported too, so every input has an outcome
+	require.Len(t, res.Errors, 2)
+	assert.Equal(t, "urls[0]", res.Errors[0].Field)
+	assert.Equal(t, apperrors.CodeConflict, res.Errors[0].Code)
+	assert.Contains(t, res.Errors[0].Message, "rolled back")
+	assert.Equal(t, "urls[1].custom_code", res.Errors[1].Field)
+	assert.Len(t, repo.urls, 1, "nothing may be committed")
+
+	// Items are also reported when validation fails before anything is written
+	res, err = svc.BulkCreateURL(context.Background(), BulkCreateURLInput{
+		User:         premium,
+		AllOrNothing: true,
+		Items: []CreateURLInput{
+			{OriginalURL: "not a url"},
+			{OriginalURL: "https://example.com/b", Password: "secret"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.URLs)
+	require.Len(t, res.Errors, 2)
+	assert.Equal(t, "urls[0].original_url", res.Errors[0].Field)
+	assert.Equal(t, "urls[1]", res.Errors[1].Field)
+	assert.Equal(t, apperrors.CodeConflict, res.Errors[1].Code)
+	assert.Len(t, repo.urls, 1)
+}
+
+func TestURLService_BulkCreateURL_Passwords(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	svc.guard = newTestGuard(nil)
+
+	items := []CreateURLInput{{OriginalURL: "https://example.com/open"}}
+	for i := 0; i < 5; i++ {
+		items = append(items, CreateURLInput{OriginalURL: fmt.Sprintf("https://example.com/%d", i), Password: "secret"})
+	}
+	items = append(items, CreateURLInput{OriginalURL: "https://example.com/long", Password: strings.Repeat("x", password.MaxLength+1)})
+
+	res, err := svc.BulkCreateURL(context.Background(), BulkCreateURLInput{User: premium, Items: items})
+	require.NoError(t, err)
+
+	require.Len(t, res.URLs, 6)
+	assert.False(t, res.URLs[0].IsPasswordProtected())
+	for _, u := range res.URLs[1:] {
+		assert.True(t, u.IsPasswordProtected(), u.OriginalURL)
+		assert.NotContains(t, u.PasswordHash, "secret")
+	}
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, "urls[6].password", res.Errors[0].Field)
+}
+
+func TestURLService_BulkCreateURL_CollidingCodes(t *testing.T) {
+	for _, allOrNothing := range []bool{false, true} {
+		repo := newMemoryRepo()
+		svc := newBulkService(t, repo)
+		// Hash codes give the same URL the same first candidate, so both items
+		// collide inside one batch
+		codes, err := shortcode.NewAllocator(shortcode.Options{Strategy: shortcode.StrategyHash}, nil)
+		require.NoError(t, err)
+		svc.codes = codes
+
+		res, err := svc.BulkCreateURL(context.Background(), BulkCreateURLInput{
+			User:         premium,
+			AllOrNothing: allOrNothing,
+			Items: []CreateURLInput{
+				{OriginalURL: "https://example.com/same"},
+				{OriginalURL: "https://example.com/same"},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, res.Errors, "all or nothing: %v", allOrNothing)
+		require.Len(t, res.URLs, 2)
+		first, _, err := codes.Candidate(context.Background(), "https://example.com/same", 0)
+		require.NoError(t, err)
+		assert.Equal(t, first, res.URLs[0].ID)
+		assert.NotEqual(t, res.URLs[0].ID, res.URLs[1].ID)
+		assert.Len(t, repo.urls, 2)
+		for _, u := range res.URLs {
+			assert.Same(t, u, repo.urls[u.ID])
+		}
+	}
+}
+
+func TestURLService_CreateURL_HashCodesAcrossOwners(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	codes, err := shortcode.NewAllocator(shortcode.Options{Strategy: shortcode.StrategyHash, MaxAttempts: 3}, nil)
+	require.NoError(t, err)
+	svc.codes = codes
+	ctx := context.Background()
+
+	// Links from other owners are never shared, so they use up the hash
+	// candidates and later ones need random codes
+	ids := make(map[string]bool)
+	for i := 0; i < 8; i++ {
+		u, err := svc.CreateURL(ctx, CreateURLInput{
+			OriginalURL: "https://example.com/popular",
+			UserID:      fmt.Sprintf("user-%d", i),
+		})
+		require.NoError(t, err)
+		assert.False(t, ids[u.ID], "code %q handed out twice", u.ID)
+		ids[u.ID] = true
+	}
+	assert.Len(t, repo.urls, 8)
+
+	// The same owner still gets its existing link back
+	again, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com/popular", UserID: "user-0"})
+	require.NoError(t, err)
+	assert.Equal(t, "user-0", again.UserID)
+	assert.Len(t, repo.urls, 8)
+
+	res, err := svc.BulkCreateURL(ctx, BulkCreateURLInput{
+		User:  &domain.UserContext{UserID: "user-9", IsPremium: true},
+		Items: []CreateURLInput{{OriginalURL: "https://example.com/popular"}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Errors)
+	require.Len(t, res.URLs, 1)
+	assert.False(t, ids[res.URLs[0].ID])
+}
+
+func TestURLService_BulkCreateURL_RequiresPremium(t *testing.T) {
+	svc := newBulkService(t, newMemoryRepo())
+	items := []CreateURLInput{{OriginalURL: "https://example.com"}}
+
+	_, err := svc.BulkCreateURL(context.Background(), BulkCreateURLInput{Items: items})
+	assert.Equal(t, apperrors.CodeUnauthorized, apperrors.AsAppError(err).Code)
+
+	_, err = svc.BulkCreateURL(context.Background(), BulkCreateURLInput{
+		User:  &domain.UserContext{UserID: "user-1"},
+		Items: items,
+	})
+	assert.Equal(t, apperrors.CodeForbidden, apperrors.AsAppError(err).Code)
+
+	_, err = svc.BulkCreateURL(context.Background(), BulkCreateURLInput{User: premium, UserID: "user-2", Items: items})
+	assert.Equal(t, apperrors.CodeForbidden, apperrors.AsAppError(err).Code)
+}
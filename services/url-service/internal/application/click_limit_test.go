@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+)
+
+func TestURLService_ClickLimit(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	ctx := context.Background()
+
+	u, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com/secret", MaxClicks: 3})
+	require.NoError(t, err)
+
+	var granted atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := svc.IncrementClick(ctx, u.ID); err == nil {
+				granted.Add(1)
+			} else {
+				assert.Equal(t, apperrors.CodeURLExpired, apperrors.AsAppError(err).Code)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(3), granted.Load())
+
+	_, err = svc.GetURL(ctx, GetURLInput{ID: u.ID})
+	assert.Equal(t, apperrors.CodeURLExpired, apperrors.AsAppError(err).Code, "used up links behave like expired ones")
+}
+
+func TestURLService_CreateURL_RejectsNegativeMaxClicks(t *testing.T) {
+	svc := newBulkService(t, newMemoryRepo())
+
+	_, err := svc.CreateURL(context.Background(), CreateURLInput{OriginalURL: "https://example.com", MaxClicks: -1})
+	assert.Equal(t, "max_clicks", apperrors.AsAppError(err).Field)
+}
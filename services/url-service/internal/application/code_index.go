@@ -0,0 +1,121 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/shortcode"
+)
+
+// CodeIndexOptions configures the code index
+type CodeIndexOptions struct {
+	Capacity          int           // Codes the filter is sized for
+	FalsePositiveRate float64       // Share of free codes that still need a database check
+	RefreshInterval   time.Duration // Time between rebuilds, picks up codes created by other replicas
+}
+
+// CodeIndex keeps a Bloom filter of every short code in use, so most free codes are
+// confirmed without a database round-trip. Codes created by other replicas are only
+// seen after the next rebuild; until then they may be reported as free, which the
+// unique constraint on insert still catches.
+type CodeIndex struct {
+	repo   domain.URLRepository
+	opts   CodeIndexOptions
+	logger *logger.Logger
+
+	mu       sync.RWMutex
+	filter   *shortcode.BloomFilter // Nil until the first load completes
+	building bool
+	pending  []string // Codes added while a rebuild is scanning
+}
+
+// NewCodeIndex creates an empty index; it answers "maybe taken" until Rebuild succeeds
+func NewCodeIndex(repo domain.URLRepository, opts CodeIndexOptions, log *logger.Logger) *CodeIndex {
+	return &CodeIndex{repo: repo, opts: opts, logger: log}
+}
+
+// MayExist reports false only if code is certainly not in use
+func (i *CodeIndex) MayExist(code string) bool {
+	i.mu.RLock()
+	filter := i.filter
+	i.mu.RUnlock()
+
+	return filter == nil || filter.MayContain(code)
+}
+
+// Add records a newly created code
+func (i *CodeIndex) Add(code string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.filter != nil {
+		i.filter.Add(code)
+	}
+	if i.building {
+		i.pending = append(i.pending, code)
+	}
+}
+
+// Rebuild loads every code into a fresh filter and swaps it in
+func (i *CodeIndex) Rebuild(ctx context.Context) error {
+	i.mu.Lock()
+	i.building = true
+	i.pending = nil
+	i.mu.Unlock()
+
+	filter := shortcode.NewBloomFilter(i.opts.Capacity, i.opts.FalsePositiveRate)
+	err := i.repo.ForEachID(ctx, func(id string) error {
+		filter.Add(id)
+		return nil
+	})
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.building = false
+	if err != nil {
+		i.pending = nil
+		return err
+	}
+
+	// Codes created during the scan may have been missed by it
+	for _, code := range i.pending {
+		filter.Add(code)
+	}
+	i.pending = nil
+	i.filter = filter
+
+	if filter.Saturated() {
+		i.logger.Warn("short code index exceeds its capacity, raise availability.bloom_capacity",
+			zap.Int("capacity", i.opts.Capacity))
+	}
+	return nil
+}
+
+// Run rebuilds the index now and every RefreshInterval until ctx is cancelled
+func (i *CodeIndex) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.opts.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		if err := i.Rebuild(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			i.logger.Error("failed to rebuild short code index", zap.Error(err))
+		} else {
+			i.logger.Debug("rebuilt short code index", zap.Duration("duration", time.Since(start)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
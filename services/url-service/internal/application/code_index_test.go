@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/pkg/logger"
+)
+
+// indexedRepo adds ForEachID to memoryRepo and counts Exists lookups
+type indexedRepo struct {
+	*memoryRepo
+
+	lookups atomic.Int32
+	// scanned, when set, runs after ForEachID has streamed every code
+	scanned  func()
+	failScan error
+}
+
+func (r *indexedRepo) Exists(ctx context.Context, id string) (bool, error) {
+	r.lookups.Add(1)
+	return r.memoryRepo.Exists(ctx, id)
+}
+
+func (r *indexedRepo) ForEachID(_ context.Context, fn func(id string) error) error {
+	if r.failScan != nil {
+		return r.failScan
+	}
+	r.mu.Lock()
+	ids := make([]string, 0, len(r.urls))
+	for id := range r.urls {
+		ids = append(ids, id)
+	}
+	r.mu.Unlock()
+
+	for _, id := range ids {
+		if err := fn(id); err != nil {
+			return err
+		}
+	}
+	if r.scanned != nil {
+		r.scanned()
+	}
+	return nil
+}
+
+func newTestIndex(repo *indexedRepo) *CodeIndex {
+	return NewCodeIndex(repo, CodeIndexOptions{Capacity: 1000, FalsePositiveRate: 0.01}, logger.Default("test"))
+}
+
+func TestCodeIndex_NoFalseNegatives(t *testing.T) {
+	index := newTestIndex(&indexedRepo{memoryRepo: newMemoryRepo()})
+	assert.True(t, index.MayExist("anything"), "an unloaded index rules nothing out")
+
+	require.NoError(t, index.Rebuild(context.Background()))
+	for i := 0; i < 1000; i++ {
+		code := fmt.Sprintf("code%d", i)
+		index.Add(code)
+		assert.True(t, index.MayExist(code), code)
+	}
+}
+
+func TestCodeIndex_Rebuild(t *testing.T) {
+	repo := &indexedRepo{memoryRepo: newMemoryRepo("abc", "def", "example.com/ghi")}
+	index := newTestIndex(repo)
+	// A code created while the scan runs is missed by it but must survive the swap
+	repo.scanned = func() { index.Add("late") }
+
+	require.NoError(t, index.Rebuild(context.Background()))
+	for _, id := range []string{"abc", "def", "example.com/ghi", "late"} {
+		assert.True(t, index.MayExist(id), id)
+	}
+
+	var free []string
+	for i := 0; i < 1000; i++ {
+		if code := fmt.Sprintf("free%d", i); !index.MayExist(code) {
+			free = append(free, code)
+		}
+	}
+	assert.Greater(t, len(free), 950, "most unused codes are ruled out")
+
+	// A failed rebuild keeps the previous filter
+	repo.scanned = nil
+	repo.failScan = assert.AnError
+	assert.ErrorIs(t, index.Rebuild(context.Background()), assert.AnError)
+	assert.True(t, index.MayExist("abc"))
+	assert.False(t, index.MayExist(free[0]))
+}
+
+func TestURLService_CheckAvailability_Index(t *testing.T) {
+	repo := &indexedRepo{memoryRepo: newMemoryRepo("taken")}
+	svc := newBulkService(t, repo)
+	svc.cfg.MaxSuggestions = 3
+	svc.index = newTestIndex(repo)
+	ctx := context.Background()
+	require.NoError(t, svc.index.Rebuild(ctx))
+
+	// Codes the filter rules out are free without a lookup
+	res, err := svc.CheckAvailability(ctx, CheckAvailabilityInput{Code: "unused"})
+	require.NoError(t, err)
+	assert.True(t, res.Available)
+	assert.Zero(t, repo.lookups.Load())
+
+	// Codes created through the service are indexed at once and confirmed by the repository
+	_, err = svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com", CustomCode: "mine"})
+	require.NoError(t, err)
+	for _, code := range []string{"taken", "mine"} {
+		res, err = svc.CheckAvailability(ctx, CheckAvailabilityInput{Code: code})
+		require.NoError(t, err)
+		assert.False(t, res.Available, code)
+		assert.NotEmpty(t, res.Suggestions, code)
+		assert.NotContains(t, res.Suggestions, code)
+	}
+
+	// A positive the repository does not confirm is reported as free
+	svc.index.Add("ghost")
+	before := repo.lookups.Load()
+	res, err = svc.CheckAvailability(ctx, CheckAvailabilityInput{Code: "ghost"})
+	require.NoError(t, err)
+	assert.True(t, res.Available)
+	assert.Equal(t, before+1, repo.lookups.Load())
+}
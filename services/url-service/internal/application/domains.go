@@ -0,0 +1,246 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// verificationValuePrefix precedes the token in a domain's TXT record
+const verificationValuePrefix = "shortener-verify="
+
+// maxHostnameLength is the longest DNS name
+const maxHostnameLength = 253
+
+// DomainOptions configures the domain service
+type DomainOptions struct {
+	MaxPerUser    int           // Domains a user may claim, 0 disables custom domains
+	LookupTimeout time.Duration // Bounds the TXT lookup of a verification, 0 for none
+	ReservedHosts []string      // Hostnames no user may claim, such as the service's own
+}
+
+// DomainService implements custom domain use cases. Users claim a hostname and
+// prove they control it by publishing a TXT record with the claim's token.
+type DomainService struct {
+	repo     domain.DomainRepository
+	resolver domain.TXTResolver
+	opts     DomainOptions
+	logger   *logger.Logger
+	now      func() time.Time
+}
+
+// NewDomainService creates a new domain service
+func NewDomainService(repo domain.DomainRepository, resolver domain.TXTResolver, opts DomainOptions, log *logger.Logger) *DomainService {
+	return &DomainService{
+		repo:     repo,
+		resolver: resolver,
+		opts:     opts,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// VerificationValue returns the TXT record value that proves ownership of d
+func VerificationValue(d *domain.CustomDomain) string {
+	return verificationValuePrefix + d.VerificationToken
+}
+
+// ListDomains returns the domains claimed by a user, newest first
+func (s *DomainService) ListDomains(ctx context.Context, userID string) ([]*domain.CustomDomain, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	domains, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, s.internal(err, "failed to list domains")
+	}
+	return domains, nil
+}
+
+// AddDomain claims a hostname for a user. The claim is unverified until
+// VerifyDomain finds its token in DNS.
+func (s *DomainService) AddDomain(ctx context.Context, userID, hostname string) (*domain.CustomDomain, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	if s.opts.MaxPerUser <= 0 {
+		return nil, apperrors.Forbidden("custom domains are disabled")
+	}
+	host, appErr := NormalizeHostname(hostname)
+	if appErr != nil {
+		return nil, appErr
+	}
+	for _, reserved := range s.opts.ReservedHosts {
+		if host == reserved || strings.HasSuffix(host, "."+reserved) {
+			return nil, apperrors.Validationf("hostname %q cannot be claimed", host).WithField("hostname")
+		}
+	}
+
+	existing, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, s.internal(err, "failed to list domains")
+	}
+	if len(existing) >= s.opts.MaxPerUser {
+		return nil, apperrors.Forbiddenf("at most %d custom domains allowed", s.opts.MaxPerUser).
+			WithDetail("limit", s.opts.MaxPerUser)
+	}
+
+	token, err := newVerificationToken()
+	if err != nil {
+		return nil, s.internal(err, "failed to add domain")
+	}
+	d := &domain.CustomDomain{
+		Hostname:          host,
+		UserID:            userID,
+		VerificationToken: token,
+		CreatedAt:         s.now().UTC(),
+	}
+	if err := s.repo.Create(ctx, d); err != nil {
+		if errors.Is(err, domain.ErrDomainTaken) {
+			return nil, apperrors.AlreadyExistsf("domain %q is already claimed", host).WithField("hostname")
+		}
+		return nil, s.internal(err, "failed to add domain")
+	}
+	return d, nil
+}
+
+// VerifyDomain looks up the TXT record of a user's claim and marks it verified
+// when the record carries the claim's token. A missing record fails with
+// CodeDomainNotVerified so clients can retry once DNS has propagated.
+func (s *DomainService) VerifyDomain(ctx context.Context, userID, hostname string) (*domain.CustomDomain, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	host, appErr := NormalizeHostname(hostname)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	d, err := s.repo.Get(ctx, host, userID)
+	if err != nil {
+		return nil, s.domainError(err, host)
+	}
+	if d.IsVerified() {
+		return d, nil
+	}
+
+	verified := s.lookupToken(ctx, d)
+	now := s.now().UTC()
+	if err := s.repo.MarkChecked(ctx, host, userID, now, verified); err != nil {
+		return nil, s.domainError(err, host)
+	}
+	d.CheckedAt = &now
+	if !verified {
+		return nil, apperrors.Newf(apperrors.CodeDomainNotVerified, "TXT record %s does not contain the verification value", d.VerificationRecord()).
+			WithField("hostname").
+			WithDetail("record", d.VerificationRecord()).
+			WithDetail("value", VerificationValue(d))
+	}
+	d.VerifiedAt = &now
+	return d, nil
+}
+
+// lookupToken reports whether the verification record of d carries its token.
+// Lookup failures count as a missing record; NXDOMAIN is the common case while
+// DNS propagates.
+func (s *DomainService) lookupToken(ctx context.Context, d *domain.CustomDomain) bool {
+	if s.opts.LookupTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.opts.LookupTimeout)
+		defer cancel()
+	}
+
+	records, err := s.resolver.LookupTXT(ctx, d.VerificationRecord())
+	if err != nil {
+		s.logger.Debug("domain verification lookup failed", zap.String("hostname", d.Hostname), zap.Error(err))
+		return false
+	}
+	want := VerificationValue(d)
+	for _, record := range records {
+		if strings.TrimSpace(record) == want {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *DomainService) domainError(err error, host string) error {
+	switch {
+	case errors.Is(err, domain.ErrDomainNotFound):
+		return apperrors.NotFoundf("domain %q not found", host).WithField("hostname")
+	case errors.Is(err, domain.ErrDomainTaken):
+		return apperrors.AlreadyExistsf("domain %q is verified by another user", host).WithField("hostname")
+	default:
+		return s.internal(err, "failed to verify domain")
+	}
+}
+
+// internal logs an unexpected error and wraps it as an internal AppError
+func (s *DomainService) internal(err error, message string) *apperrors.AppError {
+	s.logger.Error(message, zap.Error(err))
+	return apperrors.Wrap(err, apperrors.CodeInternal, message)
+}
+
+// NormalizeHostname lower-cases a hostname, drops a trailing dot and checks it
+// is a public DNS name. Internationalized names must be given in punycode.
+func NormalizeHostname(raw string) (string, *apperrors.AppError) {
+	host := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(raw)), ".")
+	if host == "" {
+		return "", apperrors.Validation("hostname is required").WithField("hostname")
+	}
+	if len(host) > maxHostnameLength {
+		return "", apperrors.Validationf("hostname exceeds %d characters", maxHostnameLength).WithField("hostname")
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		return "", apperrors.Validation("hostname must not be an IP address").WithField("hostname")
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return "", apperrors.Validation("hostname must be a fully qualified domain name").WithField("hostname")
+	}
+	for _, label := range labels {
+		if !validLabel(label) {
+			return "", apperrors.Validationf("hostname %q is not a valid domain name", host).WithField("hostname")
+		}
+	}
+	// A numeric top-level label is an IPv4 address in disguise, e.g. 127.1
+	if strings.Trim(labels[len(labels)-1], "0123456789") == "" {
+		return "", apperrors.Validationf("hostname %q is not a valid domain name", host).WithField("hostname")
+	}
+	return host, nil
+}
+
+// validLabel checks a lower-case DNS label: letters, digits and inner hyphens
+func validLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// newVerificationToken returns a random token for a TXT record
+func newVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("read random: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
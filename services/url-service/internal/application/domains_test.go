@@ -0,0 +1,209 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// memoryDomains is an in-memory domain.DomainRepository
+type memoryDomains struct {
+	mu      sync.Mutex
+	domains []*domain.CustomDomain
+}
+
+func (r *memoryDomains) Create(_ context.Context, d *domain.CustomDomain) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.domains {
+		if existing.Hostname == d.Hostname && (existing.UserID == d.UserID || existing.IsVerified()) {
+			return domain.ErrDomainTaken
+		}
+	}
+	copied := *d
+	r.domains = append(r.domains, &copied)
+	return nil
+}
+
+func (r *memoryDomains) Get(_ context.Context, hostname, userID string) (*domain.CustomDomain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, d := range r.domains {
+		if d.Hostname == hostname && d.UserID == userID {
+			copied := *d
+			return &copied, nil
+		}
+	}
+	return nil, domain.ErrDomainNotFound
+}
+
+func (r *memoryDomains) GetVerified(_ context.Context, hostname string) (*domain.CustomDomain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, d := range r.domains {
+		if d.Hostname == hostname && d.IsVerified() {
+			copied := *d
+			return &copied, nil
+		}
+	}
+	return nil, domain.ErrDomainNotFound
+}
+
+func (r *memoryDomains) ListByUser(_ context.Context, userID string) ([]*domain.CustomDomain, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*domain.CustomDomain
+	for _, d := range r.domains {
+		if d.UserID == userID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryDomains) MarkChecked(_ context.Context, hostname, userID string, at time.Time, verified bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var claim *domain.CustomDomain
+	for _, d := range r.domains {
+		if d.Hostname != hostname {
+			continue
+		}
+		if d.UserID == userID {
+			claim = d
+		} else if verified && d.IsVerified() {
+			return domain.ErrDomainTaken
+		}
+	}
+	if claim == nil {
+		return domain.ErrDomainNotFound
+	}
+	claim.CheckedAt = &at
+	if verified && claim.VerifiedAt == nil {
+		claim.VerifiedAt = &at
+	}
+	return nil
+}
+
+// fakeResolver serves TXT records from a map
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := r[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return records, nil
+}
+
+func TestDomainService_AddAndVerify(t *testing.T) {
+	repo := &memoryDomains{}
+	dns := fakeResolver{}
+	svc := NewDomainService(repo, dns, DomainOptions{MaxPerUser: 2, ReservedHosts: []string{"sho.rt"}}, logger.Default("test"))
+	ctx := context.Background()
+
+	d, err := svc.AddDomain(ctx, "alice", " Go.Example.COM. ")
+	require.NoError(t, err)
+	assert.Equal(t, "go.example.com", d.Hostname)
+	assert.Equal(t, "_shortener-verify.go.example.com", d.VerificationRecord())
+	assert.False(t, d.IsVerified())
+
+	// Bob may claim the hostname too until someone verifies it
+	_, err = svc.AddDomain(ctx, "bob", "go.example.com")
+	require.NoError(t, err)
+	_, err = svc.AddDomain(ctx, "alice", "go.example.com")
+	assert.Equal(t, apperrors.CodeAlreadyExists, apperrors.AsAppError(err).Code)
+
+	_, err = svc.VerifyDomain(ctx, "alice", "go.example.com")
+	appErr := apperrors.AsAppError(err)
+	require.NotNil(t, appErr)
+	assert.Equal(t, apperrors.CodeDomainNotVerified, appErr.Code)
+
+	dns[d.VerificationRecord()] = []string{"v=spf1 -all", VerificationValue(d)}
+	verified, err := svc.VerifyDomain(ctx, "alice", "go.example.com")
+	require.NoError(t, err)
+	assert.True(t, verified.IsVerified())
+	assert.NotNil(t, verified.CheckedAt)
+
+	// Bob's claim cannot be verified any more, even with a valid record
+	bobs, err := repo.Get(ctx, "go.example.com", "bob")
+	require.NoError(t, err)
+	dns[d.VerificationRecord()] = append(dns[d.VerificationRecord()], VerificationValue(bobs))
+	_, err = svc.VerifyDomain(ctx, "bob", "go.example.com")
+	assert.Equal(t, apperrors.CodeAlreadyExists, apperrors.AsAppError(err).Code)
+
+	domains, err := svc.ListDomains(ctx, "alice")
+	require.NoError(t, err)
+	assert.Len(t, domains, 1)
+}
+
+func TestDomainService_AddDomain_Rejects(t *testing.T) {
+	svc := NewDomainService(&memoryDomains{}, fakeResolver{}, DomainOptions{MaxPerUser: 1, ReservedHosts: []string{"sho.rt"}}, logger.Default("test"))
+	ctx := context.Background()
+
+	for _, host := range []string{"", "localhost", "192.168.0.1", "::1", "127.1", "bad_host.example.com", "-a.example.com", "sho.rt", "www.sho.rt"} {
+		_, err := svc.AddDomain(ctx, "alice", host)
+		assert.Equal(t, apperrors.CodeValidation, apperrors.AsAppError(err).Code, host)
+	}
+
+	_, err := svc.AddDomain(ctx, "alice", "a.example.com")
+	require.NoError(t, err)
+	_, err = svc.AddDomain(ctx, "alice", "b.example.com")
+	assert.Equal(t, apperrors.CodeForbidden, apperrors.AsAppError(err).Code)
+}
+
+func TestURLService_CreateURL_CustomDomain(t *testing.T) {
+	repo := newMemoryRepo("promo")
+	svc := newBulkService(t, repo)
+	svc.cfg.BaseURL = "https://sho.rt/"
+	now := time.Now()
+	svc.domains = &memoryDomains{domains: []*domain.CustomDomain{
+		{Hostname: "go.example.com", UserID: "alice", VerifiedAt: &now},
+		{Hostname: "pending.example.com", UserID: "alice"},
+	}}
+	ctx := context.Background()
+
+	// Codes are unique per domain
+	u, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com", CustomCode: "promo", UserID: "alice", Domain: "GO.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "go.example.com/promo", u.ID)
+	assert.Equal(t, "promo", u.Code())
+	assert.Equal(t, "https://go.example.com/promo", svc.ShortURL(u.ID))
+	assert.Equal(t, "https://sho.rt/promo", svc.ShortURL("promo"))
+
+	generated, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com/other", UserID: "alice", Domain: "go.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "go.example.com", generated.Domain())
+
+	res, err := svc.CheckAvailability(ctx, CheckAvailabilityInput{Code: "promo", Domain: "go.example.com"})
+	require.NoError(t, err)
+	assert.False(t, res.Available)
+
+	cases := map[string]struct {
+		in   CreateURLInput
+		code string
+	}{
+		"unverified": {CreateURLInput{UserID: "alice", Domain: "pending.example.com"}, apperrors.CodeDomainNotVerified},
+		"not owned":  {CreateURLInput{UserID: "bob", Domain: "go.example.com"}, apperrors.CodeNotFound},
+		"anonymous":  {CreateURLInput{Domain: "go.example.com"}, apperrors.CodeUnauthorized},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.in.OriginalURL = "https://example.com"
+			_, err := svc.CreateURL(ctx, tc.in)
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.code, appErr.Code)
+			assert.Equal(t, "domain", appErr.Field)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ExpirationOptions configures the expiration sweeper
+type ExpirationOptions struct {
+	Interval     time.Duration // Time between sweeps
+	BatchSize    int           // URLs or events handled per transaction
+	NotifyBefore time.Duration // How far ahead owners are warned, zero disables notices
+}
+
+// ExpirationSweeper deactivates expired URLs, warns owners about upcoming
+// expirations and relays the resulting events. Every step locks the rows it
+// works on, so any number of replicas can run a sweeper.
+type ExpirationSweeper struct {
+	repo      domain.ExpirationRepository
+	outbox    domain.Outbox
+	publisher domain.EventPublisher
+	owners    domain.OwnerDirectory // Nil disables notices
+	opts      ExpirationOptions
+	logger    *logger.Logger
+	now       func() time.Time
+}
+
+// NewExpirationSweeper creates an expiration sweeper; owners may be nil
+func NewExpirationSweeper(repo domain.ExpirationRepository, outbox domain.Outbox, publisher domain.EventPublisher,
+	owners domain.OwnerDirectory, opts ExpirationOptions, log *logger.Logger) *ExpirationSweeper {
+	return &ExpirationSweeper{
+		repo:      repo,
+		outbox:    outbox,
+		publisher: publisher,
+		owners:    owners,
+		opts:      opts,
+		logger:    log,
+		now:       time.Now,
+	}
+}
+
+// Run sweeps every Interval until ctx is cancelled
+func (s *ExpirationSweeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.Sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep runs one pass of expiration, notices and event relay, logging failures
+func (s *ExpirationSweeper) Sweep(ctx context.Context) {
+	if n, err := s.Expire(ctx); err != nil {
+		s.logger.Error("failed to expire urls", zap.Int("expired", n), zap.Error(err))
+	} else if n > 0 {
+		s.logger.Info("expired urls", zap.Int("count", n))
+	}
+
+	if n, err := s.Notify(ctx); err != nil {
+		s.logger.Error("failed to send expiry notices", zap.Int("notified", n), zap.Error(err))
+	} else if n > 0 {
+		s.logger.Info("sent expiry notices", zap.Int("urls", n))
+	}
+
+	if _, err := s.Relay(ctx); err != nil {
+		s.logger.Error("failed to relay events", zap.Error(err))
+	}
+}
+
+// Expire deactivates expired URLs batch by batch and returns how many it handled
+func (s *ExpirationSweeper) Expire(ctx context.Context) (int, error) {
+	return s.drain(ctx, func() (int, error) {
+		expired, err := s.repo.ExpireBatch(ctx, s.now().UTC(), s.opts.BatchSize)
+		return len(expired), err
+	})
+}
+
+// Notify warns owners with email notifications enabled about URLs expiring within
+// NotifyBefore. Each URL is considered once per expiration date.
+func (s *ExpirationSweeper) Notify(ctx context.Context) (int, error) {
+	if s.owners == nil || s.opts.NotifyBefore <= 0 {
+		return 0, nil
+	}
+
+	return s.drain(ctx, func() (int, error) {
+		now := s.now().UTC()
+		return s.repo.ClaimExpiring(ctx, now, now.Add(s.opts.NotifyBefore), s.opts.BatchSize, s.notices)
+	})
+}
+
+// Relay publishes pending events and returns how many were published
+func (s *ExpirationSweeper) Relay(ctx context.Context) (int, error) {
+	return s.drain(ctx, func() (int, error) {
+		return s.outbox.Relay(ctx, s.opts.BatchSize, s.publisher.Publish)
+	})
+}
+
+// notices builds one EventURLExpiring per owner who wants email notifications
+func (s *ExpirationSweeper) notices(ctx context.Context, urls []*domain.URL) ([]domain.Event, error) {
+	byOwner := make(map[string][]*domain.URL)
+	var order []string
+	for _, u := range urls {
+		if _, ok := byOwner[u.UserID]; !ok {
+			order = append(order, u.UserID)
+		}
+		byOwner[u.UserID] = append(byOwner[u.UserID], u)
+	}
+
+	now := s.now().UTC()
+	var events []domain.Event
+	for _, userID := range order {
+		owner, err := s.owners.Owner(ctx, userID)
+		if errors.Is(err, domain.ErrOwnerNotFound) {
+			continue
+		}
+		if err != nil {
+			// Failing the batch leaves its URLs unclaimed for the next sweep
+			return nil, fmt.Errorf("look up owner %s: %w", userID, err)
+		}
+		if !owner.Active || !owner.EmailNotifications || owner.Email == "" {
+			continue
+		}
+
+		payload := domain.URLExpiringPayload{UserID: owner.ID, Email: owner.Email, Name: owner.Name}
+		for _, u := range byOwner[userID] {
+			payload.URLs = append(payload.URLs, domain.ExpiringURLInfo{
+				URLID:       u.ID,
+				OriginalURL: u.OriginalURL,
+				Title:       u.Title,
+				ExpiresAt:   *u.ExpiresAt,
+			})
+		}
+
+		event, err := domain.NewEvent(domain.EventURLExpiring, owner.ID, payload, now)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// drain repeats step while it fills whole batches, returning the total handled
+func (s *ExpirationSweeper) drain(ctx context.Context, step func() (int, error)) (int, error) {
+	var total int
+	for ctx.Err() == nil {
+		n, err := step()
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < s.opts.BatchSize {
+			break
+		}
+	}
+	return total, nil
+}
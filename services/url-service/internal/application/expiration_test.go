@@ -0,0 +1,185 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// memoryExpirations is a domain.ExpirationRepository and domain.Outbox over a
+// list of URLs, recording events as the database would
+type memoryExpirations struct {
+	mu       sync.Mutex
+	urls     []*domain.URL
+	notified map[string]bool
+	events   []domain.Event
+	relayed  int // Events before this index are published
+}
+
+func (m *memoryExpirations) ExpireBatch(_ context.Context, now time.Time, limit int) ([]*domain.URL, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var expired []*domain.URL
+	for _, u := range m.urls {
+		if len(expired) == limit {
+			break
+		}
+		if u.IsActive && u.IsExpired(now) {
+			u.IsActive = false
+			expired = append(expired, u)
+			m.events = append(m.events, domain.Event{ID: int64(len(m.events) + 1), Type: domain.EventURLExpired, AggregateID: u.ID})
+		}
+	}
+	return expired, nil
+}
+
+func (m *memoryExpirations) ClaimExpiring(ctx context.Context, now, deadline time.Time, limit int,
+	notify func(ctx context.Context, urls []*domain.URL) ([]domain.Event, error)) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var claimed []*domain.URL
+	for _, u := range m.urls {
+		if len(claimed) < limit && u.IsActive && u.UserID != "" && !m.notified[u.ID] &&
+			u.ExpiresAt.After(now) && !u.ExpiresAt.After(deadline) {
+			claimed = append(claimed, u)
+		}
+	}
+	if len(claimed) == 0 {
+		return 0, nil
+	}
+	events, err := notify(ctx, claimed)
+	if err != nil {
+		return 0, err
+	}
+	for _, u := range claimed {
+		m.notified[u.ID] = true
+	}
+	for _, e := range events {
+		e.ID = int64(len(m.events) + 1)
+		m.events = append(m.events, e)
+	}
+	return len(claimed), nil
+}
+
+func (m *memoryExpirations) Relay(ctx context.Context, limit int, publish func(context.Context, domain.Event) error) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var n int
+	for m.relayed < len(m.events) && n < limit {
+		if err := publish(ctx, m.events[m.relayed]); err != nil {
+			return n, err
+		}
+		m.relayed++
+		n++
+	}
+	return n, nil
+}
+
+type memoryPublisher struct {
+	events []domain.Event
+}
+
+func (p *memoryPublisher) Publish(_ context.Context, event domain.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+type memoryOwners map[string]*domain.Owner
+
+func (o memoryOwners) Owner(_ context.Context, userID string) (*domain.Owner, error) {
+	owner, ok := o[userID]
+	if !ok {
+		return nil, domain.ErrOwnerNotFound
+	}
+	return owner, nil
+}
+
+func newTestSweeper(repo *memoryExpirations, owners domain.OwnerDirectory, now time.Time) (*ExpirationSweeper, *memoryPublisher) {
+	publisher := &memoryPublisher{}
+	s := NewExpirationSweeper(repo, repo, publisher, owners,
+		ExpirationOptions{Interval: time.Minute, BatchSize: 2, NotifyBefore: 72 * time.Hour}, logger.Default("test"))
+	s.now = func() time.Time { return now }
+	return s, publisher
+}
+
+func expiringURL(id, userID string, expiresAt time.Time) *domain.URL {
+	return &domain.URL{ID: id, OriginalURL: "https://example.com/" + id, UserID: userID, IsActive: true, ExpiresAt: &expiresAt}
+}
+
+func TestExpirationSweeper_Expire(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	repo := &memoryExpirations{notified: map[string]bool{}}
+	for _, id := range []string{"a", "b", "c"} {
+		repo.urls = append(repo.urls, expiringURL(id, "", now.Add(-time.Minute)))
+	}
+	repo.urls = append(repo.urls, expiringURL("later", "", now.Add(time.Hour)))
+	sweeper, publisher := newTestSweeper(repo, nil, now)
+	ctx := context.Background()
+
+	// Full batches are followed by another until one comes back short
+	n, err := sweeper.Expire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.True(t, repo.urls[3].IsActive)
+
+	sweeper.Sweep(ctx)
+	require.Len(t, publisher.events, 3)
+	for i, id := range []string{"a", "b", "c"} {
+		assert.Equal(t, domain.EventURLExpired, publisher.events[i].Type)
+		assert.Equal(t, id, publisher.events[i].AggregateID)
+	}
+
+	// Nothing is expired or published twice
+	sweeper.Sweep(ctx)
+	assert.Len(t, repo.events, 3)
+	assert.Len(t, publisher.events, 3)
+}
+
+func TestExpirationSweeper_Notify(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	soon := now.Add(24 * time.Hour)
+	repo := &memoryExpirations{notified: map[string]bool{}, urls: []*domain.URL{
+		expiringURL("a1", "ada", soon),
+		expiringURL("b1", "bob", soon),
+		expiringURL("a2", "ada", soon),
+		expiringURL("anon", "", soon),
+		expiringURL("far", "ada", now.Add(96*time.Hour)),
+		expiringURL("gone", "ghost", soon),
+	}}
+	owners := memoryOwners{
+		"ada": {ID: "ada", Email: "ada@example.com", Name: "Ada", Active: true, EmailNotifications: true},
+		"bob": {ID: "bob", Email: "bob@example.com", Active: true},
+	}
+	sweeper, _ := newTestSweeper(repo, owners, now)
+	sweeper.opts.BatchSize = 10
+	ctx := context.Background()
+
+	n, err := sweeper.Notify(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	// One notice per owner who wants them, listing all their URLs
+	require.Len(t, repo.events, 1)
+	event := repo.events[0]
+	assert.Equal(t, domain.EventURLExpiring, event.Type)
+	assert.Equal(t, "ada", event.AggregateID)
+	var payload domain.URLExpiringPayload
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	assert.Equal(t, "ada@example.com", payload.Email)
+	require.Len(t, payload.URLs, 2)
+	assert.Equal(t, "a1", payload.URLs[0].URLID)
+	assert.Equal(t, "a2", payload.URLs[1].URLID)
+
+	n, err = sweeper.Notify(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Len(t, repo.events, 1)
+}
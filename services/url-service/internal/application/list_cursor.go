@@ -0,0 +1,101 @@
+package application
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// listScope fingerprints everything that defines a ListURLs result set, so a
+// cursor cannot be replayed with other filters or another user
+func listScope(filter domain.ListFilter, pendingOnly bool) string {
+	return pagination.Scope(
+		"urls",
+		filter.UserID,
+		strconv.FormatBool(filter.ActiveOnly),
+		filter.Search,
+		strings.Join(filter.Tags, ","),
+		strconv.FormatBool(filter.MatchAllTags),
+		formatTime(filter.ActivatesFrom),
+		formatTime(filter.ActivatesTo),
+		strconv.FormatBool(pendingOnly),
+		filter.SortBy,
+		strconv.FormatBool(filter.Desc),
+	)
+}
+
+// decodeListCursor verifies a ListURLs cursor issued for scope
+func (s *URLService) decodeListCursor(token, scope, sortBy string) (*pagination.Cursor, error) {
+	invalid := apperrors.Validation("cursor is invalid or does not match the request").WithField("pagination.cursor")
+	if s.cursors == nil {
+		return nil, invalid
+	}
+
+	cur, err := s.cursors.Decode(token, scope)
+	if err != nil || cur.Page < 2 {
+		return nil, invalid
+	}
+	// Relevance pages by offset, every other order by key
+	if (sortBy == domain.SortRelevance) != (len(cur.Keys) == 0) || len(cur.Keys) > 2 {
+		return nil, invalid
+	}
+	return &cur, nil
+}
+
+// cursorKey returns the keyset position of cur, nil for offset cursors
+func cursorKey(cur *pagination.Cursor) *domain.ListKey {
+	switch len(cur.Keys) {
+	case 1:
+		return &domain.ListKey{ID: cur.Keys[0]}
+	case 2:
+		return &domain.ListKey{Value: &cur.Keys[0], ID: cur.Keys[1]}
+	}
+	return nil
+}
+
+// nextListCursor encodes the position after the last URL of res
+func (s *URLService) nextListCursor(scope string, filter domain.ListFilter, res *ListURLsResult) (string, error) {
+	if s.cursors == nil {
+		return "", nil
+	}
+
+	next := pagination.Cursor{Scope: scope, Page: res.Page + 1, Total: res.Total}
+	if filter.SortBy == domain.SortRelevance {
+		next.Offset = filter.Offset + len(res.URLs)
+	} else {
+		last := res.URLs[len(res.URLs)-1]
+		if value, ok := sortValue(last, filter.SortBy); ok {
+			next.Keys = append(next.Keys, value)
+		}
+		next.Keys = append(next.Keys, last.ID)
+	}
+	return s.cursors.Encode(next)
+}
+
+// sortValue returns the value u is ordered by, false when it has none
+func sortValue(u *domain.URL, sortBy string) (string, bool) {
+	switch sortBy {
+	case domain.SortClickCount:
+		return strconv.FormatInt(u.ClickCount, 10), true
+	case domain.SortLastAccessed:
+		if u.LastAccessed == nil {
+			return "", false
+		}
+		return u.LastAccessed.UTC().Format(time.RFC3339Nano), true
+	case domain.SortTitle:
+		return u.Title, true
+	}
+	return u.CreatedAt.UTC().Format(time.RFC3339Nano), true
+}
+
+// formatTime formats an optional filter bound, empty when unset
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// filterRepo records the filter passed to List and returns its fixed page
+type filterRepo struct {
+	domain.URLRepository
+	filter domain.ListFilter
+	urls   []*domain.URL
+	total  int64
+}
+
+func (r *filterRepo) List(_ context.Context, filter domain.ListFilter) ([]*domain.URL, int64, error) {
+	r.filter = filter
+	return r.urls, r.total, nil
+}
+
+func TestURLService_ListURLs_Filters(t *testing.T) {
+	cfg := config.URLConfig{DefaultLimit: 20, MaxLimit: 100}
+
+	cases := []struct {
+		name     string
+		in       ListURLsInput
+		wantSort string
+		wantDesc bool
+	}{
+		{name: "default", in: ListURLsInput{}, wantSort: domain.SortCreatedAt, wantDesc: true},
+		{name: "search ranks", in: ListURLsInput{Search: "  go tips "}, wantSort: domain.SortRelevance, wantDesc: true},
+		{name: "explicit", in: ListURLsInput{Search: "go", SortBy: "click_count", Desc: true}, wantSort: domain.SortClickCount, wantDesc: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &filterRepo{}
+			tc.in.UserID = "user-1"
+			tc.in.Tags = []string{" Go ", "go", "News"}
+
+			_, err := NewURLService(repo, nil, nil, nil, nil, nil, nil, nil, cfg, logger.Default("test")).ListURLs(context.Background(), tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantSort, repo.filter.SortBy)
+			assert.Equal(t, tc.wantDesc, repo.filter.Desc)
+			assert.Equal(t, []string{"go", "news"}, repo.filter.Tags)
+		})
+	}
+}
+
+func TestURLService_ListURLs_RejectsUnknownSort(t *testing.T) {
+	svc := NewURLService(&filterRepo{}, nil, nil, nil, nil, nil, nil, nil, config.URLConfig{}, logger.Default("test"))
+
+	for _, sortBy := range []string{"password_hash", "relevance", "id; DROP TABLE urls"} {
+		_, err := svc.ListURLs(context.Background(), ListURLsInput{UserID: "user-1", SortBy: sortBy})
+		appErr := apperrors.AsAppError(err)
+		require.NotNil(t, appErr, sortBy)
+		assert.Equal(t, "pagination.sort_by", appErr.Field)
+	}
+}
+
+func TestURLService_ListURLs_Cursor(t *testing.T) {
+	cursors, err := pagination.NewCodec([]byte(strings.Repeat("k", pagination.MinSecretLength)))
+	require.NoError(t, err)
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC)
+	repo := &filterRepo{total: 3, urls: []*domain.URL{
+		{ID: "c", CreatedAt: created.Add(2 * time.Second)},
+		{ID: "b", CreatedAt: created},
+		{ID: "a", CreatedAt: created.Add(-time.Second)},
+	}}
+	svc := NewURLService(repo, nil, nil, nil, nil, cursors, nil, nil, config.URLConfig{DefaultLimit: 2, MaxLimit: 100}, logger.Default("test"))
+	ctx := context.Background()
+
+	first, err := svc.ListURLs(ctx, ListURLsInput{UserID: "user-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, repo.filter.Limit, "one extra row detects the next page")
+	require.Len(t, first.URLs, 2)
+	require.NotEmpty(t, first.NextCursor)
+
+	repo.urls, repo.total = repo.urls[2:], 0
+	second, err := svc.ListURLs(ctx, ListURLsInput{UserID: "user-1", Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.NotNil(t, repo.filter.After)
+	assert.Equal(t, "b", repo.filter.After.ID)
+	assert.Equal(t, "2024-05-01T12:00:00.123456Z", *repo.filter.After.Value)
+	assert.True(t, repo.filter.SkipCount)
+	assert.Equal(t, int64(3), second.Total, "total is carried by the cursor")
+	assert.Equal(t, 2, second.Page)
+	assert.Empty(t, second.NextCursor)
+
+	_, err = svc.ListURLs(ctx, ListURLsInput{UserID: "user-2", Cursor: first.NextCursor})
+	assert.Equal(t, "pagination.cursor", apperrors.AsAppError(err).Field, "cursor is bound to its query")
+	_, err = svc.ListURLs(ctx, ListURLsInput{UserID: "user-1", Cursor: first.NextCursor + "x"})
+	assert.Equal(t, "pagination.cursor", apperrors.AsAppError(err).Field)
+}
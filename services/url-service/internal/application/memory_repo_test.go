@@ -0,0 +1,214 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// memoryRepo is a minimal transactional domain.URLRepository
+type memoryRepo struct {
+	domain.URLRepository
+
+	mu        sync.Mutex
+	urls      map[string]*domain.URL
+	revisions []*domain.URLRevision // Oldest first
+	lastRev   int64
+}
+
+func newMemoryRepo(ids ...string) *memoryRepo {
+	r := &memoryRepo{urls: make(map[string]*domain.URL)}
+	for _, id := range ids {
+		r.urls[id] = &domain.URL{ID: id, OriginalURL: "https://taken.example.com", IsActive: true}
+	}
+	return r
+}
+
+func (r *memoryRepo) Create(_ context.Context, u *domain.URL) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.urls[u.ID]; ok {
+		return domain.ErrCodeTaken
+	}
+	r.urls[u.ID] = u
+	return nil
+}
+
+func (r *memoryRepo) GetByID(_ context.Context, id string) (*domain.URL, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.urls[id]
+	if !ok {
+		return nil, domain.ErrURLNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *memoryRepo) Exists(_ context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.urls[id]
+	return ok, nil
+}
+
+// Bulk stages inserts and applies them only if fn succeeds
+func (r *memoryRepo) Bulk(ctx context.Context, fn func(w domain.BulkWriter) error) error {
+	tx := &memoryTx{repo: r, staged: make(map[string]*domain.URL)}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, u := range tx.staged {
+		r.urls[id] = u
+	}
+	return nil
+}
+
+type memoryTx struct {
+	repo   *memoryRepo
+	staged map[string]*domain.URL
+}
+
+func (tx *memoryTx) InsertMany(_ context.Context, urls []*domain.URL) ([]int, error) {
+	tx.repo.mu.Lock()
+	defer tx.repo.mu.Unlock()
+
+	var skipped []int
+	for i, u := range urls {
+		_, committed := tx.repo.urls[u.ID]
+		_, staged := tx.staged[u.ID]
+		if committed || staged {
+			skipped = append(skipped, i)
+			continue
+		}
+		tx.staged[u.ID] = u
+	}
+	return skipped, nil
+}
+
+func (r *memoryRepo) Update(_ context.Context, u *domain.URL, loadedAt time.Time, rev *domain.URLRevision, keep int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.urls[u.ID]
+	if !ok {
+		return domain.ErrURLNotFound
+	}
+	if !stored.UpdatedAt.Equal(loadedAt) {
+		return domain.ErrURLModified
+	}
+	r.urls[u.ID] = u
+	if rev == nil {
+		return nil
+	}
+
+	r.lastRev++
+	rev.ID = r.lastRev
+	r.revisions = append(r.revisions, rev)
+	var kept []*domain.URLRevision
+	for i := len(r.revisions) - 1; i >= 0; i-- {
+		if r.revisions[i].URLID != u.ID {
+			kept = append(kept, r.revisions[i])
+		} else if keep > 0 {
+			kept = append(kept, r.revisions[i])
+			keep--
+		}
+	}
+	slices.Reverse(kept)
+	r.revisions = kept
+	return nil
+}
+
+func (r *memoryRepo) ReplacePasswordHash(_ context.Context, id, oldHash, newHash string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.urls[id]
+	if !ok || u.PasswordHash != oldHash {
+		return domain.ErrURLNotFound
+	}
+	u.PasswordHash, u.UpdatedAt = newHash, at
+	return nil
+}
+
+func (r *memoryRepo) ListRevisions(_ context.Context, id string) ([]*domain.URLRevision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*domain.URLRevision
+	for i := len(r.revisions) - 1; i >= 0; i-- {
+		if r.revisions[i].URLID == id {
+			out = append(out, r.revisions[i])
+		}
+	}
+	return out, nil
+}
+
+// IncrementClick enforces MaxClicks under the repository lock, as the database does
+func (r *memoryRepo) IncrementClick(_ context.Context, id string, at time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.urls[id]
+	if !ok {
+		return 0, domain.ErrURLNotFound
+	}
+	if u.IsExhausted() {
+		return 0, domain.ErrClickLimitReached
+	}
+	u.ClickCount++
+	u.LastAccessed = &at
+	return u.ClickCount, nil
+}
+
+// UpdateRules applies fn under the repository lock, as the row lock does in the database
+func (r *memoryRepo) UpdateRules(_ context.Context, id string, at time.Time,
+	fn func(rules []domain.RedirectRule) ([]domain.RedirectRule, error)) ([]domain.RedirectRule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.urls[id]
+	if !ok {
+		return nil, domain.ErrURLNotFound
+	}
+	rules, err := fn(append([]domain.RedirectRule(nil), u.Rules...))
+	if err != nil {
+		return nil, err
+	}
+	u.Rules, u.UpdatedAt = rules, at
+	return rules, nil
+}
+
+// memoryAttempts blocks a key for an hour after max failures
+type memoryAttempts struct {
+	mu       sync.Mutex
+	max      int
+	failures map[string]int
+}
+
+func newMemoryAttempts(max int) *memoryAttempts {
+	return &memoryAttempts{max: max, failures: make(map[string]int)}
+}
+
+func (a *memoryAttempts) Blocked(_ context.Context, key string) (time.Duration, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.failures[key] >= a.max {
+		return time.Hour, nil
+	}
+	return 0, nil
+}
+
+func (a *memoryAttempts) Fail(_ context.Context, key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.failures[key]++
+	return nil
+}
+
+func (a *memoryAttempts) Reset(_ context.Context, key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.failures, key)
+	return nil
+}
@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/pkg/ratelimit"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// PasswordGuard hashes link passwords, throttles wrong guesses and issues unlock tokens
+type PasswordGuard struct {
+	hasher   *password.Hasher
+	attempts ratelimit.AttemptLimiter
+	secret   []byte
+	tokenTTL time.Duration
+	now      func() time.Time
+}
+
+// NewPasswordGuard creates a guard; secret signs unlock tokens valid for tokenTTL
+func NewPasswordGuard(hasher *password.Hasher, attempts ratelimit.AttemptLimiter, secret []byte, tokenTTL time.Duration) *PasswordGuard {
+	return &PasswordGuard{
+		hasher:   hasher,
+		attempts: attempts,
+		secret:   secret,
+		tokenTTL: tokenTTL,
+		now:      time.Now,
+	}
+}
+
+// Hash hashes a new link password
+func (g *PasswordGuard) Hash(plain string) (string, error) {
+	hash, err := g.hasher.Hash(plain)
+	if err != nil {
+		if errors.Is(err, password.ErrPasswordLength) {
+			return "", apperrors.Validationf("password must be between 1 and %d bytes", password.MaxLength).WithField("password")
+		}
+		return "", apperrors.Wrap(err, apperrors.CodeInternal, "failed to hash password")
+	}
+	return hash, nil
+}
+
+// NeedsRehash reports whether a stored hash should be upgraded
+func (g *PasswordGuard) NeedsRehash(hash string) bool {
+	return g.hasher.NeedsRehash(hash)
+}
+
+// Blocked returns a rate limit error when client has guessed wrong too often for u
+func (g *PasswordGuard) Blocked(ctx context.Context, u *domain.URL, client string) (*apperrors.AppError, error) {
+	wait, err := g.attempts.Blocked(ctx, attemptKey(u.ID, client))
+	if err != nil || wait <= 0 {
+		return nil, err
+	}
+	return apperrors.RateLimit("too many wrong password attempts, try again later").
+		WithField("password").
+		WithDetail("retry_after", int(math.Ceil(wait.Seconds()))), nil
+}
+
+// Verify checks a password, recording failures per code and client
+func (g *PasswordGuard) Verify(ctx context.Context, u *domain.URL, plain, client string) (bool, error) {
+	key := attemptKey(u.ID, client)
+
+	err := g.hasher.Verify(plain, u.PasswordHash)
+	switch {
+	case err == nil:
+		return true, g.attempts.Reset(ctx, key)
+	case errors.Is(err, password.ErrMismatch):
+		return false, g.attempts.Fail(ctx, key)
+	default:
+		return false, err
+	}
+}
+
+// IssueToken returns an unlock token for u and its expiry
+func (g *PasswordGuard) IssueToken(u *domain.URL) (string, time.Time) {
+	expires := g.now().Add(g.tokenTTL).Truncate(time.Second)
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return exp + "." + g.sign(u, exp), expires
+}
+
+// VerifyToken reports whether token unlocks u. Tokens are bound to the code
+// and the current password hash, so changing the password revokes them.
+func (g *PasswordGuard) VerifyToken(u *domain.URL, token string) bool {
+	exp, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || !g.now().Before(time.Unix(unix, 0)) {
+		return false
+	}
+	return hmac.Equal([]byte(sig), []byte(g.sign(u, exp)))
+}
+
+func (g *PasswordGuard) sign(u *domain.URL, exp string) string {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write([]byte(u.ID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(exp))
+	mac.Write([]byte{0})
+	mac.Write([]byte(u.PasswordHash))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func attemptKey(code, client string) string {
+	return code + ":" + client
+}
@@ -0,0 +1,140 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/pkg/ratelimit"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func newTestGuard(attempts ratelimit.AttemptLimiter) *PasswordGuard {
+	hasher := password.NewHasher(password.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 8, KeyLength: 16})
+	return NewPasswordGuard(hasher, attempts, []byte("unlock secret"), 10*time.Minute)
+}
+
+// assertCode checks the application error code and field of err
+func assertCode(t *testing.T, err error, code, field string) {
+	t.Helper()
+	appErr := apperrors.AsAppError(err)
+	require.NotNil(t, appErr, "not an application error: %v", err)
+	assert.Equal(t, code, appErr.Code)
+	assert.Equal(t, field, appErr.Field)
+}
+
+func TestPasswordGuard_VerifyToken(t *testing.T) {
+	guard := newTestGuard(nil)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	guard.now = func() time.Time { return now }
+	u := &domain.URL{ID: "abc", PasswordHash: "hash-1"}
+
+	token, expires := guard.IssueToken(u)
+	assert.Equal(t, now.Add(10*time.Minute), expires)
+	assert.True(t, guard.VerifyToken(u, token))
+
+	assert.False(t, guard.VerifyToken(&domain.URL{ID: "abd", PasswordHash: "hash-1"}, token))
+	_, sig, _ := strings.Cut(token, ".")
+	for _, forged := range []string{"", "abc", token + "x", "9999999999." + sig} {
+		assert.False(t, guard.VerifyToken(u, forged), forged)
+	}
+
+	// Changing the password revokes tokens issued for the old one
+	assert.False(t, guard.VerifyToken(&domain.URL{ID: "abc", PasswordHash: "hash-2"}, token))
+
+	now = expires.Add(-time.Second)
+	assert.True(t, guard.VerifyToken(u, token))
+	now = expires
+	assert.False(t, guard.VerifyToken(u, token))
+}
+
+func TestURLService_GetURL_Password(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	svc.guard = newTestGuard(newMemoryAttempts(3))
+	ctx := context.Background()
+
+	hash, err := svc.guard.Hash("secret")
+	require.NoError(t, err)
+	assert.NotContains(t, hash, "secret")
+	repo.urls["abc"] = &domain.URL{ID: "abc", OriginalURL: "https://example.com", IsActive: true, PasswordHash: hash}
+
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", ClientIP: "192.0.2.1"})
+	assertCode(t, err, apperrors.CodePasswordRequired, "password")
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", Password: "wrong", ClientIP: "192.0.2.1"})
+	assertCode(t, err, apperrors.CodeInvalidPassword, "password")
+
+	res, err := svc.UnlockURL(ctx, UnlockURLInput{ID: "abc", Password: "secret", ClientIP: "192.0.2.1"})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Token)
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", UnlockToken: res.Token})
+	require.NoError(t, err)
+
+	// A new password locks out visitors holding a token for the old one
+	other, err := svc.guard.Hash("other")
+	require.NoError(t, err)
+	repo.urls["abc"].PasswordHash = other
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", UnlockToken: res.Token})
+	assertCode(t, err, apperrors.CodePasswordRequired, "password")
+}
+
+func TestURLService_GetURL_PasswordThrottled(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	svc.guard = newTestGuard(newMemoryAttempts(3))
+	ctx := context.Background()
+
+	hash, err := svc.guard.Hash("secret")
+	require.NoError(t, err)
+	repo.urls["abc"] = &domain.URL{ID: "abc", OriginalURL: "https://example.com", IsActive: true, PasswordHash: hash}
+
+	for i := 0; i < 3; i++ {
+		_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", Password: "wrong", ClientIP: "192.0.2.1"})
+		assertCode(t, err, apperrors.CodeInvalidPassword, "password")
+	}
+	// Once blocked, even the right password is refused
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", Password: "secret", ClientIP: "192.0.2.1"})
+	assertCode(t, err, apperrors.CodeRateLimit, "password")
+	assert.Equal(t, 3600, apperrors.AsAppError(err).Details["retry_after"])
+
+	// Other visitors are throttled separately, and success clears their failures
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", Password: "wrong", ClientIP: "192.0.2.2"})
+	assertCode(t, err, apperrors.CodeInvalidPassword, "password")
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", Password: "secret", ClientIP: "192.0.2.2"})
+	require.NoError(t, err)
+	assert.Empty(t, svc.guard.attempts.(*memoryAttempts).failures[attemptKey("abc", "192.0.2.2")])
+}
+
+func TestURLService_GetURL_RehashStampsURL(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	svc.guard = newTestGuard(newMemoryAttempts(3))
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	legacy := password.NewHasher(password.Params{Memory: 64, Iterations: 2, Parallelism: 1, SaltLength: 8, KeyLength: 16})
+	hash, err := legacy.Hash("secret")
+	require.NoError(t, err)
+	loadedAt := now.Add(-time.Hour)
+	repo.urls["abc"] = &domain.URL{ID: "abc", OriginalURL: "https://example.com", IsActive: true, PasswordHash: hash, UpdatedAt: loadedAt}
+	stale, err := repo.GetByID(ctx, "abc")
+	require.NoError(t, err)
+
+	_, err = svc.GetURL(ctx, GetURLInput{ID: "abc", Password: "secret", ClientIP: "192.0.2.1"})
+	require.NoError(t, err)
+	upgraded := repo.urls["abc"]
+	assert.NotEqual(t, hash, upgraded.PasswordHash)
+	assert.False(t, svc.guard.NeedsRehash(upgraded.PasswordHash))
+	assert.Equal(t, now, upgraded.UpdatedAt)
+
+	// An edit loaded before the upgrade cannot write the legacy hash back
+	stale.Title = "edited"
+	assert.ErrorIs(t, repo.Update(ctx, stale, loadedAt, nil, 0), domain.ErrURLModified)
+}
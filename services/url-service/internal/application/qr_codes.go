@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+	"image/color"
+	"net/url"
+	"strings"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// minQRSize keeps modules of the largest codes for short URLs at least a pixel wide
+const minQRSize = 64
+
+var qrContentTypes = map[string]string{
+	domain.QRFormatPNG: "image/png",
+	domain.QRFormatSVG: "image/svg+xml",
+}
+
+// QRCodeInput holds data for rendering the QR code of a short URL. Empty
+// options take the configured defaults.
+type QRCodeInput struct {
+	ID         string
+	Format     string // png or svg, defaults to png
+	Size       int    // Pixels per side
+	Level      string // Error correction: L, M, Q or H
+	Foreground string // Hex colour, e.g. "#000000"
+	Background string
+	Logo       bool // Requires a configured logo, raises the level to H
+}
+
+// QRCode is a rendered QR code image
+type QRCode struct {
+	Data        []byte
+	ContentType string
+}
+
+// QRCodeService renders QR codes pointing at short URLs. Codes carry the
+// src=qr marker so scans are told apart from other clicks.
+type QRCodeService struct {
+	urls     *URLService
+	renderer domain.QRRenderer
+	cfg      config.QRConfig
+	logger   *logger.Logger
+}
+
+// NewQRCodeService creates a new QR code service
+func NewQRCodeService(urls *URLService, renderer domain.QRRenderer, cfg config.QRConfig, log *logger.Logger) *QRCodeService {
+	return &QRCodeService{urls: urls, renderer: renderer, cfg: cfg, logger: log}
+}
+
+// QRCode renders the QR code of a short URL. Scheduled links get codes before
+// they activate, print runs are usually prepared ahead of a launch.
+func (s *QRCodeService) QRCode(ctx context.Context, in QRCodeInput) (*QRCode, error) {
+	opts, err := s.options(in)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.urls.load(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsBlocked() {
+		return nil, apperrors.Newf(apperrors.CodeURLBlocked, "short url %q was disabled because its destination is unsafe", in.ID)
+	}
+	if !u.IsActive {
+		return nil, apperrors.NotFoundf("short url %q is disabled", in.ID)
+	}
+	if u.IsExpired(s.urls.now()) {
+		return nil, apperrors.Newf(apperrors.CodeURLExpired, "short url %q has expired", in.ID)
+	}
+	if u.IsExhausted() {
+		return nil, clickLimitReached(in.ID)
+	}
+
+	data, err := s.renderer.Render(ctx, QRContent(s.urls.ShortURL(u.ID)), opts)
+	if err != nil {
+		return nil, s.urls.internal(err, "failed to render qr code")
+	}
+	return &QRCode{Data: data, ContentType: qrContentTypes[opts.Format]}, nil
+}
+
+// QRContent returns the URL a QR code encodes for shortURL
+func QRContent(shortURL string) string {
+	return shortURL + "?src=" + url.QueryEscape(domain.QRSourceMarker)
+}
+
+// options validates in and fills in defaults
+func (s *QRCodeService) options(in QRCodeInput) (domain.QROptions, error) {
+	opts := domain.QROptions{
+		Format:     strings.ToLower(in.Format),
+		Size:       in.Size,
+		Level:      strings.ToUpper(in.Level),
+		Foreground: color.RGBA{A: 0xff},
+		Background: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		Logo:       in.Logo,
+	}
+
+	if opts.Format == "" {
+		opts.Format = domain.QRFormatPNG
+	}
+	if _, ok := qrContentTypes[opts.Format]; !ok {
+		return opts, apperrors.Validation("format must be png or svg").WithField("format")
+	}
+
+	if opts.Size == 0 {
+		opts.Size = s.cfg.DefaultSize
+	}
+	if opts.Size < minQRSize || opts.Size > s.cfg.MaxSize {
+		return opts, apperrors.Validationf("size must be between %d and %d", minQRSize, s.cfg.MaxSize).WithField("size")
+	}
+
+	if opts.Level == "" {
+		opts.Level = s.cfg.DefaultLevel
+	}
+	switch opts.Level {
+	case domain.QRLevelLow, domain.QRLevelMedium, domain.QRLevelQuartile, domain.QRLevelHigh:
+	default:
+		return opts, apperrors.Validation("level must be L, M, Q or H").WithField("level")
+	}
+
+	if in.Foreground != "" {
+		c, ok := parseHexColor(in.Foreground)
+		if !ok {
+			return opts, apperrors.Validation("foreground must be a hex colour like #000000").WithField("foreground")
+		}
+		opts.Foreground = c
+	}
+	if in.Background != "" {
+		c, ok := parseHexColor(in.Background)
+		if !ok {
+			return opts, apperrors.Validation("background must be a hex colour like #ffffff").WithField("background")
+		}
+		opts.Background = c
+	}
+	// Many scanners cannot read light-on-dark codes
+	if luminance(opts.Foreground) >= luminance(opts.Background) {
+		return opts, apperrors.Validation("foreground must be darker than background").WithField("foreground")
+	}
+
+	if opts.Logo {
+		if s.cfg.LogoFile == "" {
+			return opts, apperrors.Validation("no logo is configured").WithField("logo")
+		}
+		// The logo covers modules, only the highest level restores them reliably
+		opts.Level = domain.QRLevelHigh
+	}
+	return opts, nil
+}
+
+// parseHexColor parses "#rrggbb" or "rrggbb"
+func parseHexColor(s string) (color.RGBA, bool) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "#"))
+	if err != nil || len(b) != 3 {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{R: b[0], G: b[1], B: b[2], A: 0xff}, true
+}
+
+// luminance approximates perceived brightness, Rec. 601 weights
+func luminance(c color.RGBA) int {
+	return 299*int(c.R) + 587*int(c.G) + 114*int(c.B)
+}
@@ -0,0 +1,83 @@
+package application
+
+import (
+	"context"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// recordingRenderer remembers what it was asked to render
+type recordingRenderer struct {
+	content string
+	opts    domain.QROptions
+}
+
+func (r *recordingRenderer) Render(_ context.Context, content string, opts domain.QROptions) ([]byte, error) {
+	r.content, r.opts = content, opts
+	return []byte("image"), nil
+}
+
+func TestQRCodeService_QRCode(t *testing.T) {
+	repo := newMemoryRepo("abc123", "go.example.com/promo")
+	past := time.Now().Add(-time.Hour)
+	repo.urls["gone"] = &domain.URL{ID: "gone", IsActive: true, ExpiresAt: &past}
+	urls := newBulkService(t, repo)
+	urls.cfg.BaseURL = "https://sho.rt"
+
+	renderer := &recordingRenderer{}
+	svc := NewQRCodeService(urls, renderer, config.QRConfig{DefaultSize: 256, MaxSize: 1024, DefaultLevel: "M"}, logger.Default("test"))
+	ctx := context.Background()
+
+	code, err := svc.QRCode(ctx, QRCodeInput{ID: "abc123"})
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", code.ContentType)
+	assert.Equal(t, "https://sho.rt/abc123?src=qr", renderer.content)
+	assert.Equal(t, domain.QROptions{
+		Format: domain.QRFormatPNG, Size: 256, Level: domain.QRLevelMedium,
+		Foreground: color.RGBA{A: 0xff}, Background: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	}, renderer.opts)
+
+	code, err = svc.QRCode(ctx, QRCodeInput{ID: "go.example.com/promo", Format: "SVG", Size: 512, Level: "q", Foreground: "#1a2b3c", Background: "fafafa"})
+	require.NoError(t, err)
+	assert.Equal(t, "image/svg+xml", code.ContentType)
+	assert.Equal(t, "https://go.example.com/promo?src=qr", renderer.content)
+	assert.Equal(t, domain.QRLevelQuartile, renderer.opts.Level)
+	assert.Equal(t, color.RGBA{R: 0x1a, G: 0x2b, B: 0x3c, A: 0xff}, renderer.opts.Foreground)
+
+	_, err = svc.QRCode(ctx, QRCodeInput{ID: "gone"})
+	assert.Equal(t, apperrors.CodeURLExpired, apperrors.AsAppError(err).Code)
+	_, err = svc.QRCode(ctx, QRCodeInput{ID: "missing"})
+	assert.Equal(t, apperrors.CodeNotFound, apperrors.AsAppError(err).Code)
+
+	cases := map[string]struct {
+		in    QRCodeInput
+		field string
+	}{
+		"format":       {QRCodeInput{Format: "gif"}, "format"},
+		"too small":    {QRCodeInput{Size: 32}, "size"},
+		"too large":    {QRCodeInput{Size: 4096}, "size"},
+		"level":        {QRCodeInput{Level: "X"}, "level"},
+		"colour":       {QRCodeInput{Foreground: "black"}, "foreground"},
+		"inverted":     {QRCodeInput{Foreground: "#ffffff", Background: "#000000"}, "foreground"},
+		"missing logo": {QRCodeInput{Logo: true}, "logo"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.in.ID = "abc123"
+			_, err := svc.QRCode(ctx, tc.in)
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr)
+			assert.Equal(t, apperrors.CodeValidation, appErr.Code)
+			assert.Equal(t, tc.field, appErr.Field)
+		})
+	}
+}
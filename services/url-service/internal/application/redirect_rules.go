@@ -0,0 +1,378 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/fieldmask"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/redirect"
+)
+
+// maxRuleValues caps each condition list of a redirect rule
+const maxRuleValues = 50
+
+// positionPath moves a rule in UpdateRedirectRule masks; it is not a rule field
+const positionPath = "position"
+
+var (
+	countryPattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+	languagePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{1,8})*$`)
+)
+
+var ruleDeviceTypes = []string{domain.DeviceDesktop, domain.DeviceMobile, domain.DeviceTablet}
+
+var ruleOperatingSystems = []string{
+	domain.OSWindows, domain.OSMacOS, domain.OSIOS, domain.OSAndroid, domain.OSLinux, domain.OSChromeOS,
+}
+
+// RedirectRuleInput holds the settable fields of a redirect rule
+type RedirectRuleInput struct {
+	DestinationURL   string
+	Countries        []string
+	DeviceTypes      []string
+	OperatingSystems []string
+	Languages        []string
+	TimeWindow       *TimeWindowInput // Nil for any time
+	Disabled         bool
+}
+
+// TimeWindowInput is a daily time range as clients send it
+type TimeWindowInput struct {
+	Start    string // "HH:MM"
+	End      string // "HH:MM", before Start wraps past midnight
+	Timezone string // IANA name, UTC when empty
+	Weekdays []int  // 0 is Sunday, empty for every day
+}
+
+// CreateRuleInput holds data for adding a redirect rule to a URL
+type CreateRuleInput struct {
+	URLID    string
+	UserID   string
+	Rule     RedirectRuleInput
+	Position *int // Index to insert at, nil or past the end appends
+}
+
+// UpdateRuleInput holds data for changing a redirect rule
+type UpdateRuleInput struct {
+	URLID    string
+	UserID   string
+	RuleID   string
+	Rule     RedirectRuleInput
+	Position *int     // New index in evaluation order, nil keeps it
+	Mask     []string // Rule fields to update and "position"; empty replaces the whole rule
+}
+
+// ListRedirectRules returns the redirect rules of a URL in evaluation order
+func (s *URLService) ListRedirectRules(ctx context.Context, urlID, userID string) ([]domain.RedirectRule, error) {
+	u, err := s.loadOwned(ctx, urlID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.Rules, nil
+}
+
+// CreateRedirectRule adds a redirect rule to a URL owned by the requesting user
+func (s *URLService) CreateRedirectRule(ctx context.Context, in CreateRuleInput) (*domain.RedirectRule, error) {
+	setters := s.ruleSetters(in.Rule)
+	rule := domain.RedirectRule{}
+	if err := setters.Apply(&rule, ruleFields); err != nil {
+		return nil, err
+	}
+	if err := validateConditions(&rule); err != nil {
+		return nil, err
+	}
+	if err := validatePosition(in.Position); err != nil {
+		return nil, err
+	}
+	if _, err := s.loadOwned(ctx, in.URLID, in.UserID); err != nil {
+		return nil, err
+	}
+
+	id, err := newRuleID()
+	if err != nil {
+		return nil, s.internal(err, "failed to generate rule id")
+	}
+	now := s.now().UTC()
+	rule.ID, rule.CreatedAt, rule.UpdatedAt = id, now, now
+
+	_, err = s.repo.UpdateRules(ctx, in.URLID, now, func(rules []domain.RedirectRule) ([]domain.RedirectRule, error) {
+		if len(rules) >= s.cfg.MaxRedirectRules {
+			return nil, apperrors.Validationf("a url can have at most %d redirect rules", s.cfg.MaxRedirectRules).
+				WithField("rule")
+		}
+		return slices.Insert(rules, insertIndex(in.Position, len(rules)), rule), nil
+	})
+	if err != nil {
+		return nil, s.ruleError(err, in.URLID, "")
+	}
+	return &rule, nil
+}
+
+// UpdateRedirectRule changes a redirect rule of a URL owned by the requesting user
+func (s *URLService) UpdateRedirectRule(ctx context.Context, in UpdateRuleInput) (*domain.RedirectRule, error) {
+	if in.RuleID == "" {
+		return nil, apperrors.Validation("rule id is required").WithField("rule.id")
+	}
+
+	// Position is handled here, the rest of the mask by the setters
+	mask, move := make([]string, 0, len(in.Mask)), in.Position != nil
+	for _, path := range in.Mask {
+		if strings.TrimSpace(path) == positionPath {
+			move = true
+			continue
+		}
+		mask = append(mask, path)
+	}
+	if len(in.Mask) == 0 {
+		mask = ruleFields
+	}
+
+	setters := s.ruleSetters(in.Rule)
+	mask, err := setters.Validate(mask)
+	if err != nil {
+		return nil, err
+	}
+	if move {
+		if in.Position == nil {
+			return nil, apperrors.Validation("position is required to move a rule").WithField(positionPath)
+		}
+		if err := validatePosition(in.Position); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.loadOwned(ctx, in.URLID, in.UserID); err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	var updated domain.RedirectRule
+	_, err = s.repo.UpdateRules(ctx, in.URLID, now, func(rules []domain.RedirectRule) ([]domain.RedirectRule, error) {
+		i := slices.IndexFunc(rules, func(r domain.RedirectRule) bool { return r.ID == in.RuleID })
+		if i < 0 {
+			return nil, domain.ErrRuleNotFound
+		}
+
+		updated = rules[i]
+		if err := setters.Apply(&updated, mask); err != nil {
+			return nil, err
+		}
+		if err := validateConditions(&updated); err != nil {
+			return nil, err
+		}
+		updated.UpdatedAt = now
+
+		if !move {
+			rules[i] = updated
+			return rules, nil
+		}
+		rules = slices.Delete(rules, i, i+1)
+		return slices.Insert(rules, insertIndex(in.Position, len(rules)), updated), nil
+	})
+	if err != nil {
+		return nil, s.ruleError(err, in.URLID, in.RuleID)
+	}
+	return &updated, nil
+}
+
+// DeleteRedirectRule removes a redirect rule from a URL owned by the requesting user
+func (s *URLService) DeleteRedirectRule(ctx context.Context, urlID, userID, ruleID string) error {
+	if ruleID == "" {
+		return apperrors.Validation("rule_id is required").WithField("rule_id")
+	}
+	if _, err := s.loadOwned(ctx, urlID, userID); err != nil {
+		return err
+	}
+
+	_, err := s.repo.UpdateRules(ctx, urlID, s.now().UTC(), func(rules []domain.RedirectRule) ([]domain.RedirectRule, error) {
+		i := slices.IndexFunc(rules, func(r domain.RedirectRule) bool { return r.ID == ruleID })
+		if i < 0 {
+			return nil, domain.ErrRuleNotFound
+		}
+		return slices.Delete(rules, i, i+1), nil
+	})
+	if err != nil {
+		return s.ruleError(err, urlID, ruleID)
+	}
+	return nil
+}
+
+// ruleFields are the rule mask paths, in the order a full replacement applies them
+var ruleFields = []string{
+	"destination_url", "countries", "device_types", "operating_systems", "languages", "time_window", "disabled",
+}
+
+// ruleSetters maps rule mask paths to setters that validate and normalize the input
+func (s *URLService) ruleSetters(in RedirectRuleInput) fieldmask.Setters[domain.RedirectRule] {
+	return fieldmask.Setters[domain.RedirectRule]{
+		"destination_url": func(r *domain.RedirectRule) error {
+			dest := strings.TrimSpace(in.DestinationURL)
+			if err := ValidateOriginalURL(dest, s.cfg.MaxURLLength); err != nil {
+				return err.WithField("rule.destination_url")
+			}
+			if err := s.screen(dest, "rule.destination_url"); err != nil {
+				return err
+			}
+			r.DestinationURL = dest
+			return nil
+		},
+		"countries": func(r *domain.RedirectRule) error {
+			countries, err := normalizeRuleValues(in.Countries, "rule.countries", strings.ToUpper, func(v string) bool {
+				return countryPattern.MatchString(v)
+			})
+			r.Countries = countries
+			return err
+		},
+		"device_types": func(r *domain.RedirectRule) error {
+			devices, err := normalizeRuleValues(in.DeviceTypes, "rule.device_types", strings.ToLower, func(v string) bool {
+				return slices.Contains(ruleDeviceTypes, v)
+			})
+			r.DeviceTypes = devices
+			return err
+		},
+		"operating_systems": func(r *domain.RedirectRule) error {
+			systems, err := normalizeRuleValues(in.OperatingSystems, "rule.operating_systems", strings.ToLower, func(v string) bool {
+				return slices.Contains(ruleOperatingSystems, v)
+			})
+			r.OperatingSystems = systems
+			return err
+		},
+		"languages": func(r *domain.RedirectRule) error {
+			languages, err := normalizeRuleValues(in.Languages, "rule.languages", strings.ToLower, func(v string) bool {
+				return languagePattern.MatchString(v)
+			})
+			r.Languages = languages
+			return err
+		},
+		"time_window": func(r *domain.RedirectRule) error {
+			window, err := parseTimeWindow(in.TimeWindow)
+			if err != nil {
+				return err
+			}
+			r.TimeWindow = window
+			return nil
+		},
+		"disabled": func(r *domain.RedirectRule) error {
+			r.Disabled = in.Disabled
+			return nil
+		},
+	}
+}
+
+// normalizeRuleValues trims, cases and de-duplicates a condition list,
+// rejecting values valid does not accept
+func normalizeRuleValues(values []string, field string, normalize func(string) string,
+	valid func(string) bool) ([]string, error) {
+	if len(values) > maxRuleValues {
+		return nil, apperrors.Validationf("at most %d values are allowed", maxRuleValues).WithField(field)
+	}
+
+	var out []string
+	for _, v := range values {
+		v = normalize(strings.TrimSpace(v))
+		if !valid(v) {
+			return nil, apperrors.Validationf("invalid value %q", v).WithField(field)
+		}
+		if !slices.Contains(out, v) {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// parseTimeWindow validates a client time window; nil means any time
+func parseTimeWindow(in *TimeWindowInput) (*domain.TimeWindow, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	start, err := parseClock(in.Start)
+	if err != nil {
+		return nil, apperrors.Validation("start must be HH:MM").WithField("rule.time_window.start")
+	}
+	end, err := parseClock(in.End)
+	if err != nil {
+		return nil, apperrors.Validation("end must be HH:MM").WithField("rule.time_window.end")
+	}
+	if start == end {
+		return nil, apperrors.Validation("time window must not be empty").WithField("rule.time_window")
+	}
+	if !redirect.ValidTimezone(in.Timezone) {
+		return nil, apperrors.Validationf("unknown time zone %q", in.Timezone).WithField("rule.time_window.timezone")
+	}
+
+	w := &domain.TimeWindow{Start: start, End: end, Timezone: in.Timezone}
+	for _, d := range in.Weekdays {
+		if d < 0 || d > 6 {
+			return nil, apperrors.Validationf("weekday %d is not between 0 and 6", d).
+				WithField("rule.time_window.weekdays")
+		}
+		if day := time.Weekday(d); !slices.Contains(w.Weekdays, day) {
+			w.Weekdays = append(w.Weekdays, day)
+		}
+	}
+	return w, nil
+}
+
+// parseClock parses "HH:MM" into minutes after midnight
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// validateConditions rejects rules without conditions, which would shadow every
+// later rule and the fallback
+func validateConditions(r *domain.RedirectRule) *apperrors.AppError {
+	if len(r.Countries) == 0 && len(r.DeviceTypes) == 0 && len(r.OperatingSystems) == 0 &&
+		len(r.Languages) == 0 && r.TimeWindow == nil {
+		return apperrors.Validation("redirect rule needs at least one condition").WithField("rule")
+	}
+	return nil
+}
+
+func validatePosition(position *int) *apperrors.AppError {
+	if position != nil && *position < 0 {
+		return apperrors.Validation("position must not be negative").WithField(positionPath)
+	}
+	return nil
+}
+
+// insertIndex clamps a requested position to a list of n rules, nil appending
+func insertIndex(position *int, n int) int {
+	if position == nil || *position > n {
+		return n
+	}
+	return *position
+}
+
+// ruleError maps UpdateRules failures to application errors
+func (s *URLService) ruleError(err error, urlID, ruleID string) error {
+	switch {
+	case errors.Is(err, domain.ErrURLNotFound):
+		return apperrors.NotFoundf("short url %q not found", urlID)
+	case errors.Is(err, domain.ErrRuleNotFound):
+		return apperrors.NotFoundf("redirect rule %q not found", ruleID).WithField("rule_id")
+	case apperrors.IsAppError(err):
+		return err
+	}
+	return s.internal(err, "failed to update redirect rules")
+}
+
+func newRuleID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("read random: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestURLService_RedirectRules(t *testing.T) {
+	repo := newMemoryRepo()
+	repo.urls["promo"] = &domain.URL{ID: "promo", OriginalURL: "https://example.com", UserID: "user-1", IsActive: true}
+	svc := newBulkService(t, repo)
+	ctx := context.Background()
+
+	de, err := svc.CreateRedirectRule(ctx, CreateRuleInput{
+		URLID: "promo", UserID: "user-1",
+		Rule: RedirectRuleInput{DestinationURL: "https://example.de", Countries: []string{"de", " AT ", "DE"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DE", "AT"}, de.Countries)
+
+	first := 0
+	night, err := svc.CreateRedirectRule(ctx, CreateRuleInput{
+		URLID: "promo", UserID: "user-1", Position: &first,
+		Rule: RedirectRuleInput{
+			DestinationURL: "https://example.com/night",
+			TimeWindow:     &TimeWindowInput{Start: "22:00", End: "06:00", Timezone: "UTC", Weekdays: []int{5, 6, 5}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &domain.TimeWindow{Start: 22 * 60, End: 6 * 60, Timezone: "UTC", Weekdays: []time.Weekday{time.Friday, time.Saturday}},
+		night.TimeWindow)
+
+	// A masked update changes only the listed fields and can move the rule
+	last := 5
+	moved, err := svc.UpdateRedirectRule(ctx, UpdateRuleInput{
+		URLID: "promo", UserID: "user-1", RuleID: night.ID, Position: &last,
+		Rule: RedirectRuleInput{Disabled: true, DestinationURL: "ignored"},
+		Mask: []string{"disabled", "position"},
+	})
+	require.NoError(t, err)
+	assert.True(t, moved.Disabled)
+	assert.Equal(t, night.DestinationURL, moved.DestinationURL)
+
+	rules, err := svc.ListRedirectRules(ctx, "promo", "user-1")
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, []string{de.ID, night.ID}, []string{rules[0].ID, rules[1].ID})
+
+	require.NoError(t, svc.DeleteRedirectRule(ctx, "promo", "user-1", de.ID))
+	err = svc.DeleteRedirectRule(ctx, "promo", "user-1", de.ID)
+	assert.Equal(t, apperrors.CodeNotFound, apperrors.AsAppError(err).Code)
+
+	_, err = svc.ListRedirectRules(ctx, "promo", "user-2")
+	assert.Equal(t, apperrors.CodeForbidden, apperrors.AsAppError(err).Code)
+}
+
+func TestURLService_CreateRedirectRule_Validation(t *testing.T) {
+	repo := newMemoryRepo()
+	repo.urls["promo"] = &domain.URL{ID: "promo", OriginalURL: "https://example.com", UserID: "user-1", IsActive: true}
+	svc := newBulkService(t, repo)
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		rule  RedirectRuleInput
+		field string
+	}{
+		"no conditions":   {RedirectRuleInput{DestinationURL: "https://example.com/a"}, "rule"},
+		"bad country":     {RedirectRuleInput{DestinationURL: "https://example.com/a", Countries: []string{"DEU"}}, "rule.countries"},
+		"bad device":      {RedirectRuleInput{DestinationURL: "https://example.com/a", DeviceTypes: []string{"watch"}}, "rule.device_types"},
+		"bad language":    {RedirectRuleInput{DestinationURL: "https://example.com/a", Languages: []string{"english"}}, "rule.languages"},
+		"bad time zone":   {RedirectRuleInput{DestinationURL: "https://example.com/a", TimeWindow: &TimeWindowInput{Start: "09:00", End: "17:00", Timezone: "Mars/Olympus"}}, "rule.time_window.timezone"},
+		"bad clock":       {RedirectRuleInput{DestinationURL: "https://example.com/a", TimeWindow: &TimeWindowInput{Start: "9am", End: "17:00"}}, "rule.time_window.start"},
+		"bad destination": {RedirectRuleInput{DestinationURL: "ftp://example.com", OperatingSystems: []string{"ios"}}, "rule.destination_url"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.CreateRedirectRule(ctx, CreateRuleInput{URLID: "promo", UserID: "user-1", Rule: tc.rule})
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.field, appErr.Field)
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := svc.CreateRedirectRule(ctx, CreateRuleInput{URLID: "promo", UserID: "user-1",
+			Rule: RedirectRuleInput{DestinationURL: "https://example.com/m", DeviceTypes: []string{"Mobile"}}})
+		require.NoError(t, err)
+	}
+	_, err := svc.CreateRedirectRule(ctx, CreateRuleInput{URLID: "promo", UserID: "user-1",
+		Rule: RedirectRuleInput{DestinationURL: "https://example.com/m", DeviceTypes: []string{"mobile"}}})
+	assert.Equal(t, apperrors.CodeValidation, apperrors.AsAppError(err).Code)
+}
@@ -0,0 +1,165 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// revisionFields are the UpdateURL fields revisions record, in the order changes are listed
+var revisionFields = []string{
+	"original_url", "title", "description", "password", "is_active", "tags",
+	"max_clicks", "variants", "activates_at", "expires_at",
+}
+
+// RestoreURLRevisionInput holds data for rolling a URL back to a revision
+type RestoreURLRevisionInput struct {
+	ID         string
+	UserID     string
+	User       *domain.UserContext // Caller, premium accounts keep a longer history; may be nil
+	RevisionID int64
+}
+
+// ListURLRevisions returns the edit history of a URL owned by the requesting
+// user, newest first
+func (s *URLService) ListURLRevisions(ctx context.Context, id, userID string) ([]*domain.URLRevision, error) {
+	if _, err := s.loadOwned(ctx, id, userID); err != nil {
+		return nil, err
+	}
+	revisions, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, s.internal(err, "failed to list revisions")
+	}
+	return revisions, nil
+}
+
+// RestoreURLRevision returns the fields of a URL to their values right after
+// the given revision, undoing every later edit, and records the rollback as a
+// new revision. Passwords are not restored, revisions do not keep their hashes.
+func (s *URLService) RestoreURLRevision(ctx context.Context, in RestoreURLRevisionInput) (*domain.URL, error) {
+	if in.RevisionID <= 0 {
+		return nil, apperrors.Validation("revision_id is required").WithField("revision_id")
+	}
+	revisions, err := s.ListURLRevisions(ctx, in.ID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walking from the newest edit back, the oldest later edit of a field
+	// leaves its value as it was right after the target revision
+	values := make(map[string]json.RawMessage)
+	found := false
+	for _, rev := range revisions {
+		if rev.ID == in.RevisionID {
+			found = true
+			break
+		}
+		for _, change := range rev.Changes {
+			values[change.Field] = change.Old
+		}
+	}
+	if !found {
+		return nil, apperrors.NotFoundf("revision %d of short url %q not found", in.RevisionID, in.ID).
+			WithField("revision_id")
+	}
+	delete(values, "password")
+
+	update, err := restoreInput(in, values)
+	if err != nil {
+		return nil, s.internal(err, "failed to decode revision")
+	}
+	if len(update.Mask) == 0 {
+		// Already at the revision
+		return s.loadOwned(ctx, in.ID, in.UserID)
+	}
+	return s.updateURL(ctx, update, in.RevisionID)
+}
+
+// restoreInput builds the update setting each field to its recorded value
+func restoreInput(in RestoreURLRevisionInput, values map[string]json.RawMessage) (UpdateURLInput, error) {
+	update := UpdateURLInput{ID: in.ID, UserID: in.UserID, User: in.User}
+	targets := map[string]interface{}{
+		"original_url": &update.OriginalURL,
+		"title":        &update.Title,
+		"description":  &update.Description,
+		"is_active":    &update.IsActive,
+		"tags":         &update.Tags,
+		"max_clicks":   &update.MaxClicks,
+		"variants":     &update.Variants,
+		"activates_at": &update.ActivatesAt,
+		"expires_at":   &update.ExpiresAt,
+	}
+	for _, field := range revisionFields {
+		raw, ok := values[field]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(raw, targets[field]); err != nil {
+			return update, err
+		}
+		update.Mask = append(update.Mask, field)
+	}
+	return update, nil
+}
+
+// revisionLimit returns how many revisions are kept per URL of the caller
+func (s *URLService) revisionLimit(user *domain.UserContext) int {
+	if user != nil && user.IsPremium {
+		return s.cfg.MaxPremiumRevisions
+	}
+	return s.cfg.MaxRevisions
+}
+
+// revisionValues encodes the fields revisions record, empty lists as null and
+// times in UTC so equal values encode alike
+func revisionValues(u *domain.URL) map[string]json.RawMessage {
+	values := map[string]interface{}{
+		"original_url": u.OriginalURL,
+		"title":        u.Title,
+		"description":  u.Description,
+		"password":     u.IsPasswordProtected(),
+		"is_active":    u.IsActive,
+		"tags":         nilIfEmpty(u.Tags),
+		"max_clicks":   u.MaxClicks,
+		"variants":     nilIfEmpty(u.Variants),
+		"activates_at": utc(u.ActivatesAt),
+		"expires_at":   utc(u.ExpiresAt),
+	}
+
+	encoded := make(map[string]json.RawMessage, len(values))
+	for field, v := range values {
+		// Strings, numbers, times and plain structs always encode
+		encoded[field], _ = json.Marshal(v)
+	}
+	return encoded
+}
+
+// diffRevision lists the fields whose values differ
+func diffRevision(before, after map[string]json.RawMessage) []domain.FieldChange {
+	var changes []domain.FieldChange
+	for _, field := range revisionFields {
+		if !bytes.Equal(before[field], after[field]) {
+			changes = append(changes, domain.FieldChange{Field: field, Old: before[field], New: after[field]})
+		}
+	}
+	return changes
+}
+
+func nilIfEmpty[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.UTC()
+	return &v
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestURLService_Revisions(t *testing.T) {
+	svc, repo := newUpdateFixture(t)
+	repo.urls["abc"].OriginalURL = "https://example.com/v1"
+	svc.cfg.MaxRevisions, svc.cfg.MaxPremiumRevisions = 2, 3
+	svc.guard = NewPasswordGuard(password.NewHasher(password.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 8, KeyLength: 16}), nil, nil, 0)
+	ctx := context.Background()
+	team := &domain.UserContext{UserID: "user-1", IsPremium: true}
+
+	update := func(in UpdateURLInput) {
+		t.Helper()
+		in.ID, in.UserID, in.User = "abc", "user-1", team
+		_, err := svc.UpdateURL(ctx, in)
+		require.NoError(t, err)
+	}
+	v2, v3, title, secret := "https://example.com/v2", "https://example.com/v3", "Launch", "secret"
+	update(UpdateURLInput{OriginalURL: &v2, Title: &title})
+	update(UpdateURLInput{OriginalURL: &v3, Password: &secret})
+	update(UpdateURLInput{Tags: []string{"go"}}) // No change, no revision
+
+	revisions, err := svc.ListURLRevisions(ctx, "abc", "user-1")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	first, second := revisions[1], revisions[0]
+	assert.Equal(t, "user-1", first.UserID)
+	require.Len(t, first.Changes, 2)
+	assert.Equal(t, domain.FieldChange{Field: "original_url", Old: []byte(`"https://example.com/v1"`), New: []byte(`"https://example.com/v2"`)}, first.Changes[0])
+	assert.Equal(t, "title", first.Changes[1].Field)
+	assert.Equal(t, domain.FieldChange{Field: "password", Old: []byte(`false`), New: []byte(`true`)}, second.Changes[1],
+		"password hashes are not recorded")
+
+	u, err := svc.RestoreURLRevision(ctx, RestoreURLRevisionInput{ID: "abc", UserID: "user-1", User: team, RevisionID: first.ID})
+	require.NoError(t, err)
+	assert.Equal(t, v2, u.OriginalURL)
+	assert.Equal(t, "Launch", u.Title)
+	assert.True(t, u.IsPasswordProtected(), "passwords are not restored")
+
+	revisions, err = svc.ListURLRevisions(ctx, "abc", "user-1")
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, first.ID, revisions[0].RestoredFrom)
+
+	// Free accounts keep a shorter history
+	update(UpdateURLInput{Title: &v3})
+	_, err = svc.UpdateURL(ctx, UpdateURLInput{ID: "abc", UserID: "user-1", Title: &title})
+	require.NoError(t, err)
+	revisions, err = svc.ListURLRevisions(ctx, "abc", "user-1")
+	require.NoError(t, err)
+	assert.Len(t, revisions, 2)
+
+	_, err = svc.RestoreURLRevision(ctx, RestoreURLRevisionInput{ID: "abc", UserID: "user-1", RevisionID: first.ID})
+	assert.Equal(t, apperrors.CodeNotFound, apperrors.AsAppError(err).Code)
+	_, err = svc.ListURLRevisions(ctx, "abc", "user-2")
+	assert.Equal(t, apperrors.CodeForbidden, apperrors.AsAppError(err).Code)
+}
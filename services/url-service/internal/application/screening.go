@@ -0,0 +1,165 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// URLInvalidator drops cached copies of a URL
+type URLInvalidator interface {
+	Invalidate(ctx context.Context, id string)
+}
+
+// RescanOptions configures the re-scan job
+type RescanOptions struct {
+	Interval  time.Duration // Time between passes
+	MaxAge    time.Duration // Re-screen URLs last screened longer ago than this
+	BatchSize int           // URLs screened per transaction
+}
+
+// Rescanner re-screens the destinations of active URLs, catching links whose
+// destinations were listed after they were created. Only the local screener is
+// consulted; fetching every destination again would take far too long. Rows
+// are locked while screened, so any number of replicas can run a rescanner.
+type Rescanner struct {
+	repo     domain.ScreeningRepository
+	screener domain.DestinationScreener
+	cache    URLInvalidator // Nil when URLs are not cached
+	opts     RescanOptions
+	logger   *logger.Logger
+	now      func() time.Time
+}
+
+// NewRescanner creates a rescanner; cache may be nil
+func NewRescanner(repo domain.ScreeningRepository, screener domain.DestinationScreener, cache URLInvalidator,
+	opts RescanOptions, log *logger.Logger) *Rescanner {
+	return &Rescanner{
+		repo:     repo,
+		screener: screener,
+		cache:    cache,
+		opts:     opts,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// Run scans every Interval until ctx is cancelled
+func (r *Rescanner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if n, blocked, err := r.Scan(ctx); err != nil {
+			r.logger.Error("failed to rescan urls", zap.Int("scanned", n), zap.Error(err))
+		} else if n > 0 {
+			r.logger.Info("rescanned urls", zap.Int("count", n), zap.Int("blocked", blocked))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan screens due URLs batch by batch, deactivating flagged ones. It returns
+// how many URLs it screened and blocked.
+func (r *Rescanner) Scan(ctx context.Context) (int, int, error) {
+	var total, blocked int
+	for ctx.Err() == nil {
+		now := r.now().UTC()
+		n, flagged, err := r.repo.ScanBatch(ctx, now.Add(-r.opts.MaxAge), now, r.opts.BatchSize, r.check)
+		total += n
+		if err != nil {
+			return total, blocked, err
+		}
+
+		blocked += len(flagged)
+		for _, u := range flagged {
+			r.logger.Warn("blocked url with unsafe destination", zap.String("id", u.ID), zap.String("reason", u.BlockedReason))
+			if r.cache != nil {
+				r.cache.Invalidate(ctx, u.ID)
+			}
+		}
+		if n < r.opts.BatchSize {
+			break
+		}
+	}
+	return total, blocked, nil
+}
+
+// check screens every destination of u
+func (r *Rescanner) check(u *domain.URL) *domain.Threat {
+	for _, dest := range u.Destinations() {
+		if threat := r.screener.Screen(dest); threat != nil {
+			return threat
+		}
+	}
+	return nil
+}
+
+// screen checks a destination, reporting a flagged one on field. Without a
+// screener every destination passes.
+func (s *URLService) screen(raw, field string) error {
+	if s.screener == nil {
+		return nil
+	}
+	if threat := s.screener.Screen(raw); threat != nil {
+		return urlBlocked(threat, field)
+	}
+	return nil
+}
+
+// screenVariants checks the destinations of normalized variants
+func (s *URLService) screenVariants(variants []domain.Variant) error {
+	for i, v := range variants {
+		if err := s.screen(v.DestinationURL, fmt.Sprintf("variants[%d].destination_url", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inspect fetches raw and screens the destination its redirects lead to. With
+// screening on, redirecting past the fetcher's limit flags the destination;
+// chains that long are a common way to hide where a link goes.
+func (s *URLService) inspect(ctx context.Context, raw, field string) (*domain.PageInfo, error) {
+	info, err := s.pages.Inspect(ctx, raw)
+	if err != nil {
+		if s.screener != nil && errors.Is(err, domain.ErrTooManyRedirects) {
+			return nil, urlBlocked(&domain.Threat{
+				Source: domain.ThreatHeuristic,
+				Reason: "destination redirects too many times",
+			}, field)
+		}
+		return nil, err
+	}
+	if info.FinalURL != "" && info.FinalURL != raw {
+		if err := s.screen(info.FinalURL, field); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
+
+// isBlocked reports whether err flags a destination as unsafe
+func isBlocked(err error) bool {
+	appErr := apperrors.AsAppError(err)
+	return appErr != nil && appErr.Code == apperrors.CodeURLBlocked
+}
+
+// urlBlocked reports a destination flagged by screening
+func urlBlocked(threat *domain.Threat, field string) *apperrors.AppError {
+	return apperrors.Newf(apperrors.CodeURLBlocked, "destination is flagged as unsafe: %s", threat.Reason).
+		WithField(field).
+		WithDetail("source", threat.Source)
+}
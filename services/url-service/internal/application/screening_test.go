@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// hostScreener flags destinations on listed hosts
+type hostScreener map[string]bool
+
+func (s hostScreener) Screen(raw string) *domain.Threat {
+	u, err := url.Parse(raw)
+	if err != nil || !s[u.Hostname()] {
+		return nil
+	}
+	return &domain.Threat{Source: domain.ThreatBlocklist, Reason: "domain " + u.Hostname() + " is blocklisted"}
+}
+
+// memoryScreening is an in-memory domain.ScreeningRepository
+type memoryScreening struct {
+	urls []*domain.URL
+}
+
+func (r *memoryScreening) ScanBatch(_ context.Context, cutoff, at time.Time, limit int,
+	check func(u *domain.URL) *domain.Threat) (int, []*domain.URL, error) {
+	var scanned int
+	var blocked []*domain.URL
+	for _, u := range r.urls {
+		if scanned == limit || !u.IsActive || (u.ScannedAt != nil && !u.ScannedAt.Before(cutoff)) {
+			continue
+		}
+		scanned++
+		if threat := check(u); threat != nil {
+			u.IsActive, u.BlockedReason = false, threat.String()
+			blocked = append(blocked, u)
+		}
+		u.ScannedAt = &at
+	}
+	return scanned, blocked, nil
+}
+
+// recordingInvalidator remembers invalidated IDs
+type recordingInvalidator []string
+
+func (r *recordingInvalidator) Invalidate(_ context.Context, id string) {
+	*r = append(*r, id)
+}
+
+func assertBlocked(t *testing.T, err error, field string) {
+	t.Helper()
+	appErr := apperrors.AsAppError(err)
+	require.NotNil(t, appErr)
+	assert.Equal(t, apperrors.CodeURLBlocked, appErr.Code)
+	assert.Equal(t, field, appErr.Field)
+}
+
+func TestURLService_Screening(t *testing.T) {
+	repo := newMemoryRepo()
+	svc := newBulkService(t, repo)
+	svc.screener = hostScreener{"evil.example": true}
+	ctx := context.Background()
+
+	_, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://evil.example/login"})
+	assertBlocked(t, err, "original_url")
+
+	_, err = svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com", Variants: []domain.Variant{
+		{DestinationURL: "https://example.com/a", Weight: 1},
+		{DestinationURL: "https://evil.example/b", Weight: 1},
+	}})
+	assertBlocked(t, err, "variants[1].destination_url")
+
+	res, err := svc.BulkCreateURL(ctx, BulkCreateURLInput{User: premium, Items: []CreateURLInput{
+		{OriginalURL: "https://example.com/ok"},
+		{OriginalURL: "https://evil.example/"},
+	}})
+	require.NoError(t, err)
+	assert.Len(t, res.URLs, 1)
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, apperrors.CodeURLBlocked, res.Errors[0].Code)
+	assert.Equal(t, "urls[1].original_url", res.Errors[0].Field)
+
+	u := res.URLs[0]
+	_, err = svc.CreateRedirectRule(ctx, CreateRuleInput{URLID: u.ID, UserID: premium.UserID, Rule: RedirectRuleInput{
+		DestinationURL: "https://evil.example/mobile", DeviceTypes: []string{domain.DeviceMobile},
+	}})
+	assertBlocked(t, err, "rule.destination_url")
+
+	// A flagged link stays disabled for its owner
+	u.IsActive, u.BlockedReason = false, "blocklist: domain evil.example is blocklisted"
+	_, err = svc.GetURL(ctx, GetURLInput{ID: u.ID})
+	assert.Equal(t, apperrors.CodeURLBlocked, apperrors.AsAppError(err).Code)
+	active := true
+	_, err = svc.UpdateURL(ctx, UpdateURLInput{ID: u.ID, UserID: premium.UserID, IsActive: &active})
+	assertBlocked(t, err, "is_active")
+}
+
+func TestURLService_ValidateURL_ScreensRedirects(t *testing.T) {
+	screener := hostScreener{"evil.example": true}
+	cases := map[string]stubInspector{
+		"too many redirects": {err: domain.ErrTooManyRedirects},
+		"flagged final url":  {info: &domain.PageInfo{FinalURL: "https://evil.example/", Redirects: 1, StatusCode: 200}},
+	}
+	for name, pages := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc := newValidateService(pages)
+			svc.screener = screener
+			_, err := svc.ValidateURL(context.Background(), "https://example.com")
+			assertBlocked(t, err, "url")
+		})
+	}
+}
+
+func TestRescanner_Scan(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	repo := &memoryScreening{urls: []*domain.URL{
+		{ID: "safe", OriginalURL: "https://example.com", IsActive: true},
+		{ID: "listed", OriginalURL: "https://example.com", IsActive: true, ScannedAt: &old,
+			Rules: []domain.RedirectRule{{DestinationURL: "https://evil.example/"}}},
+		{ID: "disabled", OriginalURL: "https://evil.example/", IsActive: false},
+	}}
+	var cache recordingInvalidator
+	r := NewRescanner(repo, hostScreener{"evil.example": true}, &cache,
+		RescanOptions{MaxAge: 24 * time.Hour, BatchSize: 1}, logger.Default("test"))
+
+	scanned, blocked, err := r.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, scanned)
+	assert.Equal(t, 1, blocked)
+	assert.Equal(t, recordingInvalidator{"listed"}, cache)
+	assert.False(t, repo.urls[1].IsActive)
+	assert.Equal(t, "blocklist: domain evil.example is blocklisted", repo.urls[1].BlockedReason)
+
+	// Freshly scanned URLs are not due again
+	scanned, _, err = r.Scan(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, scanned)
+}
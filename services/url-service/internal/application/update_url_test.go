@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func newUpdateFixture(t *testing.T) (*URLService, *memoryRepo) {
+	repo := newMemoryRepo()
+	repo.urls["abc"] = &domain.URL{ID: "abc", UserID: "user-1", Title: "Old", Description: "Desc", Tags: []string{"go"}, IsActive: true}
+	return newBulkService(t, repo), repo
+}
+
+func TestURLService_UpdateURL_Mask(t *testing.T) {
+	svc, repo := newUpdateFixture(t)
+
+	title := "New"
+	u, err := svc.UpdateURL(context.Background(), UpdateURLInput{
+		ID: "abc", UserID: "user-1", Title: &title,
+		Mask: []string{"tags", "description"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Old", u.Title, "unmasked fields are left alone")
+	assert.Empty(t, u.Description)
+	assert.Empty(t, u.Tags, "masked tags without values are cleared")
+	assert.True(t, u.IsActive)
+	assert.Same(t, u, repo.urls["abc"])
+}
+
+func TestURLService_UpdateURL_WithoutMask(t *testing.T) {
+	svc, _ := newUpdateFixture(t)
+
+	title := "New"
+	u, err := svc.UpdateURL(context.Background(), UpdateURLInput{ID: "abc", UserID: "user-1", Title: &title})
+	require.NoError(t, err)
+
+	assert.Equal(t, "New", u.Title)
+	assert.Equal(t, []string{"go"}, u.Tags, "empty tags mean unchanged without a mask")
+}
+
+func TestURLService_UpdateURL_RejectsImmutable(t *testing.T) {
+	svc, repo := newUpdateFixture(t)
+
+	for _, path := range []string{"id", "user_id", "created_at", "click_count"} {
+		_, err := svc.UpdateURL(context.Background(), UpdateURLInput{ID: "abc", UserID: "user-1", Mask: []string{"title", path}})
+
+		appErr := apperrors.AsAppError(err)
+		require.NotNil(t, appErr, path)
+		assert.Equal(t, apperrors.CodeValidation, appErr.Code)
+		assert.Equal(t, path, appErr.Field)
+	}
+	assert.Equal(t, "Old", repo.urls["abc"].Title)
+}
+
+// blockingRepo blocks a URL the first time it is updated, as a rescan
+// committing between UpdateURL loading the URL and storing it would
+type blockingRepo struct {
+	*memoryRepo
+	blocked bool
+}
+
+func (r *blockingRepo) Update(ctx context.Context, u *domain.URL, loadedAt time.Time, rev *domain.URLRevision, keep int) error {
+	if !r.blocked {
+		r.blocked = true
+		r.mu.Lock()
+		stored := r.urls[u.ID]
+		stored.IsActive, stored.BlockedReason = false, "malware"
+		stored.UpdatedAt = loadedAt.Add(time.Second)
+		r.mu.Unlock()
+	}
+	return r.memoryRepo.Update(ctx, u, loadedAt, rev, keep)
+}
+
+func TestURLService_UpdateURL_ConcurrentBlock(t *testing.T) {
+	_, inner := newUpdateFixture(t)
+	inner.urls["abc"].IsActive = false
+	repo := &blockingRepo{memoryRepo: inner}
+	svc := newBulkService(t, repo)
+
+	active := true
+	_, err := svc.UpdateURL(context.Background(), UpdateURLInput{ID: "abc", UserID: "user-1", IsActive: &active})
+	assertCode(t, err, apperrors.CodeURLBlocked, "is_active")
+	assert.False(t, inner.urls["abc"].IsActive)
+
+	// Edits that leave the link inactive are reapplied to the blocked URL
+	title := "New"
+	u, err := svc.UpdateURL(context.Background(), UpdateURLInput{ID: "abc", UserID: "user-1", Title: &title})
+	require.NoError(t, err)
+	assert.Equal(t, "New", u.Title)
+	assert.False(t, u.IsActive)
+	assert.Equal(t, "malware", u.BlockedReason)
+}
+
+// modifiedRepo reports every update as concurrent
+type modifiedRepo struct {
+	*memoryRepo
+	updates int
+}
+
+func (r *modifiedRepo) Update(context.Context, *domain.URL, time.Time, *domain.URLRevision, int) error {
+	r.updates++
+	return domain.ErrURLModified
+}
+
+func TestURLService_UpdateURL_Conflict(t *testing.T) {
+	_, inner := newUpdateFixture(t)
+	repo := &modifiedRepo{memoryRepo: inner}
+
+	title := "New"
+	_, err := newBulkService(t, repo).UpdateURL(context.Background(), UpdateURLInput{ID: "abc", UserID: "user-1", Title: &title})
+	assertCode(t, err, apperrors.CodeConflict, "")
+	assert.Equal(t, maxUpdateAttempts, repo.updates)
+	assert.Equal(t, "Old", inner.urls["abc"].Title)
+}
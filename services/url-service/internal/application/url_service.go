@@ -0,0 +1,962 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/fieldmask"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/shortcode"
+)
+
+// maxUpdateAttempts bounds how often an update is reapplied to a URL written
+// concurrently before the caller is asked to retry
+const maxUpdateAttempts = 3
+
+// CreateURLInput holds data for creating a short URL
+type CreateURLInput struct {
+	OriginalURL string
+	CustomCode  string
+	UserID      string
+	Title       string
+	Description string
+	Password    string
+	ActivatesAt *time.Time // Nil to resolve immediately
+	ExpiresAt   *time.Time
+	Tags        []string
+	MaxClicks   int64 // 0 for unlimited, 1 for a one-time link
+	Variants    []domain.Variant
+	Domain      string // Verified custom domain of the user, empty for the default domain
+}
+
+// GetURLInput holds data for resolving a short URL
+type GetURLInput struct {
+	ID           string
+	Password     string
+	UnlockToken  string // Proof of an earlier successful unlock, replaces Password
+	ClientIP     string // Wrong passwords are throttled per code and client
+	IncludeStats bool
+}
+
+// UnlockURLInput holds data for unlocking a password-protected URL
+type UnlockURLInput struct {
+	ID       string
+	Password string
+	ClientIP string
+}
+
+// UnlockURLResult holds an unlocked URL and a token that skips the password until it expires
+type UnlockURLResult struct {
+	URL       *domain.URL
+	Token     string
+	ExpiresAt time.Time
+}
+
+// UpdateURLInput holds data for updating a short URL. Without a mask nil fields
+// are left unchanged; with one, masked fields are set even when nil or empty.
+type UpdateURLInput struct {
+	ID          string
+	UserID      string
+	User        *domain.UserContext // Caller, premium accounts keep a longer history; may be nil
+	OriginalURL *string
+	Title       *string
+	Description *string
+	Password    *string // Empty string removes the password
+	IsActive    *bool
+	Tags        []string // Without a mask, replaces tags when non-empty
+	ActivatesAt *time.Time
+	ExpiresAt   *time.Time
+	MaxClicks   *int64           // 0 removes the limit
+	Variants    []domain.Variant // Without a mask, replaces variants when non-empty
+	Mask        []string         // Field paths to update
+}
+
+// ListURLsInput holds data for listing a user's URLs
+type ListURLsInput struct {
+	UserID        string
+	Page          int
+	Limit         int
+	ActiveOnly    bool
+	Search        string
+	Tags          []string
+	MatchAllTags  bool
+	ActivatesFrom *time.Time // Activation window, inclusive
+	ActivatesTo   *time.Time
+	PendingOnly   bool   // Only URLs not yet activated
+	SortBy        string // Defaults to relevance when searching, otherwise newest first
+	Desc          bool
+	Cursor        string // NextCursor of the previous page, takes precedence over Page
+}
+
+// ListURLsResult holds a page of URLs
+type ListURLsResult struct {
+	URLs       []*domain.URL
+	Total      int64
+	Page       int
+	Limit      int
+	NextCursor string // Empty on the last page
+}
+
+// CheckAvailabilityInput holds data for checking a custom short code
+type CheckAvailabilityInput struct {
+	Code        string
+	OriginalURL string // Optional, its words seed suggestions
+	Domain      string // Custom domain to check on, empty for the default domain
+}
+
+// AvailabilityResult reports whether a code is free and, if not, free alternatives
+type AvailabilityResult struct {
+	Available   bool
+	Suggestions []string
+}
+
+// URLService implements URL use cases
+type URLService struct {
+	repo     domain.URLRepository
+	codes    *shortcode.Allocator
+	index    *CodeIndex // Nil sends every availability check to the repository
+	pages    domain.PageInspector
+	guard    *PasswordGuard
+	cursors  *pagination.Codec          // Nil disables cursor pagination
+	domains  domain.DomainRepository    // Nil disables links on custom domains
+	screener domain.DestinationScreener // Nil disables destination screening
+	cfg      config.URLConfig
+	logger   *logger.Logger
+	now      func() time.Time
+}
+
+// NewURLService creates a new URL service; index, cursors, domains and screener may be nil
+func NewURLService(repo domain.URLRepository, codes *shortcode.Allocator, index *CodeIndex, pages domain.PageInspector,
+	guard *PasswordGuard, cursors *pagination.Codec, domains domain.DomainRepository, screener domain.DestinationScreener,
+	cfg config.URLConfig, log *logger.Logger) *URLService {
+	return &URLService{
+		repo:     repo,
+		codes:    codes,
+		index:    index,
+		pages:    pages,
+		guard:    guard,
+		cursors:  cursors,
+		domains:  domains,
+		screener: screener,
+		cfg:      cfg,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// ShortURL returns the full short URL for a URL ID. Links on custom domains
+// keep the scheme of the base URL.
+func (s *URLService) ShortURL(id string) string {
+	host, code := domain.SplitID(id)
+	if host == "" {
+		return strings.TrimRight(s.cfg.BaseURL, "/") + "/" + code
+	}
+	scheme, _, ok := strings.Cut(s.cfg.BaseURL, "://")
+	if !ok {
+		scheme = "https"
+	}
+	return scheme + "://" + host + "/" + code
+}
+
+// CreateURL shortens a URL
+func (s *URLService) CreateURL(ctx context.Context, in CreateURLInput) (*domain.URL, error) {
+	host, err := s.linkDomain(ctx, in.Domain, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.newURL(in, host, s.now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.hashPassword(u, in.Password); err != nil {
+		return nil, err
+	}
+	switch {
+	case s.screener != nil && s.cfg.ScreenRedirects:
+		// One fetch serves both redirect screening and the title
+		info, err := s.inspect(ctx, u.OriginalURL, "original_url")
+		if isBlocked(err) {
+			return nil, err
+		}
+		if u.Title == "" && s.cfg.AutoTitle && err == nil && info.Accessible() {
+			u.Title = info.Title
+		}
+	case u.Title == "" && s.cfg.AutoTitle:
+		u.Title = s.detectTitle(ctx, u.OriginalURL)
+	}
+
+	if u.IsCustom {
+		if err := s.repo.Create(ctx, u); err != nil {
+			if errors.Is(err, domain.ErrCodeTaken) {
+				return nil, apperrors.Newf(apperrors.CodeCustomCodeTaken, "short code %q is already taken", in.CustomCode).
+					WithField("custom_code")
+			}
+			return nil, s.internal(err, "failed to create url")
+		}
+		s.indexCode(u.ID)
+		return u, nil
+	}
+
+	var reused *domain.URL
+	_, err = s.codes.Allocate(ctx, u.OriginalURL, func(code string) (bool, error) {
+		u.ID = domain.QualifiedID(host, code)
+		err := s.repo.Create(ctx, u)
+		if !errors.Is(err, domain.ErrCodeTaken) {
+			return false, err
+		}
+
+		// Deterministic codes for the same URL and owner point at an equivalent link
+		if s.codes.Deterministic() && u.PasswordHash == "" {
+			if existing, ok := s.reusable(ctx, u.ID, u); ok {
+				reused = existing
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		if errors.Is(err, shortcode.ErrExhausted) {
+			return nil, apperrors.Internal("failed to allocate a unique short code")
+		}
+		return nil, s.internal(err, "failed to create url")
+	}
+
+	if reused != nil {
+		return reused, nil
+	}
+	s.indexCode(u.ID)
+	return u, nil
+}
+
+// newURL validates in and builds the URL to store on host; its password is
+// hashed separately by hashPassword
+func (s *URLService) newURL(in CreateURLInput, host string, now time.Time) (*domain.URL, error) {
+	if err := ValidateOriginalURL(in.OriginalURL, s.cfg.MaxURLLength); err != nil {
+		return nil, err
+	}
+	if err := s.screen(in.OriginalURL, "original_url"); err != nil {
+		return nil, err
+	}
+	if in.CustomCode != "" {
+		if err := s.checkCustomCode(in.CustomCode); err != nil {
+			return nil, err
+		}
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(now) {
+		return nil, apperrors.Validation("expiration must be in the future").WithField("expires_at")
+	}
+	if err := validateMaxClicks(in.MaxClicks); err != nil {
+		return nil, err
+	}
+	variants, err := normalizeVariants(in.Variants, s.cfg.MaxURLLength)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.screenVariants(variants); err != nil {
+		return nil, err
+	}
+
+	u := &domain.URL{
+		ID:          domain.QualifiedID(host, in.CustomCode),
+		OriginalURL: in.OriginalURL,
+		UserID:      in.UserID,
+		Title:       strings.TrimSpace(in.Title),
+		Description: strings.TrimSpace(in.Description),
+		Tags:        normalizeTags(in.Tags),
+		IsActive:    true,
+		IsCustom:    in.CustomCode != "",
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ActivatesAt: in.ActivatesAt,
+		ExpiresAt:   in.ExpiresAt,
+		MaxClicks:   in.MaxClicks,
+		Variants:    variants,
+	}
+	if err := validateWindow(u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// hashPassword protects u with plain, if set
+func (s *URLService) hashPassword(u *domain.URL, plain string) error {
+	if plain == "" {
+		return nil
+	}
+	hash, err := s.guard.Hash(plain)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = hash
+	return nil
+}
+
+// GetURL resolves a short code, enforcing screening, activation, expiration and password
+func (s *URLService) GetURL(ctx context.Context, in GetURLInput) (*domain.URL, error) {
+	u, err := s.load(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.IsBlocked() {
+		return nil, apperrors.Newf(apperrors.CodeURLBlocked, "short url %q was disabled because its destination is unsafe", in.ID)
+	}
+	if !u.IsActive {
+		return nil, apperrors.NotFoundf("short url %q is disabled", in.ID)
+	}
+	if u.IsExpired(s.now()) {
+		return nil, apperrors.Newf(apperrors.CodeURLExpired, "short url %q has expired", in.ID)
+	}
+	if u.IsExhausted() {
+		return nil, clickLimitReached(in.ID)
+	}
+	if u.IsPending(s.now()) {
+		return nil, apperrors.Newf(apperrors.CodeURLNotYetActive, "short url %q is not active yet", in.ID).
+			WithDetail("activates_at", *u.ActivatesAt)
+	}
+
+	if u.IsPasswordProtected() && !s.guard.VerifyToken(u, in.UnlockToken) {
+		if err := s.checkPassword(ctx, u, in.Password, in.ClientIP); err != nil {
+			return nil, err
+		}
+	}
+
+	if !in.IncludeStats {
+		u.ClickCount = 0
+		u.LastAccessed = nil
+	}
+	return u, nil
+}
+
+// UnlockURL checks the password of a protected URL and issues an unlock token
+func (s *URLService) UnlockURL(ctx context.Context, in UnlockURLInput) (*UnlockURLResult, error) {
+	u, err := s.GetURL(ctx, GetURLInput{ID: in.ID, Password: in.Password, ClientIP: in.ClientIP})
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsPasswordProtected() {
+		return &UnlockURLResult{URL: u}, nil
+	}
+
+	token, expires := s.guard.IssueToken(u)
+	return &UnlockURLResult{URL: u, Token: token, ExpiresAt: expires}, nil
+}
+
+// UpdateURL modifies a URL owned by the requesting user, recording the change
+// as a revision
+func (s *URLService) UpdateURL(ctx context.Context, in UpdateURLInput) (*domain.URL, error) {
+	return s.updateURL(ctx, in, 0)
+}
+
+// updateURL applies in; restoredFrom is the revision it restores, 0 for none
+func (s *URLService) updateURL(ctx context.Context, in UpdateURLInput, restoredFrom int64) (*domain.URL, error) {
+	setters := s.urlSetters(in)
+	mask := in.Mask
+	if len(mask) == 0 {
+		mask = presentFields(in)
+	}
+	// Reject bad masks before touching the repository
+	mask, err := setters.Validate(mask)
+	if err != nil {
+		return nil, err
+	}
+
+	keep := s.revisionLimit(in.User)
+	for attempt := 1; ; attempt++ {
+		u, err := s.loadOwned(ctx, in.ID, in.UserID)
+		if err != nil {
+			return nil, err
+		}
+		loadedAt := u.UpdatedAt
+		before := revisionValues(u)
+		if err := setters.Apply(u, mask); err != nil {
+			return nil, err
+		}
+		if err := validateWindow(u); err != nil {
+			return nil, err
+		}
+
+		u.UpdatedAt = s.now().UTC()
+		var rev *domain.URLRevision
+		if changes := diffRevision(before, revisionValues(u)); len(changes) > 0 && keep > 0 {
+			rev = &domain.URLRevision{
+				URLID:        u.ID,
+				UserID:       in.UserID,
+				Changes:      changes,
+				RestoredFrom: restoredFrom,
+				CreatedAt:    u.UpdatedAt,
+			}
+		}
+		err = s.repo.Update(ctx, u, loadedAt, rev, keep)
+		switch {
+		case err == nil:
+			return u, nil
+		case errors.Is(err, domain.ErrURLNotFound):
+			return nil, apperrors.NotFoundf("short url %q not found", in.ID)
+		case errors.Is(err, domain.ErrURLModified):
+			// Reapply to the stored URL, so a link screening just blocked stays blocked
+			if attempt < maxUpdateAttempts {
+				continue
+			}
+			return nil, apperrors.New(apperrors.CodeConflict, "url is being modified concurrently, retry the update")
+		}
+		return nil, s.internal(err, "failed to update url")
+	}
+}
+
+// urlSetters maps UpdateURL mask paths to the fields they set; a nil value clears the field
+func (s *URLService) urlSetters(in UpdateURLInput) fieldmask.Setters[domain.URL] {
+	return fieldmask.Setters[domain.URL]{
+		"original_url": func(u *domain.URL) error {
+			raw := strings.TrimSpace(deref(in.OriginalURL))
+			if err := ValidateOriginalURL(raw, s.cfg.MaxURLLength); err != nil {
+				return err
+			}
+			if err := s.screen(raw, "original_url"); err != nil {
+				return err
+			}
+			u.OriginalURL = raw
+			return nil
+		},
+		"title": func(u *domain.URL) error {
+			u.Title = strings.TrimSpace(deref(in.Title))
+			return nil
+		},
+		"description": func(u *domain.URL) error {
+			u.Description = strings.TrimSpace(deref(in.Description))
+			return nil
+		},
+		"password": func(u *domain.URL) error {
+			u.PasswordHash = ""
+			if p := deref(in.Password); p != "" {
+				hash, err := s.guard.Hash(p)
+				if err != nil {
+					return err
+				}
+				u.PasswordHash = hash
+			}
+			return nil
+		},
+		"is_active": func(u *domain.URL) error {
+			// Only operators may clear a screening verdict
+			if deref(in.IsActive) && u.IsBlocked() {
+				return apperrors.New(apperrors.CodeURLBlocked, "url was flagged as unsafe and cannot be reactivated").
+					WithField("is_active")
+			}
+			u.IsActive = deref(in.IsActive)
+			return nil
+		},
+		"tags": func(u *domain.URL) error {
+			u.Tags = normalizeTags(in.Tags)
+			return nil
+		},
+		"max_clicks": func(u *domain.URL) error {
+			if err := validateMaxClicks(deref(in.MaxClicks)); err != nil {
+				return err
+			}
+			u.MaxClicks = deref(in.MaxClicks)
+			return nil
+		},
+		"variants": func(u *domain.URL) error {
+			variants, err := normalizeVariants(in.Variants, s.cfg.MaxURLLength)
+			if err != nil {
+				return err
+			}
+			if err := s.screenVariants(variants); err != nil {
+				return err
+			}
+			u.Variants = variants
+			return nil
+		},
+		"activates_at": func(u *domain.URL) error {
+			u.ActivatesAt = in.ActivatesAt
+			return nil
+		},
+		"expires_at": func(u *domain.URL) error {
+			if in.ExpiresAt != nil && !in.ExpiresAt.After(s.now()) {
+				return apperrors.Validation("expiration must be in the future").WithField("expires_at")
+			}
+			u.ExpiresAt = in.ExpiresAt
+			return nil
+		},
+	}
+}
+
+// presentFields is the implicit mask of an UpdateURL request without one
+func presentFields(in UpdateURLInput) []string {
+	var mask []string
+	if in.OriginalURL != nil {
+		mask = append(mask, "original_url")
+	}
+	if in.Title != nil {
+		mask = append(mask, "title")
+	}
+	if in.Description != nil {
+		mask = append(mask, "description")
+	}
+	if in.Password != nil {
+		mask = append(mask, "password")
+	}
+	if in.IsActive != nil {
+		mask = append(mask, "is_active")
+	}
+	if len(in.Tags) > 0 {
+		mask = append(mask, "tags")
+	}
+	if in.ActivatesAt != nil {
+		mask = append(mask, "activates_at")
+	}
+	if in.ExpiresAt != nil {
+		mask = append(mask, "expires_at")
+	}
+	if in.MaxClicks != nil {
+		mask = append(mask, "max_clicks")
+	}
+	if len(in.Variants) > 0 {
+		mask = append(mask, "variants")
+	}
+	return mask
+}
+
+// clickLimitReached makes a used up link look expired to clients
+func clickLimitReached(id string) *apperrors.AppError {
+	return apperrors.Newf(apperrors.CodeURLExpired, "short url %q has reached its click limit", id)
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// DeleteURL removes a URL owned by the requesting user
+func (s *URLService) DeleteURL(ctx context.Context, id, userID string) error {
+	if _, err := s.loadOwned(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrURLNotFound) {
+			return apperrors.NotFoundf("short url %q not found", id)
+		}
+		return s.internal(err, "failed to delete url")
+	}
+	return nil
+}
+
+// ListURLs returns a page of a user's URLs
+func (s *URLService) ListURLs(ctx context.Context, in ListURLsInput) (*ListURLsResult, error) {
+	if in.UserID == "" {
+		return nil, apperrors.Validation("user_id is required").WithField("user_id")
+	}
+
+	if err := ValidateListSort(in.SortBy); err != nil {
+		return nil, err
+	}
+	search := strings.TrimSpace(in.Search)
+	if len(search) > maxSearchLength {
+		return nil, apperrors.Validationf("search exceeds %d characters", maxSearchLength).WithField("search")
+	}
+
+	if in.ActivatesFrom != nil && in.ActivatesTo != nil && in.ActivatesTo.Before(*in.ActivatesFrom) {
+		return nil, apperrors.Validation("activation window ends before it starts").WithField("activates_at")
+	}
+
+	filter := domain.ListFilter{
+		UserID:        in.UserID,
+		ActiveOnly:    in.ActiveOnly,
+		Search:        search,
+		Tags:          normalizeTags(in.Tags),
+		MatchAllTags:  in.MatchAllTags,
+		ActivatesFrom: in.ActivatesFrom,
+		ActivatesTo:   in.ActivatesTo,
+		SortBy:        in.SortBy,
+		Desc:          in.Desc,
+	}
+	if filter.SortBy == "" {
+		filter.SortBy, filter.Desc = domain.SortCreatedAt, true
+		if search != "" {
+			filter.SortBy = domain.SortRelevance
+		}
+	}
+
+	page, limit := s.normalizePage(in.Page, in.Limit)
+	// Scope before narrowing to pending URLs, so the cursor survives the clock moving
+	scope := listScope(filter, in.PendingOnly)
+	if in.PendingOnly {
+		now := s.now().UTC()
+		if filter.ActivatesFrom == nil || filter.ActivatesFrom.Before(now) {
+			filter.ActivatesFrom = &now
+		}
+	}
+
+	var cur *pagination.Cursor
+	if in.Cursor != "" {
+		c, err := s.decodeListCursor(in.Cursor, scope, filter.SortBy)
+		if err != nil {
+			return nil, err
+		}
+		cur, page = c, c.Page
+		filter.After, filter.Offset = cursorKey(cur), cur.Offset
+		filter.SkipCount = true
+	} else {
+		filter.Offset = (page - 1) * limit
+	}
+
+	// One extra row tells whether another page follows
+	filter.Limit = limit + 1
+	urls, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, s.internal(err, "failed to list urls")
+	}
+	if cur != nil {
+		total = cur.Total
+	}
+
+	res := &ListURLsResult{URLs: urls, Total: total, Page: page, Limit: limit}
+	if len(urls) > limit {
+		res.URLs = urls[:limit]
+		if res.NextCursor, err = s.nextListCursor(scope, filter, res); err != nil {
+			return nil, s.internal(err, "failed to encode cursor")
+		}
+	}
+	return res, nil
+}
+
+// ValidateURL checks that raw can be shortened and fetches it. When the destination
+// answers with an error status its details are returned with CodeURLNotAccessible.
+// Destinations screening flags, or whose redirects lead somewhere it flags,
+// fail with CodeURLBlocked.
+func (s *URLService) ValidateURL(ctx context.Context, raw string) (*domain.PageInfo, error) {
+	if err := ValidateOriginalURL(raw, s.cfg.MaxURLLength); err != nil {
+		return nil, err
+	}
+
+	if err := s.screen(raw, "url"); err != nil {
+		return nil, err
+	}
+
+	info, err := s.inspect(ctx, raw, "url")
+	if isBlocked(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, notAccessible(err)
+	}
+	if !info.Accessible() {
+		return info, apperrors.Newf(apperrors.CodeURLNotAccessible, "destination responded with status %d", info.StatusCode).
+			WithField("url")
+	}
+	return info, nil
+}
+
+// CheckAvailability reports whether a custom short code can be used and, if it is
+// taken, suggests ranked alternatives that are free
+func (s *URLService) CheckAvailability(ctx context.Context, in CheckAvailabilityInput) (*AvailabilityResult, error) {
+	if err := s.checkCustomCode(in.Code); err != nil {
+		return nil, err
+	}
+	if in.OriginalURL != "" {
+		if err := ValidateOriginalURL(in.OriginalURL, s.cfg.MaxURLLength); err != nil {
+			return nil, err
+		}
+	}
+	var host string
+	if in.Domain != "" {
+		var appErr *apperrors.AppError
+		if host, appErr = NormalizeHostname(in.Domain); appErr != nil {
+			return nil, appErr.WithField("domain")
+		}
+	}
+
+	taken, err := s.codeTaken(ctx, domain.QualifiedID(host, in.Code))
+	if err != nil {
+		return nil, s.internal(err, "failed to check availability")
+	}
+	if !taken {
+		return &AvailabilityResult{Available: true}, nil
+	}
+
+	suggestions, err := s.suggest(ctx, host, in.Code, in.OriginalURL)
+	if err != nil {
+		return nil, s.internal(err, "failed to check suggestions")
+	}
+	return &AvailabilityResult{Suggestions: suggestions}, nil
+}
+
+// IncrementClick records a click and returns the new click count. A URL whose
+// click limit is used up fails with CodeURLExpired without being counted.
+func (s *URLService) IncrementClick(ctx context.Context, id string) (int64, error) {
+	if id == "" {
+		return 0, apperrors.Validation("url_id is required").WithField("url_id")
+	}
+
+	count, err := s.repo.IncrementClick(ctx, id, s.now().UTC())
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrURLNotFound):
+			return 0, apperrors.NotFoundf("short url %q not found", id)
+		case errors.Is(err, domain.ErrClickLimitReached):
+			return 0, clickLimitReached(id)
+		}
+		return 0, s.internal(err, "failed to increment click")
+	}
+	return count, nil
+}
+
+// Health checks service dependencies
+func (s *URLService) Health(ctx context.Context) error {
+	if err := s.repo.Ping(ctx); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternal, "database unavailable")
+	}
+	return nil
+}
+
+// checkCustomCode validates a requested code and rejects reserved or profane ones
+func (s *URLService) checkCustomCode(code string) *apperrors.AppError {
+	if err := ValidateCustomCode(code); err != nil {
+		return err
+	}
+	if s.codes.Blocklist().IsReserved(code) {
+		return apperrors.Newf(apperrors.CodeCustomCodeTaken, "short code %q is reserved", code).WithField("custom_code")
+	}
+	if s.codes.Blocklist().IsProfane(code) {
+		return apperrors.Newf(apperrors.CodeCustomCodeTaken, "short code %q is not allowed", code).WithField("custom_code")
+	}
+	return nil
+}
+
+// codeTaken reports whether a URL ID is in use, asking the repository only when the index cannot rule it out
+func (s *URLService) codeTaken(ctx context.Context, id string) (bool, error) {
+	if s.index != nil && !s.index.MayExist(id) {
+		return false, nil
+	}
+	return s.repo.Exists(ctx, id)
+}
+
+// suggest returns up to MaxSuggestions codes free on host as alternatives to code, best first
+func (s *URLService) suggest(ctx context.Context, host, code, originalURL string) ([]string, error) {
+	limit := s.cfg.MaxSuggestions
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	// Bound repository lookups, candidates the index rules out are free
+	lookups := 2 * limit
+	suggestions := make([]string, 0, limit)
+	for _, candidate := range shortcode.Suggest(code, originalURL) {
+		if len(suggestions) == limit || lookups == 0 {
+			break
+		}
+		if ValidateCustomCode(candidate.Code) != nil || !s.codes.Blocklist().Allows(candidate.Code) {
+			continue
+		}
+
+		id := domain.QualifiedID(host, candidate.Code)
+		if s.index == nil || s.index.MayExist(id) {
+			lookups--
+			exists, err := s.repo.Exists(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				continue
+			}
+		}
+		suggestions = append(suggestions, candidate.Code)
+	}
+	return suggestions, nil
+}
+
+// detectTitle scrapes the destination's title, best effort within the fetch timeout
+func (s *URLService) detectTitle(ctx context.Context, raw string) string {
+	info, err := s.pages.Inspect(ctx, raw)
+	if err != nil {
+		s.logger.Debug("failed to detect title", zap.String("url", raw), zap.Error(err))
+		return ""
+	}
+	if !info.Accessible() {
+		return ""
+	}
+	return info.Title
+}
+
+// notAccessible maps a fetch failure to CodeURLNotAccessible
+func notAccessible(err error) *apperrors.AppError {
+	message := "destination could not be reached"
+	switch {
+	case errors.Is(err, domain.ErrDestinationBlocked):
+		message = "destination address is not allowed"
+	case errors.Is(err, domain.ErrTooManyRedirects):
+		message = "destination redirects too many times"
+	case errors.Is(err, context.DeadlineExceeded):
+		message = "destination did not respond in time"
+	}
+	return apperrors.Wrap(err, apperrors.CodeURLNotAccessible, message).WithField("url")
+}
+
+// linkDomain checks that userID may create links on the custom domain raw and
+// returns its hostname; empty raw selects the default domain
+func (s *URLService) linkDomain(ctx context.Context, raw, userID string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	if s.domains == nil {
+		return "", apperrors.Validation("custom domains are disabled").WithField("domain")
+	}
+	if userID == "" {
+		return "", apperrors.Unauthorized("custom domains require an account").WithField("domain")
+	}
+	host, appErr := NormalizeHostname(raw)
+	if appErr != nil {
+		return "", appErr.WithField("domain")
+	}
+
+	d, err := s.domains.Get(ctx, host, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDomainNotFound) {
+			return "", apperrors.NotFoundf("domain %q not found", host).WithField("domain")
+		}
+		return "", s.internal(err, "failed to load domain")
+	}
+	if !d.IsVerified() {
+		return "", apperrors.Newf(apperrors.CodeDomainNotVerified, "domain %q is not verified", host).WithField("domain")
+	}
+	return host, nil
+}
+
+// indexCode adds a newly created code to the availability index
+func (s *URLService) indexCode(code string) {
+	if s.index != nil {
+		s.index.Add(code)
+	}
+}
+
+func (s *URLService) load(ctx context.Context, id string) (*domain.URL, error) {
+	if id == "" {
+		return nil, apperrors.Validation("id is required").WithField("id")
+	}
+
+	u, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrURLNotFound) {
+			return nil, apperrors.NotFoundf("short url %q not found", id)
+		}
+		return nil, s.internal(err, "failed to load url")
+	}
+	return u, nil
+}
+
+// checkPassword verifies a link password, throttling repeated wrong guesses
+func (s *URLService) checkPassword(ctx context.Context, u *domain.URL, plain, client string) error {
+	if plain == "" {
+		return apperrors.New(apperrors.CodePasswordRequired, "password required").WithField("password")
+	}
+
+	// The limiter fails open so an outage does not lock every protected link
+	blocked, err := s.guard.Blocked(ctx, u, client)
+	if err != nil {
+		s.logger.Warn("password attempt limiter unavailable", zap.Error(err))
+	}
+	if blocked != nil {
+		return blocked
+	}
+
+	ok, err := s.guard.Verify(ctx, u, plain, client)
+	if err != nil {
+		s.logger.Error("password verification failed", zap.String("url_id", u.ID), zap.Error(err))
+	}
+	if !ok {
+		return apperrors.New(apperrors.CodeInvalidPassword, "invalid password").WithField("password")
+	}
+
+	if s.guard.NeedsRehash(u.PasswordHash) {
+		s.rehashPassword(ctx, u, plain)
+	}
+	return nil
+}
+
+// rehashPassword upgrades a legacy hash after a successful check, best effort
+func (s *URLService) rehashPassword(ctx context.Context, u *domain.URL, plain string) {
+	hash, err := s.guard.Hash(plain)
+	if err != nil {
+		return
+	}
+	// Stamping the URL makes an edit loaded before the upgrade fail instead of
+	// writing the legacy hash back
+	now := s.now().UTC()
+	if err := s.repo.ReplacePasswordHash(ctx, u.ID, u.PasswordHash, hash, now); err != nil {
+		if !errors.Is(err, domain.ErrURLNotFound) {
+			s.logger.Warn("failed to upgrade password hash", zap.String("url_id", u.ID), zap.Error(err))
+		}
+		return
+	}
+	u.PasswordHash, u.UpdatedAt = hash, now
+}
+
+// reusable returns the existing URL stored under code if it is equivalent to u
+func (s *URLService) reusable(ctx context.Context, code string, u *domain.URL) (*domain.URL, bool) {
+	existing, err := s.repo.GetByID(ctx, code)
+	if err != nil {
+		return nil, false
+	}
+	if existing.OriginalURL != u.OriginalURL || existing.UserID != u.UserID {
+		return nil, false
+	}
+	if existing.IsPasswordProtected() || !existing.IsActive || existing.IsExpired(s.now()) {
+		return nil, false
+	}
+	// Limited and scheduled links have their own lifecycle, and split or rule-based
+	// links their own destinations, so they are never shared
+	if existing.MaxClicks > 0 || u.MaxClicks > 0 || existing.ActivatesAt != nil || u.ActivatesAt != nil {
+		return nil, false
+	}
+	if len(existing.Variants) > 0 || len(u.Variants) > 0 || len(existing.Rules) > 0 {
+		return nil, false
+	}
+	return existing, true
+}
+
+func (s *URLService) loadOwned(ctx context.Context, id, userID string) (*domain.URL, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("user_id is required").WithField("user_id")
+	}
+
+	u, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsOwnedBy(userID) {
+		return nil, apperrors.Forbidden("url belongs to another user")
+	}
+	return u, nil
+}
+
+func (s *URLService) normalizePage(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = s.cfg.DefaultLimit
+	}
+	if limit > s.cfg.MaxLimit {
+		limit = s.cfg.MaxLimit
+	}
+	return page, limit
+}
+
+// internal logs an unexpected error and wraps it as an internal AppError
+func (s *URLService) internal(err error, message string) *apperrors.AppError {
+	s.logger.Error(message, zap.Error(err))
+	return apperrors.Wrap(err, apperrors.CodeInternal, message)
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// stubInspector returns a fixed page or error
+type stubInspector struct {
+	info *domain.PageInfo
+	err  error
+}
+
+func (s stubInspector) Inspect(context.Context, string) (*domain.PageInfo, error) {
+	return s.info, s.err
+}
+
+func newValidateService(pages domain.PageInspector) *URLService {
+	return NewURLService(nil, nil, nil, pages, nil, nil, nil, nil, config.URLConfig{MaxURLLength: 2048}, logger.Default("test"))
+}
+
+func TestURLService_ValidateURL(t *testing.T) {
+	ok := &domain.PageInfo{StatusCode: 200, Title: "Example"}
+	missing := &domain.PageInfo{StatusCode: 404}
+
+	cases := []struct {
+		name     string
+		pages    stubInspector
+		raw      string
+		wantCode string
+		wantInfo bool
+	}{
+		{name: "accessible", pages: stubInspector{info: ok}, raw: "https://example.com", wantInfo: true},
+		{name: "invalid", raw: "mailto:someone@example.com", wantCode: apperrors.CodeInvalidURL},
+		{name: "error status", pages: stubInspector{info: missing}, raw: "https://example.com", wantCode: apperrors.CodeURLNotAccessible, wantInfo: true},
+		{name: "blocked", pages: stubInspector{err: fmt.Errorf("%w: 10.0.0.1", domain.ErrDestinationBlocked)}, raw: "https://example.com", wantCode: apperrors.CodeURLNotAccessible},
+		{name: "redirect loop", pages: stubInspector{err: domain.ErrTooManyRedirects}, raw: "https://example.com", wantCode: apperrors.CodeURLNotAccessible},
+		{name: "timeout", pages: stubInspector{err: context.DeadlineExceeded}, raw: "https://example.com", wantCode: apperrors.CodeURLNotAccessible},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, err := newValidateService(tc.pages).ValidateURL(context.Background(), tc.raw)
+
+			if tc.wantCode == "" {
+				require.NoError(t, err)
+			} else {
+				appErr := apperrors.AsAppError(err)
+				require.NotNil(t, appErr)
+				assert.Equal(t, tc.wantCode, appErr.Code)
+				assert.NotEmpty(t, appErr.Field)
+			}
+			assert.Equal(t, tc.wantInfo, info != nil)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+const (
+	minCustomCodeLength = 3
+	maxCustomCodeLength = 32
+	maxTags             = 20
+	maxTagLength        = 50
+	maxSearchLength     = 200
+	maxVariants         = 10
+	maxVariantWeight    = 10000
+)
+
+// listSortFields are the sort_by values ListURLs accepts
+var listSortFields = map[string]struct{}{
+	domain.SortCreatedAt:    {},
+	domain.SortClickCount:   {},
+	domain.SortLastAccessed: {},
+	domain.SortTitle:        {},
+}
+
+var (
+	customCodePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	variantIDPattern  = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+)
+
+// ValidateOriginalURL checks that raw is an absolute http(s) URL
+func ValidateOriginalURL(raw string, maxLength int) *apperrors.AppError {
+	if raw == "" {
+		return apperrors.New(apperrors.CodeInvalidURL, "original_url is required").WithField("original_url")
+	}
+	if maxLength > 0 && len(raw) > maxLength {
+		return apperrors.Newf(apperrors.CodeInvalidURL, "url exceeds %d characters", maxLength).WithField("original_url")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInvalidURL, "url is malformed").WithField("original_url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return apperrors.New(apperrors.CodeInvalidURL, "url scheme must be http or https").WithField("original_url")
+	}
+	if u.Hostname() == "" {
+		return apperrors.New(apperrors.CodeInvalidURL, "url must have a host").WithField("original_url")
+	}
+	return nil
+}
+
+// ValidateCustomCode checks custom short code format
+func ValidateCustomCode(code string) *apperrors.AppError {
+	if len(code) < minCustomCodeLength || len(code) > maxCustomCodeLength {
+		return apperrors.Validationf("custom code must be %d-%d characters", minCustomCodeLength, maxCustomCodeLength).
+			WithField("custom_code")
+	}
+	if !customCodePattern.MatchString(code) {
+		return apperrors.Validation("custom code may only contain letters, digits, '-' and '_'").
+			WithField("custom_code")
+	}
+	return nil
+}
+
+// validateWindow checks that a URL activates before it expires
+func validateWindow(u *domain.URL) *apperrors.AppError {
+	if u.ActivatesAt != nil && u.ExpiresAt != nil && !u.ActivatesAt.Before(*u.ExpiresAt) {
+		return apperrors.Validation("activation must be before expiration").WithField("activates_at")
+	}
+	return nil
+}
+
+// validateMaxClicks checks a click limit, 0 means unlimited
+func validateMaxClicks(n int64) *apperrors.AppError {
+	if n < 0 {
+		return apperrors.Validation("max_clicks must not be negative").WithField("max_clicks")
+	}
+	return nil
+}
+
+// normalizeVariants validates A/B split destinations, lowercasing IDs and
+// naming unnamed ones a, b, c... by position. No variants is valid; otherwise
+// at least two are needed and one must have weight.
+func normalizeVariants(variants []domain.Variant, maxURLLength int) ([]domain.Variant, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	if len(variants) < 2 || len(variants) > maxVariants {
+		return nil, apperrors.Validationf("a split needs 2 to %d variants", maxVariants).WithField("variants")
+	}
+
+	out := make([]domain.Variant, len(variants))
+	seen := make(map[string]struct{}, len(variants))
+	total := 0
+	for i, v := range variants {
+		field := fmt.Sprintf("variants[%d]", i)
+
+		v.ID = strings.ToLower(strings.TrimSpace(v.ID))
+		if v.ID == "" {
+			v.ID = string(rune('a' + i))
+		}
+		if !variantIDPattern.MatchString(v.ID) {
+			return nil, apperrors.Validation("variant id may only contain letters, digits, '-' and '_'").
+				WithField(field + ".id")
+		}
+		if _, ok := seen[v.ID]; ok {
+			return nil, apperrors.Validationf("duplicate variant id %q", v.ID).WithField(field + ".id")
+		}
+		seen[v.ID] = struct{}{}
+
+		v.DestinationURL = strings.TrimSpace(v.DestinationURL)
+		if err := ValidateOriginalURL(v.DestinationURL, maxURLLength); err != nil {
+			return nil, err.WithField(field + ".destination_url")
+		}
+		if v.Weight < 0 || v.Weight > maxVariantWeight {
+			return nil, apperrors.Validationf("weight must be between 0 and %d", maxVariantWeight).
+				WithField(field + ".weight")
+		}
+		total += v.Weight
+		out[i] = v
+	}
+	if total == 0 {
+		return nil, apperrors.Validation("at least one variant needs a positive weight").WithField("variants")
+	}
+	return out, nil
+}
+
+// normalizeTags trims, lowercases and de-duplicates tags
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len(tag) > maxTagLength {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+		if len(out) == maxTags {
+			break
+		}
+	}
+	return out
+}
+
+// ValidateListSort checks sort_by against the allowlist; empty means the default order
+func ValidateListSort(sortBy string) *apperrors.AppError {
+	if sortBy == "" {
+		return nil
+	}
+	if _, ok := listSortFields[sortBy]; !ok {
+		return apperrors.Validationf("cannot sort by %q", sortBy).WithField("pagination.sort_by")
+	}
+	return nil
+}
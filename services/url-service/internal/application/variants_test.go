@@ -0,0 +1,49 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestURLService_CreateURL_Variants(t *testing.T) {
+	svc := newBulkService(t, newMemoryRepo())
+	ctx := context.Background()
+
+	u, err := svc.CreateURL(ctx, CreateURLInput{
+		OriginalURL: "https://example.com",
+		Variants: []domain.Variant{
+			{DestinationURL: "https://example.com/a", Weight: 70},
+			{ID: " Blue ", DestinationURL: "https://example.com/b", Weight: 30},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Variant{
+		{ID: "a", DestinationURL: "https://example.com/a", Weight: 70},
+		{ID: "blue", DestinationURL: "https://example.com/b", Weight: 30},
+	}, u.Variants)
+
+	cases := map[string]struct {
+		variants []domain.Variant
+		field    string
+	}{
+		"single":     {[]domain.Variant{{DestinationURL: "https://example.com/a", Weight: 1}}, "variants"},
+		"duplicate":  {[]domain.Variant{{ID: "x", DestinationURL: "https://example.com/a", Weight: 1}, {ID: "X", DestinationURL: "https://example.com/b", Weight: 1}}, "variants[1].id"},
+		"bad url":    {[]domain.Variant{{DestinationURL: "https://example.com/a", Weight: 1}, {DestinationURL: "mailto:a@example.com", Weight: 1}}, "variants[1].destination_url"},
+		"all paused": {[]domain.Variant{{DestinationURL: "https://example.com/a"}, {DestinationURL: "https://example.com/b"}}, "variants"},
+		"bad weight": {[]domain.Variant{{DestinationURL: "https://example.com/a", Weight: -1}, {DestinationURL: "https://example.com/b", Weight: 1}}, "variants[0].weight"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.CreateURL(ctx, CreateURLInput{OriginalURL: "https://example.com", Variants: tc.variants})
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.field, appErr.Field)
+		})
+	}
+}
@@ -0,0 +1,460 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// ServiceName is used for config lookup and env prefix
+const ServiceName = "url-service"
+
+// Config holds URL service configuration
+type Config struct {
+	config.BaseConfig `mapstructure:",squash"`
+	URL               URLConfig              `mapstructure:"url"`
+	ShortCode         ShortCodeConfig        `mapstructure:"short_code"`
+	Availability      AvailabilityConfig     `mapstructure:"availability"`
+	Fetch             FetchConfig            `mapstructure:"fetch"`
+	Cache             CacheConfig            `mapstructure:"cache"`
+	Password          PasswordConfig         `mapstructure:"password"`
+	Expiration        ExpirationConfig       `mapstructure:"expiration"`
+	ComingSoon        ComingSoonConfig       `mapstructure:"coming_soon"`
+	Geo               GeoConfig              `mapstructure:"geo"`
+	Domains           DomainsConfig          `mapstructure:"domains"`
+	QR                QRConfig               `mapstructure:"qr"`
+	Screening         ScreeningConfig        `mapstructure:"screening"`
+	UserService       UserServiceConfig      `mapstructure:"user_service"`
+	AnalyticsService  AnalyticsServiceConfig `mapstructure:"analytics_service"`
+	Auth              AuthConfig             `mapstructure:"auth"`
+}
+
+// URLConfig holds short link settings
+type URLConfig struct {
+	BaseURL      string `mapstructure:"base_url"`       // Prefix for short_url (e.g., "https://sho.rt")
+	MaxURLLength int    `mapstructure:"max_url_length"` // Maximum original URL length
+	DefaultLimit int    `mapstructure:"default_limit"`  // Default page size for listings
+	MaxLimit     int    `mapstructure:"max_limit"`      // Maximum page size for listings
+	RedirectCode int    `mapstructure:"redirect_code"`  // 301 (permanent) or 302 (temporary)
+
+	MaxSuggestions int  `mapstructure:"max_suggestions"` // Alternatives offered for a taken custom code
+	AutoTitle      bool `mapstructure:"auto_title"`      // Scrape the destination title when none is given
+	MaxBulkSize    int  `mapstructure:"max_bulk_size"`   // URLs accepted by one BulkCreateURL call
+	BulkBatchSize  int  `mapstructure:"bulk_batch_size"` // URLs per transaction in best-effort bulk creation
+
+	CursorSecret string `mapstructure:"cursor_secret"` // Signs pagination cursors, random per process if empty
+
+	MaxRedirectRules int `mapstructure:"max_redirect_rules"` // Conditional redirect rules per URL, 0 disables them
+
+	ScreenRedirects bool `mapstructure:"screen_redirects"` // Fetch new destinations to screen where they redirect, needs screening
+
+	MaxRevisions        int `mapstructure:"max_revisions"`         // Edit history kept per URL of a free account, 0 disables history
+	MaxPremiumRevisions int `mapstructure:"max_premium_revisions"` // Edit history kept per URL of a premium account
+}
+
+// ShortCodeConfig holds short code generation settings
+type ShortCodeConfig struct {
+	Strategy    string   `mapstructure:"strategy"`     // random, counter, hash
+	Length      int      `mapstructure:"length"`       // Code length (minimum length for counter)
+	Alphabet    string   `mapstructure:"alphabet"`     // Characters used in codes
+	MaxAttempts int      `mapstructure:"max_attempts"` // Attempts before giving up on collisions; hash codes get as many random ones after
+	Salt        string   `mapstructure:"salt"`         // Mixed into hash codes
+	Reserved    []string `mapstructure:"reserved"`     // Codes that are never handed out
+	Profanity   []string `mapstructure:"profanity"`    // Words never allowed inside a code
+}
+
+// AvailabilityConfig holds the Bloom filter settings behind custom code availability checks
+type AvailabilityConfig struct {
+	Enabled         bool    `mapstructure:"enabled"`
+	BloomCapacity   int     `mapstructure:"bloom_capacity"`   // Codes the filter is sized for
+	BloomFPRate     float64 `mapstructure:"bloom_fp_rate"`    // False positive rate at capacity
+	RefreshInterval string  `mapstructure:"refresh_interval"` // Time between rebuilds from the database
+}
+
+// FetchConfig holds settings for fetching destination URLs
+type FetchConfig struct {
+	Timeout         string   `mapstructure:"timeout"`          // Whole fetch including redirects
+	MaxRedirects    int      `mapstructure:"max_redirects"`    // Hops followed before giving up
+	MaxBodyBytes    int64    `mapstructure:"max_body_bytes"`   // HTML read for metadata
+	UserAgent       string   `mapstructure:"user_agent"`       // Sent with every request
+	AllowedNetworks []string `mapstructure:"allowed_networks"` // CIDRs exempt from SSRF blocking, for local development only
+}
+
+// CacheConfig holds short code cache settings
+type CacheConfig struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	Store       string `mapstructure:"store"`        // redis (default), or memory for a single instance only
+	TTL         string `mapstructure:"ttl"`          // Lifetime of cached URLs
+	NegativeTTL string `mapstructure:"negative_ttl"` // Lifetime of "not found" markers
+	KeyPrefix   string `mapstructure:"key_prefix"`
+	MaxEntries  int    `mapstructure:"max_entries"` // Memory store capacity
+}
+
+// PasswordConfig holds settings for password-protected links
+type PasswordConfig struct {
+	UnlockSecret  string `mapstructure:"unlock_secret"`  // Signs unlock cookies, random per process if empty
+	UnlockTTL     string `mapstructure:"unlock_ttl"`     // Lifetime of unlock cookies
+	MaxAttempts   int    `mapstructure:"max_attempts"`   // Wrong guesses per code and client before blocking
+	AttemptWindow string `mapstructure:"attempt_window"` // Window in which wrong guesses are counted
+	AttemptStore  string `mapstructure:"attempt_store"`  // redis, memory
+	KeyPrefix     string `mapstructure:"key_prefix"`     // Redis key prefix for attempt counters
+}
+
+// ExpirationConfig holds expiration sweeper settings
+type ExpirationConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Interval   string `mapstructure:"interval"`    // Time between sweeps
+	BatchSize  int    `mapstructure:"batch_size"`  // URLs or events handled per transaction
+	NotifyDays int    `mapstructure:"notify_days"` // Warn owners this many days ahead, 0 disables
+
+	Publisher    string `mapstructure:"publisher"`      // Where outbox events go: redis (a stream) or log
+	Stream       string `mapstructure:"stream"`         // Redis stream receiving events
+	StreamMaxLen int64  `mapstructure:"stream_max_len"` // Approximate cap on stream entries, 0 keeps all
+}
+
+// ComingSoonConfig holds the response for scheduled links before activation
+type ComingSoonConfig struct {
+	Status       int    `mapstructure:"status"`        // Status of the rendered page
+	RedirectURL  string `mapstructure:"redirect_url"`  // Redirect here instead of rendering a page
+	TemplateFile string `mapstructure:"template_file"` // html/template replacing the built-in page
+}
+
+// GeoConfig holds visitor country lookup settings for redirect rules
+type GeoConfig struct {
+	CountryHeader string `mapstructure:"country_header"` // Country header set by a trusted proxy or CDN, e.g. CF-IPCountry
+	DatabaseFile  string `mapstructure:"database_file"`  // CSV of IP ranges and countries, used when the header is absent
+}
+
+// DomainsConfig holds custom domain settings
+type DomainsConfig struct {
+	MaxPerUser    int    `mapstructure:"max_per_user"`   // Domains a user may claim, 0 disables custom domains
+	LookupTimeout string `mapstructure:"lookup_timeout"` // DNS lookup timeout when verifying ownership
+}
+
+// QRConfig holds QR code rendering settings
+type QRConfig struct {
+	DefaultSize  int    `mapstructure:"default_size"`  // Pixels per side when none is requested
+	MaxSize      int    `mapstructure:"max_size"`      // Largest size that may be requested
+	DefaultLevel string `mapstructure:"default_level"` // Error correction level: L, M, Q or H
+	LogoFile     string `mapstructure:"logo_file"`     // PNG or JPEG placed in the centre on request, empty disables logos
+	CacheTTL     string `mapstructure:"cache_ttl"`     // Lifetime of rendered images in the cache store, 0 disables caching
+}
+
+// ScreeningConfig holds malicious destination screening settings
+type ScreeningConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	BlocklistFile  string `mapstructure:"blocklist_file"`  // Domains, IPs, CIDRs and re: patterns, one per line; empty uses heuristics only
+	ReloadInterval string `mapstructure:"reload_interval"` // How often the blocklist file is checked for changes
+	BlockIPHosts   bool   `mapstructure:"block_ip_hosts"`  // Flag destinations addressed by IP instead of name
+	RescanInterval string `mapstructure:"rescan_interval"` // Time between re-scans of stored URLs, 0 disables them
+	RescanAge      string `mapstructure:"rescan_age"`      // Re-screen URLs last screened longer ago than this
+	BatchSize      int    `mapstructure:"batch_size"`      // URLs screened per transaction
+}
+
+// UserServiceConfig holds the user service client settings
+type UserServiceConfig struct {
+	Addr    string `mapstructure:"addr"`    // gRPC address, empty disables owner lookups
+	Timeout string `mapstructure:"timeout"` // Per-call timeout
+}
+
+// AnalyticsServiceConfig holds the analytics service client settings
+type AnalyticsServiceConfig struct {
+	Addr    string `mapstructure:"addr"`    // gRPC address, empty disables click reporting
+	Timeout string `mapstructure:"timeout"` // Per-call timeout
+}
+
+// AuthConfig holds access token verification settings. Issuer and audience
+// come from the shared jwt section.
+type AuthConfig struct {
+	JWKSURL         string `mapstructure:"jwks_url"`         // User service key set, empty trusts the caller's user_id
+	RefreshInterval string `mapstructure:"refresh_interval"` // How long fetched keys are used before refetching
+}
+
+// Validate implements config.Config
+func (c Config) Validate() error {
+	if c.Database.Host == "" {
+		return fmt.Errorf("database.host is required")
+	}
+	if c.Database.Database == "" {
+		return fmt.Errorf("database.database is required")
+	}
+	if c.URL.BaseURL == "" {
+		return fmt.Errorf("url.base_url is required")
+	}
+	if _, err := url.ParseRequestURI(c.URL.BaseURL); err != nil {
+		return fmt.Errorf("url.base_url is invalid: %w", err)
+	}
+	if c.URL.MaxURLLength <= 0 {
+		return fmt.Errorf("url.max_url_length must be positive")
+	}
+	if c.URL.DefaultLimit <= 0 || c.URL.MaxLimit < c.URL.DefaultLimit {
+		return fmt.Errorf("url.default_limit must be positive and not exceed url.max_limit")
+	}
+	if c.URL.RedirectCode != 301 && c.URL.RedirectCode != 302 {
+		return fmt.Errorf("url.redirect_code must be 301 or 302")
+	}
+	if c.URL.MaxSuggestions < 0 {
+		return fmt.Errorf("url.max_suggestions must not be negative")
+	}
+	if c.URL.MaxBulkSize <= 0 || c.URL.BulkBatchSize <= 0 {
+		return fmt.Errorf("url.max_bulk_size and url.bulk_batch_size must be positive")
+	}
+	if c.URL.MaxRedirectRules < 0 {
+		return fmt.Errorf("url.max_redirect_rules must not be negative")
+	}
+	if c.URL.MaxRevisions < 0 || c.URL.MaxPremiumRevisions < c.URL.MaxRevisions {
+		return fmt.Errorf("url.max_revisions must not be negative or exceed url.max_premium_revisions")
+	}
+	if s := c.URL.CursorSecret; s != "" && len(s) < 32 {
+		return fmt.Errorf("url.cursor_secret must be at least 32 bytes")
+	}
+	switch c.ShortCode.Strategy {
+	case "random", "counter", "hash":
+	default:
+		return fmt.Errorf("short_code.strategy must be one of random, counter, hash")
+	}
+	if c.ShortCode.Length < 4 || c.ShortCode.Length > 16 {
+		return fmt.Errorf("short_code.length must be between 4 and 16")
+	}
+	if c.Availability.Enabled {
+		if c.Availability.BloomCapacity <= 0 {
+			return fmt.Errorf("availability.bloom_capacity must be positive")
+		}
+		if c.Availability.BloomFPRate <= 0 || c.Availability.BloomFPRate >= 1 {
+			return fmt.Errorf("availability.bloom_fp_rate must be between 0 and 1")
+		}
+		if d, err := time.ParseDuration(c.Availability.RefreshInterval); err != nil || d <= 0 {
+			return fmt.Errorf("availability.refresh_interval must be a positive duration")
+		}
+	}
+	if d, err := time.ParseDuration(c.Fetch.Timeout); err != nil || d <= 0 {
+		return fmt.Errorf("fetch.timeout must be a positive duration")
+	}
+	if c.Fetch.MaxRedirects <= 0 {
+		return fmt.Errorf("fetch.max_redirects must be positive")
+	}
+	if c.Fetch.MaxBodyBytes <= 0 {
+		return fmt.Errorf("fetch.max_body_bytes must be positive")
+	}
+	if c.Cache.Enabled {
+		if c.Cache.Store != "redis" && c.Cache.Store != "memory" {
+			return fmt.Errorf("cache.store must be redis or memory")
+		}
+		if _, err := time.ParseDuration(c.Cache.TTL); err != nil {
+			return fmt.Errorf("cache.ttl is invalid: %w", err)
+		}
+		if _, err := time.ParseDuration(c.Cache.NegativeTTL); err != nil {
+			return fmt.Errorf("cache.negative_ttl is invalid: %w", err)
+		}
+	}
+	if s := c.Password.UnlockSecret; s != "" && len(s) < 32 {
+		return fmt.Errorf("password.unlock_secret must be at least 32 bytes")
+	}
+	if _, err := time.ParseDuration(c.Password.UnlockTTL); err != nil {
+		return fmt.Errorf("password.unlock_ttl is invalid: %w", err)
+	}
+	if c.Password.MaxAttempts <= 0 {
+		return fmt.Errorf("password.max_attempts must be positive")
+	}
+	if d, err := time.ParseDuration(c.Password.AttemptWindow); err != nil || d < time.Second {
+		return fmt.Errorf("password.attempt_window must be a duration of at least 1s")
+	}
+	if c.Password.AttemptStore != "redis" && c.Password.AttemptStore != "memory" {
+		return fmt.Errorf("password.attempt_store must be redis or memory")
+	}
+	if c.Expiration.Enabled {
+		if d, err := time.ParseDuration(c.Expiration.Interval); err != nil || d <= 0 {
+			return fmt.Errorf("expiration.interval must be a positive duration")
+		}
+		if c.Expiration.BatchSize <= 0 {
+			return fmt.Errorf("expiration.batch_size must be positive")
+		}
+		if c.Expiration.NotifyDays < 0 {
+			return fmt.Errorf("expiration.notify_days must not be negative")
+		}
+		switch c.Expiration.Publisher {
+		case "redis":
+			if c.Expiration.Stream == "" {
+				return fmt.Errorf("expiration.stream is required when expiration.publisher is redis")
+			}
+			if c.Expiration.StreamMaxLen < 0 {
+				return fmt.Errorf("expiration.stream_max_len must not be negative")
+			}
+		case "log":
+		default:
+			return fmt.Errorf("expiration.publisher must be redis or log")
+		}
+	}
+	if c.ComingSoon.Status < 200 || c.ComingSoon.Status > 599 {
+		return fmt.Errorf("coming_soon.status must be an HTTP status code")
+	}
+	if c.ComingSoon.RedirectURL != "" {
+		if _, err := url.ParseRequestURI(c.ComingSoon.RedirectURL); err != nil {
+			return fmt.Errorf("coming_soon.redirect_url is invalid: %w", err)
+		}
+	}
+	if c.Domains.MaxPerUser < 0 {
+		return fmt.Errorf("domains.max_per_user must not be negative")
+	}
+	if c.Domains.MaxPerUser > 0 {
+		if _, err := time.ParseDuration(c.Domains.LookupTimeout); err != nil {
+			return fmt.Errorf("domains.lookup_timeout is invalid: %w", err)
+		}
+	}
+	if c.QR.DefaultSize < 64 || c.QR.DefaultSize > c.QR.MaxSize {
+		return fmt.Errorf("qr.default_size must be between 64 and qr.max_size")
+	}
+	switch c.QR.DefaultLevel {
+	case "L", "M", "Q", "H":
+	default:
+		return fmt.Errorf("qr.default_level must be L, M, Q or H")
+	}
+	if _, err := time.ParseDuration(c.QR.CacheTTL); err != nil {
+		return fmt.Errorf("qr.cache_ttl is invalid: %w", err)
+	}
+	if c.Screening.Enabled {
+		if _, err := time.ParseDuration(c.Screening.ReloadInterval); err != nil {
+			return fmt.Errorf("screening.reload_interval is invalid: %w", err)
+		}
+		d, err := time.ParseDuration(c.Screening.RescanInterval)
+		if err != nil || d < 0 {
+			return fmt.Errorf("screening.rescan_interval must be a non-negative duration")
+		}
+		if d > 0 {
+			if age, err := time.ParseDuration(c.Screening.RescanAge); err != nil || age <= 0 {
+				return fmt.Errorf("screening.rescan_age must be a positive duration")
+			}
+			if c.Screening.BatchSize <= 0 {
+				return fmt.Errorf("screening.batch_size must be positive")
+			}
+		}
+	}
+	if c.UserService.Addr != "" {
+		if _, err := time.ParseDuration(c.UserService.Timeout); err != nil {
+			return fmt.Errorf("user_service.timeout is invalid: %w", err)
+		}
+	}
+	if c.AnalyticsService.Addr != "" {
+		if _, err := time.ParseDuration(c.AnalyticsService.Timeout); err != nil {
+			return fmt.Errorf("analytics_service.timeout is invalid: %w", err)
+		}
+	}
+	if c.Auth.JWKSURL != "" {
+		if u, err := url.Parse(c.Auth.JWKSURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("auth.jwks_url must be an absolute http(s) URL")
+		}
+		if d, err := time.ParseDuration(c.Auth.RefreshInterval); err != nil || d <= 0 {
+			return fmt.Errorf("auth.refresh_interval must be a positive duration")
+		}
+		if c.JWT.Issuer == "" || c.JWT.Audience == "" {
+			return fmt.Errorf("jwt.issuer and jwt.audience are required to verify access tokens")
+		}
+	}
+	return nil
+}
+
+// Load loads URL service configuration from file and environment variables
+func Load(configPath string) (Config, error) {
+	return config.LoadConfig[Config](configPath, ServiceName)
+}
+
+// SetDefaults implements config.Defaulter
+func (Config) SetDefaults() {
+	viper.SetDefault("service_name", ServiceName)
+	viper.SetDefault("server.port", 8080)
+	viper.SetDefault("grpc.port", 8081)
+
+	// Database defaults
+	viper.SetDefault("database.user", "postgres")
+	viper.SetDefault("database.password", "postgres")
+	viper.SetDefault("database.database", "url_service")
+
+	// Short link defaults
+	viper.SetDefault("url.base_url", "http://localhost:8080")
+	viper.SetDefault("url.max_url_length", 2048)
+	viper.SetDefault("url.default_limit", 20)
+	viper.SetDefault("url.max_limit", 100)
+	viper.SetDefault("url.redirect_code", 302)
+	viper.SetDefault("url.max_suggestions", 5)
+	viper.SetDefault("url.auto_title", true)
+	viper.SetDefault("url.max_bulk_size", 5000)
+	viper.SetDefault("url.bulk_batch_size", 500)
+	viper.SetDefault("url.max_redirect_rules", 20)
+	viper.SetDefault("url.max_revisions", 20)
+	viper.SetDefault("url.max_premium_revisions", 200)
+
+	// Short code defaults
+	viper.SetDefault("short_code.strategy", "random")
+	viper.SetDefault("short_code.length", 7)
+	viper.SetDefault("short_code.max_attempts", 5)
+
+	// Availability index defaults
+	viper.SetDefault("availability.enabled", true)
+	viper.SetDefault("availability.bloom_capacity", 10000000)
+	viper.SetDefault("availability.bloom_fp_rate", 0.01)
+	viper.SetDefault("availability.refresh_interval", "5m")
+
+	// Destination fetch defaults
+	viper.SetDefault("fetch.timeout", "5s")
+	viper.SetDefault("fetch.max_redirects", 5)
+	viper.SetDefault("fetch.max_body_bytes", 524288)
+
+	// Cache defaults
+	viper.SetDefault("cache.enabled", true)
+	viper.SetDefault("cache.store", "redis")
+	viper.SetDefault("cache.ttl", "10m")
+	viper.SetDefault("cache.negative_ttl", "30s")
+	viper.SetDefault("cache.key_prefix", "url:")
+	viper.SetDefault("cache.max_entries", 100000)
+
+	// Password-protected link defaults
+	viper.SetDefault("password.unlock_ttl", "30m")
+	viper.SetDefault("password.max_attempts", 5)
+	viper.SetDefault("password.attempt_window", "15m")
+	viper.SetDefault("password.attempt_store", "redis")
+	viper.SetDefault("password.key_prefix", "unlock:")
+
+	// Expiration sweeper defaults
+	viper.SetDefault("expiration.enabled", true)
+	viper.SetDefault("expiration.interval", "1m")
+	viper.SetDefault("expiration.batch_size", 500)
+	viper.SetDefault("expiration.notify_days", 3)
+	viper.SetDefault("expiration.publisher", "redis")
+	viper.SetDefault("expiration.stream", "url-events")
+	viper.SetDefault("expiration.stream_max_len", 100000)
+
+	// Scheduled link defaults
+	viper.SetDefault("coming_soon.status", 200)
+
+	// Custom domain defaults
+	viper.SetDefault("domains.max_per_user", 10)
+	viper.SetDefault("domains.lookup_timeout", "5s")
+
+	// QR code defaults
+	viper.SetDefault("qr.default_size", 256)
+	viper.SetDefault("qr.max_size", 2048)
+	viper.SetDefault("qr.default_level", "M")
+	viper.SetDefault("qr.cache_ttl", "24h")
+
+	// Destination screening defaults
+	viper.SetDefault("screening.enabled", true)
+	viper.SetDefault("screening.reload_interval", "30s")
+	viper.SetDefault("screening.block_ip_hosts", true)
+	viper.SetDefault("screening.rescan_interval", "10m")
+	viper.SetDefault("screening.rescan_age", "24h")
+	viper.SetDefault("screening.batch_size", 500)
+
+	// User service client defaults
+	viper.SetDefault("user_service.addr", "localhost:8083")
+	viper.SetDefault("user_service.timeout", "5s")
+
+	// Analytics service client defaults
+	viper.SetDefault("analytics_service.timeout", "2s")
+
+	// Access token verification defaults
+	viper.SetDefault("auth.refresh_interval", "5m")
+}
@@ -0,0 +1,135 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/jwtauth"
+	commonpb "github.com/url-shortener-microservices/proto/gen/common"
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+)
+
+// staticVerifier accepts the tokens it maps to claims
+type staticVerifier map[string]*jwtauth.Claims
+
+func (v staticVerifier) Verify(_ context.Context, token string) (*jwtauth.Claims, error) {
+	switch token {
+	case "expired":
+		return nil, jwtauth.ErrExpiredToken
+	case "keys-down":
+		return nil, errors.New("jwks unreachable")
+	}
+	if c, ok := v[token]; ok {
+		return c, nil
+	}
+	return nil, jwtauth.ErrInvalidToken
+}
+
+func callAuth(t *testing.T, authorization string, req interface{}) error {
+	t.Helper()
+	verifier := staticVerifier{
+		"alice": {RegisteredClaims: jwt.RegisteredClaims{Subject: "u1"}, Roles: []string{"user"}},
+		"bob":   {RegisteredClaims: jwt.RegisteredClaims{Subject: "u2"}, Roles: []string{"user", "admin"}, IsPremium: true},
+	}
+	ctx := context.Background()
+	if authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+	called := false
+	_, err := AuthInterceptor(verifier)(ctx, req, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	assert.Equal(t, err == nil, called, "handler runs only for accepted requests")
+	return err
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	owned := &urlpb.DeleteURLRequest{Id: "abc", UserId: "u1"}
+	tests := []struct {
+		name          string
+		authorization string
+		req           interface{}
+		wantCode      string // Empty when the request is accepted
+	}{
+		{"anonymous request", "", &urlpb.GetURLRequest{Id: "abc"}, ""},
+		{"owner", "Bearer alice", owned, ""},
+		{"lower case scheme", "bearer alice", owned, ""},
+		{"missing token", "", owned, apperrors.CodeUnauthorized},
+		{"other user", "Bearer bob", owned, apperrors.CodeForbidden},
+		{"expired", "Bearer expired", owned, apperrors.CodeExpiredToken},
+		{"forged", "Bearer forged", owned, apperrors.CodeInvalidToken},
+		{"bad token on anonymous request", "Bearer forged", &urlpb.GetURLRequest{Id: "abc"}, apperrors.CodeInvalidToken},
+		{"basic auth", "Basic YWxpY2U6cHc=", owned, apperrors.CodeInvalidToken},
+		{"keys unavailable", "Bearer keys-down", owned, apperrors.CodeInternal},
+		{
+			"user context without token", "",
+			&urlpb.UpdateURLRequest{UserContext: &commonpb.UserContext{UserId: "u1"}},
+			apperrors.CodeUnauthorized,
+		},
+		{
+			"matching user context", "Bearer bob",
+			&urlpb.UpdateURLRequest{UserId: "u2", UserContext: &commonpb.UserContext{UserId: "u2", Roles: []string{"admin"}, IsPremium: true}},
+			"",
+		},
+		{
+			"user context claiming premium", "Bearer alice",
+			&urlpb.UpdateURLRequest{UserId: "u1", UserContext: &commonpb.UserContext{UserId: "u1", IsPremium: true}},
+			apperrors.CodeForbidden,
+		},
+		{
+			"user context claiming a role", "Bearer alice",
+			&urlpb.UpdateURLRequest{UserId: "u1", UserContext: &commonpb.UserContext{UserId: "u1", Roles: []string{"admin"}}},
+			apperrors.CodeForbidden,
+		},
+		{
+			"user context of another user", "Bearer alice",
+			&urlpb.UpdateURLRequest{UserContext: &commonpb.UserContext{UserId: "u2"}},
+			apperrors.CodeForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := callAuth(t, tt.authorization, tt.req)
+			if tt.wantCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr, "got %v", err)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+		})
+	}
+}
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	verifier := staticVerifier{
+		"unverified": {RegisteredClaims: jwt.RegisteredClaims{Subject: "u1"}},
+		"verified":   {RegisteredClaims: jwt.RegisteredClaims{Subject: "u1"}, EmailVerified: true},
+	}
+	check := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, requireVerifiedEmail(ctx)
+	}
+	req := &urlpb.AddDomainRequest{UserId: "u1", Hostname: "go.example.com"}
+	call := func(token string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		_, err := AuthInterceptor(verifier)(ctx, req, &grpc.UnaryServerInfo{}, check)
+		return err
+	}
+
+	appErr := apperrors.AsAppError(call("unverified"))
+	require.NotNil(t, appErr)
+	assert.Equal(t, apperrors.CodeEmailNotVerified, appErr.Code)
+	assert.NoError(t, call("verified"))
+
+	// Without auth configured the caller is trusted
+	assert.NoError(t, requireVerifiedEmail(context.Background()))
+}
@@ -0,0 +1,70 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ListDomains returns the custom domains a user has claimed
+func (s *Server) ListDomains(ctx context.Context, req *urlpb.ListDomainsRequest) (*urlpb.ListDomainsResponse, error) {
+	domains, err := s.domains.ListDomains(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &urlpb.ListDomainsResponse{
+		Status:  successStatus(),
+		Domains: make([]*urlpb.Domain, 0, len(domains)),
+	}
+	for _, d := range domains {
+		resp.Domains = append(resp.Domains, domainToProto(d))
+	}
+	return resp, nil
+}
+
+// AddDomain claims a hostname, returning the TXT record that proves ownership
+func (s *Server) AddDomain(ctx context.Context, req *urlpb.AddDomainRequest) (*urlpb.AddDomainResponse, error) {
+	if err := requireVerifiedEmail(ctx); err != nil {
+		return nil, err
+	}
+	d, err := s.domains.AddDomain(ctx, req.GetUserId(), req.GetHostname())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.AddDomainResponse{
+		Status: successStatus(),
+		Domain: domainToProto(d),
+	}, nil
+}
+
+// VerifyDomain checks the TXT record of a claimed hostname
+func (s *Server) VerifyDomain(ctx context.Context, req *urlpb.VerifyDomainRequest) (*urlpb.VerifyDomainResponse, error) {
+	d, err := s.domains.VerifyDomain(ctx, req.GetUserId(), req.GetHostname())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.VerifyDomainResponse{
+		Status: successStatus(),
+		Domain: domainToProto(d),
+	}, nil
+}
+
+func domainToProto(d *domain.CustomDomain) *urlpb.Domain {
+	return &urlpb.Domain{
+		Hostname:           d.Hostname,
+		UserId:             d.UserID,
+		IsVerified:         d.IsVerified(),
+		VerificationRecord: d.VerificationRecord(),
+		VerificationValue:  application.VerificationValue(d),
+		CreatedAt:          timestamppb.New(d.CreatedAt),
+		VerifiedAt:         toTimestamp(d.VerifiedAt),
+		CheckedAt:          toTimestamp(d.CheckedAt),
+	}
+}
@@ -0,0 +1,30 @@
+package grpchandler
+
+import (
+	"context"
+
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+)
+
+// GetQRCode renders the QR code of a short URL
+func (s *Server) GetQRCode(ctx context.Context, req *urlpb.GetQRCodeRequest) (*urlpb.GetQRCodeResponse, error) {
+	code, err := s.qr.QRCode(ctx, application.QRCodeInput{
+		ID:         req.GetId(),
+		Format:     req.GetFormat(),
+		Size:       int(req.GetSize()),
+		Level:      req.GetLevel(),
+		Foreground: req.GetForeground(),
+		Background: req.GetBackground(),
+		Logo:       req.GetLogo(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.GetQRCodeResponse{
+		Status:      successStatus(),
+		ContentType: code.ContentType,
+		Data:        code.Data,
+	}, nil
+}
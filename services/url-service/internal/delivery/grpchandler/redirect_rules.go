@@ -0,0 +1,138 @@
+package grpchandler
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ListRedirectRules returns the redirect rules of a URL in evaluation order
+func (s *Server) ListRedirectRules(ctx context.Context, req *urlpb.ListRedirectRulesRequest) (*urlpb.ListRedirectRulesResponse, error) {
+	rules, err := s.service.ListRedirectRules(ctx, req.GetUrlId(), req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &urlpb.ListRedirectRulesResponse{
+		Status: successStatus(),
+		Rules:  make([]*urlpb.RedirectRule, 0, len(rules)),
+	}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, ruleToProto(&rules[i]))
+	}
+	return resp, nil
+}
+
+// CreateRedirectRule adds a redirect rule to a URL
+func (s *Server) CreateRedirectRule(ctx context.Context, req *urlpb.CreateRedirectRuleRequest) (*urlpb.CreateRedirectRuleResponse, error) {
+	rule, err := s.service.CreateRedirectRule(ctx, application.CreateRuleInput{
+		URLID:    req.GetUrlId(),
+		UserID:   req.GetUserId(),
+		Rule:     ruleInput(req.GetRule()),
+		Position: position(req.Position),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.CreateRedirectRuleResponse{
+		Status: successStatus(),
+		Rule:   ruleToProto(rule),
+	}, nil
+}
+
+// UpdateRedirectRule changes or moves a redirect rule
+func (s *Server) UpdateRedirectRule(ctx context.Context, req *urlpb.UpdateRedirectRuleRequest) (*urlpb.UpdateRedirectRuleResponse, error) {
+	rule, err := s.service.UpdateRedirectRule(ctx, application.UpdateRuleInput{
+		URLID:    req.GetUrlId(),
+		UserID:   req.GetUserId(),
+		RuleID:   req.GetRule().GetId(),
+		Rule:     ruleInput(req.GetRule()),
+		Position: position(req.Position),
+		Mask:     req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.UpdateRedirectRuleResponse{
+		Status: successStatus(),
+		Rule:   ruleToProto(rule),
+	}, nil
+}
+
+// DeleteRedirectRule removes a redirect rule
+func (s *Server) DeleteRedirectRule(ctx context.Context, req *urlpb.DeleteRedirectRuleRequest) (*urlpb.DeleteRedirectRuleResponse, error) {
+	if err := s.service.DeleteRedirectRule(ctx, req.GetUrlId(), req.GetUserId(), req.GetRuleId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.DeleteRedirectRuleResponse{Status: successStatus()}, nil
+}
+
+// ruleInput converts a protobuf rule to application input
+func ruleInput(r *urlpb.RedirectRule) application.RedirectRuleInput {
+	in := application.RedirectRuleInput{
+		DestinationURL:   r.GetDestinationUrl(),
+		Countries:        r.GetCountries(),
+		DeviceTypes:      r.GetDeviceTypes(),
+		OperatingSystems: r.GetOperatingSystems(),
+		Languages:        r.GetLanguages(),
+		Disabled:         r.GetDisabled(),
+	}
+	if tw := r.GetTimeWindow(); tw != nil {
+		in.TimeWindow = &application.TimeWindowInput{
+			Start:    tw.GetStart(),
+			End:      tw.GetEnd(),
+			Timezone: tw.GetTimezone(),
+		}
+		for _, d := range tw.GetWeekdays() {
+			in.TimeWindow.Weekdays = append(in.TimeWindow.Weekdays, int(d))
+		}
+	}
+	return in
+}
+
+// ruleToProto converts a domain redirect rule to its protobuf representation
+func ruleToProto(r *domain.RedirectRule) *urlpb.RedirectRule {
+	pb := &urlpb.RedirectRule{
+		Id:               r.ID,
+		DestinationUrl:   r.DestinationURL,
+		Countries:        r.Countries,
+		DeviceTypes:      r.DeviceTypes,
+		OperatingSystems: r.OperatingSystems,
+		Languages:        r.Languages,
+		Disabled:         r.Disabled,
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+		UpdatedAt:        timestamppb.New(r.UpdatedAt),
+	}
+	if tw := r.TimeWindow; tw != nil {
+		pb.TimeWindow = &urlpb.TimeWindow{
+			Start:    clock(tw.Start),
+			End:      clock(tw.End),
+			Timezone: tw.Timezone,
+		}
+		for _, d := range tw.Weekdays {
+			pb.TimeWindow.Weekdays = append(pb.TimeWindow.Weekdays, int32(d))
+		}
+	}
+	return pb
+}
+
+// clock formats minutes after midnight as "HH:MM"
+func clock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func position(p *int32) *int {
+	if p == nil {
+		return nil
+	}
+	n := int(*p)
+	return &n
+}
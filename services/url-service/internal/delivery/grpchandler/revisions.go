@@ -0,0 +1,65 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ListURLRevisions returns the edit history of a URL
+func (s *Server) ListURLRevisions(ctx context.Context, req *urlpb.ListURLRevisionsRequest) (*urlpb.ListURLRevisionsResponse, error) {
+	revisions, err := s.service.ListURLRevisions(ctx, req.GetId(), req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &urlpb.ListURLRevisionsResponse{
+		Status:    successStatus(),
+		Revisions: make([]*urlpb.URLRevision, 0, len(revisions)),
+	}
+	for _, rev := range revisions {
+		resp.Revisions = append(resp.Revisions, revisionToProto(rev))
+	}
+	return resp, nil
+}
+
+// RestoreURLRevision rolls a URL back to a revision
+func (s *Server) RestoreURLRevision(ctx context.Context, req *urlpb.RestoreURLRevisionRequest) (*urlpb.RestoreURLRevisionResponse, error) {
+	u, err := s.service.RestoreURLRevision(ctx, application.RestoreURLRevisionInput{
+		ID:         req.GetId(),
+		UserID:     req.GetUserId(),
+		User:       fromUserContext(req.GetUserContext()),
+		RevisionID: req.GetRevisionId(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.RestoreURLRevisionResponse{
+		Status: successStatus(),
+		Url:    s.toProto(u),
+	}, nil
+}
+
+func revisionToProto(rev *domain.URLRevision) *urlpb.URLRevision {
+	changes := make([]*urlpb.FieldChange, 0, len(rev.Changes))
+	for _, c := range rev.Changes {
+		changes = append(changes, &urlpb.FieldChange{
+			Field:    c.Field,
+			OldValue: string(c.Old),
+			NewValue: string(c.New),
+		})
+	}
+	return &urlpb.URLRevision{
+		Id:           rev.ID,
+		UrlId:        rev.URLID,
+		UserId:       rev.UserID,
+		Changes:      changes,
+		RestoredFrom: rev.RestoredFrom,
+		CreatedAt:    timestamppb.New(rev.CreatedAt),
+	}
+}
@@ -0,0 +1,394 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	commonpb "github.com/url-shortener-microservices/proto/gen/common"
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// Server implements urlpb.URLServiceServer
+type Server struct {
+	urlpb.UnimplementedURLServiceServer
+
+	service *application.URLService
+	domains *application.DomainService
+	qr      *application.QRCodeService
+}
+
+// NewServer creates a new URL gRPC server
+func NewServer(service *application.URLService, domains *application.DomainService, qr *application.QRCodeService) *Server {
+	return &Server{service: service, domains: domains, qr: qr}
+}
+
+// CreateURL shortens a URL
+func (s *Server) CreateURL(ctx context.Context, req *urlpb.CreateURLRequest) (*urlpb.CreateURLResponse, error) {
+	u, err := s.service.CreateURL(ctx, createInput(req))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.CreateURLResponse{
+		Status: successStatus(),
+		Url:    s.toProto(u),
+	}, nil
+}
+
+// GetURL resolves a short code
+func (s *Server) GetURL(ctx context.Context, req *urlpb.GetURLRequest) (*urlpb.GetURLResponse, error) {
+	u, err := s.service.GetURL(ctx, application.GetURLInput{
+		ID:           req.GetId(),
+		Password:     req.GetPassword(),
+		ClientIP:     peerIP(ctx),
+		IncludeStats: req.GetIncludeStats(),
+	})
+	if err != nil {
+		// A missing password is a normal outcome, not an RPC failure
+		if appErr := apperrors.AsAppError(err); appErr != nil && appErr.Code == apperrors.CodePasswordRequired {
+			return &urlpb.GetURLResponse{
+				Status:           errorStatus(appErr),
+				Url:              &urlpb.URL{Id: req.GetId(), ShortUrl: s.service.ShortURL(req.GetId())},
+				PasswordRequired: true,
+			}, nil
+		}
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.GetURLResponse{
+		Status: successStatus(),
+		Url:    s.toProto(u),
+	}, nil
+}
+
+// UpdateURL modifies a URL
+func (s *Server) UpdateURL(ctx context.Context, req *urlpb.UpdateURLRequest) (*urlpb.UpdateURLResponse, error) {
+	u, err := s.service.UpdateURL(ctx, application.UpdateURLInput{
+		ID:          req.GetId(),
+		UserID:      req.GetUserId(),
+		User:        fromUserContext(req.GetUserContext()),
+		OriginalURL: req.OriginalUrl,
+		Title:       req.Title,
+		Description: req.Description,
+		Password:    req.Password,
+		IsActive:    req.IsActive,
+		Tags:        req.GetTags(),
+		ActivatesAt: fromTimestamp(req.GetActivatesAt()),
+		ExpiresAt:   fromTimestamp(req.GetExpiresAt()),
+		MaxClicks:   req.MaxClicks,
+		Variants:    fromVariants(req.GetVariants()),
+		Mask:        req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.UpdateURLResponse{
+		Status: successStatus(),
+		Url:    s.toProto(u),
+	}, nil
+}
+
+// DeleteURL removes a URL
+func (s *Server) DeleteURL(ctx context.Context, req *urlpb.DeleteURLRequest) (*urlpb.DeleteURLResponse, error) {
+	if err := s.service.DeleteURL(ctx, req.GetId(), req.GetUserId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.DeleteURLResponse{Status: successStatus()}, nil
+}
+
+// ListURLs returns a page of a user's URLs
+func (s *Server) ListURLs(ctx context.Context, req *urlpb.ListURLsRequest) (*urlpb.ListURLsResponse, error) {
+	res, err := s.service.ListURLs(ctx, application.ListURLsInput{
+		UserID:        req.GetUserId(),
+		Page:          int(req.GetPagination().GetPage()),
+		Limit:         int(req.GetPagination().GetLimit()),
+		ActiveOnly:    req.GetActiveOnly(),
+		Search:        req.GetSearch(),
+		Tags:          req.GetTags(),
+		MatchAllTags:  req.GetMatchAllTags(),
+		ActivatesFrom: fromTimestamp(req.GetActivatesAt().GetFrom()),
+		ActivatesTo:   fromTimestamp(req.GetActivatesAt().GetTo()),
+		PendingOnly:   req.GetPendingOnly(),
+		SortBy:        req.GetPagination().GetSortBy(),
+		Desc:          req.GetPagination().GetDesc(),
+		Cursor:        req.GetPagination().GetCursor(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	urls := make([]*urlpb.URL, 0, len(res.URLs))
+	for _, u := range res.URLs {
+		urls = append(urls, s.toProto(u))
+	}
+
+	return &urlpb.ListURLsResponse{
+		Status:     successStatus(),
+		Urls:       urls,
+		Pagination: paginationResponse(res.Page, res.Limit, res.Total, res.NextCursor),
+	}, nil
+}
+
+// ValidateURL checks whether a URL can be shortened and scrapes its metadata
+func (s *Server) ValidateURL(ctx context.Context, req *urlpb.ValidateURLRequest) (*urlpb.ValidateURLResponse, error) {
+	info, err := s.service.ValidateURL(ctx, req.GetUrl())
+
+	// Invalid or unreachable destinations are normal outcomes, not RPC failures
+	appErr := apperrors.AsAppError(err)
+	if err != nil && appErr == nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &urlpb.ValidateURLResponse{
+		Status:  successStatus(),
+		IsValid: appErr == nil || appErr.Code == apperrors.CodeURLNotAccessible,
+	}
+	if appErr != nil {
+		resp.Status = errorStatus(appErr)
+	}
+	if info != nil {
+		resp.IsAccessible = info.Accessible()
+		resp.DetectedTitle = info.Title
+		resp.DetectedDescription = info.Description
+		resp.DetectedImage = info.Image
+		resp.ContentType = info.ContentType
+		resp.StatusCode = int32(info.StatusCode)
+		resp.FinalUrl = info.FinalURL
+	}
+	return resp, nil
+}
+
+// CheckAvailability reports whether a custom short code is free, suggesting alternatives if not
+func (s *Server) CheckAvailability(ctx context.Context, req *urlpb.CheckAvailabilityRequest) (*urlpb.CheckAvailabilityResponse, error) {
+	res, err := s.service.CheckAvailability(ctx, application.CheckAvailabilityInput{
+		Code:        req.GetCustomCode(),
+		OriginalURL: req.GetOriginalUrl(),
+		Domain:      req.GetDomain(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.CheckAvailabilityResponse{
+		Status:      successStatus(),
+		IsAvailable: res.Available,
+		Suggestions: res.Suggestions,
+	}, nil
+}
+
+// BulkCreateURL shortens many URLs, reporting failures per item
+func (s *Server) BulkCreateURL(ctx context.Context, req *urlpb.BulkCreateURLRequest) (*urlpb.BulkCreateURLResponse, error) {
+	if err := requireVerifiedEmail(ctx); err != nil {
+		return nil, err
+	}
+	items := make([]application.CreateURLInput, 0, len(req.GetUrls()))
+	for _, item := range req.GetUrls() {
+		items = append(items, createInput(item))
+	}
+
+	res, err := s.service.BulkCreateURL(ctx, application.BulkCreateURLInput{
+		User:         fromUserContext(req.GetUserContext()),
+		UserID:       req.GetUserId(),
+		Items:        items,
+		AllOrNothing: req.GetAllOrNothing(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &urlpb.BulkCreateURLResponse{
+		Status: &commonpb.Response{Success: len(res.Errors) == 0},
+		Urls:   make([]*urlpb.URL, 0, len(res.URLs)),
+		Errors: make([]*commonpb.Error, 0, len(res.Errors)),
+	}
+	for _, u := range res.URLs {
+		resp.Urls = append(resp.Urls, s.toProto(u))
+	}
+	for _, appErr := range res.Errors {
+		resp.Errors = append(resp.Errors, &commonpb.Error{Code: appErr.Code, Message: appErr.Message, Field: appErr.Field})
+	}
+	return resp, nil
+}
+
+// IncrementClick records a click on a short URL
+func (s *Server) IncrementClick(ctx context.Context, req *urlpb.IncrementClickRequest) (*urlpb.IncrementClickResponse, error) {
+	count, err := s.service.IncrementClick(ctx, req.GetUrlId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &urlpb.IncrementClickResponse{
+		Status:   successStatus(),
+		NewCount: count,
+	}, nil
+}
+
+// HealthCheck reports service health
+func (s *Server) HealthCheck(ctx context.Context, req *commonpb.HealthCheckRequest) (*commonpb.HealthCheckResponse, error) {
+	resp := &commonpb.HealthCheckResponse{
+		Status:    commonpb.HealthCheckResponse_SERVING,
+		Timestamp: timestamppb.Now(),
+	}
+	if err := s.service.Health(ctx); err != nil {
+		resp.Status = commonpb.HealthCheckResponse_NOT_SERVING
+		resp.Message = err.Error()
+	}
+	return resp, nil
+}
+
+// toProto converts a domain URL to its protobuf representation
+func (s *Server) toProto(u *domain.URL) *urlpb.URL {
+	return &urlpb.URL{
+		Id:            u.ID,
+		OriginalUrl:   u.OriginalURL,
+		ShortUrl:      s.service.ShortURL(u.ID),
+		UserId:        u.UserID,
+		Title:         u.Title,
+		Description:   u.Description,
+		CreatedAt:     timestamppb.New(u.CreatedAt),
+		ExpiresAt:     toTimestamp(u.ExpiresAt),
+		ActivatesAt:   toTimestamp(u.ActivatesAt),
+		UpdatedAt:     timestamppb.New(u.UpdatedAt),
+		ClickCount:    u.ClickCount,
+		LastAccessed:  toTimestamp(u.LastAccessed),
+		IsActive:      u.IsActive,
+		IsCustom:      u.IsCustom,
+		Tags:          u.Tags,
+		MaxClicks:     u.MaxClicks,
+		Variants:      toVariants(u.Variants),
+		BlockedReason: u.BlockedReason,
+	}
+}
+
+func fromVariants(variants []*urlpb.Variant) []domain.Variant {
+	if len(variants) == 0 {
+		return nil
+	}
+	out := make([]domain.Variant, 0, len(variants))
+	for _, v := range variants {
+		out = append(out, domain.Variant{ID: v.GetId(), DestinationURL: v.GetDestinationUrl(), Weight: int(v.GetWeight())})
+	}
+	return out
+}
+
+func toVariants(variants []domain.Variant) []*urlpb.Variant {
+	if len(variants) == 0 {
+		return nil
+	}
+	out := make([]*urlpb.Variant, 0, len(variants))
+	for _, v := range variants {
+		out = append(out, &urlpb.Variant{Id: v.ID, DestinationUrl: v.DestinationURL, Weight: int32(v.Weight)})
+	}
+	return out
+}
+
+// createInput converts a create request to application input
+func createInput(req *urlpb.CreateURLRequest) application.CreateURLInput {
+	return application.CreateURLInput{
+		OriginalURL: req.GetOriginalUrl(),
+		CustomCode:  req.GetCustomCode(),
+		UserID:      req.GetUserId(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Password:    req.GetPassword(),
+		ActivatesAt: fromTimestamp(req.GetActivatesAt()),
+		ExpiresAt:   fromTimestamp(req.GetExpiresAt()),
+		Tags:        req.GetTags(),
+		MaxClicks:   req.GetMaxClicks(),
+		Variants:    fromVariants(req.GetVariants()),
+		Domain:      req.GetDomain(),
+	}
+}
+
+// fromUserContext converts the gateway supplied caller, nil if absent
+func fromUserContext(uc *commonpb.UserContext) *domain.UserContext {
+	if uc == nil {
+		return nil
+	}
+	return &domain.UserContext{
+		UserID:    uc.GetUserId(),
+		Email:     uc.GetEmail(),
+		Roles:     uc.GetRoles(),
+		IsPremium: uc.GetIsPremium(),
+	}
+}
+
+// toGRPCError converts an error into one carrying a gRPC status
+func toGRPCError(err error) error {
+	if appErr := apperrors.AsAppError(err); appErr != nil {
+		return appErr
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return apperrors.Wrap(err, apperrors.CodeTimeout, "request timed out")
+	}
+	return apperrors.Wrap(err, apperrors.CodeInternal, "internal error")
+}
+
+func successStatus() *commonpb.Response {
+	return &commonpb.Response{Success: true}
+}
+
+func errorStatus(appErr *apperrors.AppError) *commonpb.Response {
+	return &commonpb.Response{
+		Success: false,
+		Errors: []*commonpb.Error{{
+			Code:    appErr.Code,
+			Message: appErr.Message,
+			Field:   appErr.Field,
+		}},
+	}
+}
+
+func paginationResponse(page, limit int, total int64, nextCursor string) *commonpb.PaginationResponse {
+	totalPages := int32(0)
+	if limit > 0 {
+		totalPages = int32((total + int64(limit) - 1) / int64(limit))
+	}
+	return &commonpb.PaginationResponse{
+		Page:       int32(page),
+		Limit:      int32(limit),
+		TotalPages: totalPages,
+		TotalItems: total,
+		HasNext:    nextCursor != "" || int32(page) < totalPages,
+		HasPrev:    page > 1,
+		NextCursor: nextCursor,
+	}
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// peerIP returns the caller's IP address, used to throttle password guesses per client
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,330 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/grpcserver"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/pkg/password"
+	commonpb "github.com/url-shortener-microservices/proto/gen/common"
+	urlpb "github.com/url-shortener-microservices/proto/gen/url"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/config"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/shortcode"
+)
+
+// memoryRepo is a minimal domain.URLRepository
+type memoryRepo struct {
+	domain.URLRepository
+
+	mu   sync.Mutex
+	urls map[string]*domain.URL
+	err  error // Returned by every call when set
+}
+
+func (r *memoryRepo) Create(_ context.Context, u *domain.URL) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	if _, ok := r.urls[u.ID]; ok {
+		return domain.ErrCodeTaken
+	}
+	r.urls[u.ID] = u
+	return nil
+}
+
+func (r *memoryRepo) GetByID(_ context.Context, id string) (*domain.URL, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return nil, r.err
+	}
+	u, ok := r.urls[id]
+	if !ok {
+		return nil, domain.ErrURLNotFound
+	}
+	c := *u
+	return &c, nil
+}
+
+func (r *memoryRepo) Update(_ context.Context, u *domain.URL, loadedAt time.Time, _ *domain.URLRevision, _ int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.urls[u.ID]; ok && !stored.UpdatedAt.Equal(loadedAt) {
+		return domain.ErrURLModified
+	}
+	r.urls[u.ID] = u
+	return nil
+}
+
+func (r *memoryRepo) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.urls[id]; !ok {
+		return domain.ErrURLNotFound
+	}
+	delete(r.urls, id)
+	return nil
+}
+
+// List pages through a user's URLs newest first, ignoring the other filters
+func (r *memoryRepo) List(_ context.Context, f domain.ListFilter) ([]*domain.URL, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*domain.URL
+	for _, u := range r.urls {
+		if u.UserID == f.UserID {
+			out = append(out, u)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	total := int64(len(out))
+	out = out[min(f.Offset, len(out)):]
+	return out[:min(f.Limit, len(out))], total, nil
+}
+
+type testServer struct {
+	client urlpb.URLServiceClient
+	repo   *memoryRepo
+}
+
+// newTestServer serves a URL service over an in-memory connection, through the
+// interceptor used in production
+func newTestServer(t *testing.T) *testServer {
+	repo := &memoryRepo{urls: make(map[string]*domain.URL)}
+	codes, err := shortcode.NewAllocator(shortcode.Options{Strategy: shortcode.StrategyCounter}, shortcode.NewMemorySequence(1))
+	require.NoError(t, err)
+	cursors, err := pagination.NewCodec([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	cfg := config.URLConfig{BaseURL: "https://sho.rt", MaxURLLength: 2048, DefaultLimit: 2, MaxLimit: 10}
+	guard := application.NewPasswordGuard(password.NewHasher(password.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 8, KeyLength: 16}),
+		nil, []byte("unlock secret"), time.Minute)
+	svc := application.NewURLService(repo, codes, nil, nil, guard, cursors, nil, nil, cfg, logger.Default("test"))
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(grpcserver.UnaryInterceptor(logger.Default("test"))))
+	urlpb.RegisterURLServiceServer(srv, NewServer(svc, nil, nil))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return &testServer{client: urlpb.NewURLServiceClient(conn), repo: repo}
+}
+
+func (s *testServer) add(u *domain.URL) {
+	s.repo.mu.Lock()
+	defer s.repo.mu.Unlock()
+	s.repo.urls[u.ID] = u
+}
+
+// assertStatus checks the gRPC code and message of err
+func assertStatus(t *testing.T, err error, want codes.Code, message string) {
+	t.Helper()
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok, "not a gRPC status: %v", err)
+	assert.Equal(t, want, st.Code())
+	assert.Contains(t, st.Message(), message)
+}
+
+func TestServer_ErrorStatus(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	s.add(&domain.URL{ID: "theirs", OriginalURL: "https://example.com", UserID: "user-2", IsActive: true})
+	s.add(&domain.URL{ID: "gone", OriginalURL: "https://example.com", IsActive: true,
+		ExpiresAt: ptr(time.Now().Add(-time.Hour))})
+
+	_, err := s.client.CreateURL(ctx, &urlpb.CreateURLRequest{OriginalUrl: "not a url"})
+	assertStatus(t, err, codes.InvalidArgument, "")
+	_, err = s.client.GetURL(ctx, &urlpb.GetURLRequest{Id: "missing"})
+	assertStatus(t, err, codes.NotFound, `short url "missing" not found`)
+	_, err = s.client.GetURL(ctx, &urlpb.GetURLRequest{Id: "gone"})
+	assertStatus(t, err, codes.NotFound, "has expired")
+	_, err = s.client.DeleteURL(ctx, &urlpb.DeleteURLRequest{Id: "theirs"})
+	assertStatus(t, err, codes.Unauthenticated, "user_id is required")
+	_, err = s.client.DeleteURL(ctx, &urlpb.DeleteURLRequest{Id: "theirs", UserId: "user-1"})
+	assertStatus(t, err, codes.PermissionDenied, "another user")
+	_, err = s.client.CreateURL(ctx, &urlpb.CreateURLRequest{OriginalUrl: "https://example.com", CustomCode: "theirs"})
+	assertStatus(t, err, codes.InvalidArgument, `short code "theirs" is already taken`)
+
+	// Errors from outside the application layer do not leak their details
+	s.repo.err = errors.New("connection refused")
+	_, err = s.client.GetURL(ctx, &urlpb.GetURLRequest{Id: "theirs"})
+	assertStatus(t, err, codes.Internal, "")
+	assert.NotContains(t, status.Convert(err).Message(), "connection refused")
+}
+
+func TestServer_CreateAndGetURL(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	created, err := s.client.CreateURL(ctx, &urlpb.CreateURLRequest{
+		OriginalUrl: "https://example.com/post",
+		CustomCode:  "post",
+		UserId:      "user-1",
+		Title:       "  A post ",
+		Tags:        []string{"Blog", "blog"},
+		ExpiresAt:   timestamppb.New(expires),
+		MaxClicks:   10,
+	})
+	require.NoError(t, err)
+	assert.True(t, created.GetStatus().GetSuccess())
+	u := created.GetUrl()
+	assert.Equal(t, "post", u.GetId())
+	assert.Equal(t, "https://sho.rt/post", u.GetShortUrl())
+	assert.Equal(t, "https://example.com/post", u.GetOriginalUrl())
+	assert.Equal(t, "user-1", u.GetUserId())
+	assert.Equal(t, "A post", u.GetTitle())
+	assert.Equal(t, []string{"blog"}, u.GetTags())
+	assert.Equal(t, expires, u.GetExpiresAt().AsTime())
+	assert.Nil(t, u.GetActivatesAt())
+	assert.EqualValues(t, 10, u.GetMaxClicks())
+	assert.True(t, u.GetIsActive())
+	assert.True(t, u.GetIsCustom())
+
+	s.repo.urls["post"].ClickCount = 3
+	got, err := s.client.GetURL(ctx, &urlpb.GetURLRequest{Id: "post"})
+	require.NoError(t, err)
+	assert.Equal(t, "A post", got.GetUrl().GetTitle())
+	assert.Zero(t, got.GetUrl().GetClickCount())
+	got, err = s.client.GetURL(ctx, &urlpb.GetURLRequest{Id: "post", IncludeStats: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, got.GetUrl().GetClickCount())
+
+	generated, err := s.client.CreateURL(ctx, &urlpb.CreateURLRequest{OriginalUrl: "https://example.com/other"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, generated.GetUrl().GetId())
+	assert.False(t, generated.GetUrl().GetIsCustom())
+}
+
+func TestServer_GetURL_PasswordRequired(t *testing.T) {
+	s := newTestServer(t)
+	s.add(&domain.URL{ID: "secret", OriginalURL: "https://example.com", PasswordHash: "hash", IsActive: true})
+
+	resp, err := s.client.GetURL(context.Background(), &urlpb.GetURLRequest{Id: "secret"})
+	require.NoError(t, err)
+	assert.True(t, resp.GetPasswordRequired())
+	assert.False(t, resp.GetStatus().GetSuccess())
+	require.Len(t, resp.GetStatus().GetErrors(), 1)
+	assert.Equal(t, apperrors.CodePasswordRequired, resp.GetStatus().GetErrors()[0].GetCode())
+	assert.Equal(t, "password", resp.GetStatus().GetErrors()[0].GetField())
+	// The destination is withheld until the password is given
+	assert.Equal(t, "https://sho.rt/secret", resp.GetUrl().GetShortUrl())
+	assert.Empty(t, resp.GetUrl().GetOriginalUrl())
+}
+
+func TestServer_UpdateURL(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	s.add(&domain.URL{ID: "post", OriginalURL: "https://example.com", UserID: "user-1", Title: "Old",
+		Description: "Kept", IsActive: true})
+
+	// The mask selects fields, so unset ones in it are cleared and others are kept
+	resp, err := s.client.UpdateURL(ctx, &urlpb.UpdateURLRequest{
+		Id:          "post",
+		UserId:      "user-1",
+		Title:       ptr("New"),
+		Description: ptr("Ignored"),
+		IsActive:    ptr(false),
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"title", "is_active", "expires_at"}},
+	})
+	require.NoError(t, err)
+	u := resp.GetUrl()
+	assert.Equal(t, "New", u.GetTitle())
+	assert.Equal(t, "Kept", u.GetDescription())
+	assert.False(t, u.GetIsActive())
+	assert.Nil(t, u.GetExpiresAt())
+
+	_, err = s.client.UpdateURL(ctx, &urlpb.UpdateURLRequest{
+		Id:         "post",
+		UserId:     "user-1",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"click_count"}},
+	})
+	assertStatus(t, err, codes.InvalidArgument, "")
+}
+
+func TestServer_DeleteURL(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	s.add(&domain.URL{ID: "post", OriginalURL: "https://example.com", UserID: "user-1", IsActive: true})
+
+	resp, err := s.client.DeleteURL(ctx, &urlpb.DeleteURLRequest{Id: "post", UserId: "user-1"})
+	require.NoError(t, err)
+	assert.True(t, resp.GetStatus().GetSuccess())
+	_, err = s.client.DeleteURL(ctx, &urlpb.DeleteURLRequest{Id: "post", UserId: "user-1"})
+	assertStatus(t, err, codes.NotFound, `short url "post" not found`)
+}
+
+func TestServer_ListURLs(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, id := range []string{"a", "b", "c"} {
+		s.add(&domain.URL{ID: id, OriginalURL: "https://example.com/" + id, UserID: "user-1", IsActive: true,
+			CreatedAt: base.Add(time.Duration(i) * time.Minute)})
+	}
+
+	resp, err := s.client.ListURLs(ctx, &urlpb.ListURLsRequest{UserId: "user-1"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetUrls(), 2)
+	assert.Equal(t, "c", resp.GetUrls()[0].GetId())
+	assert.Equal(t, "https://sho.rt/c", resp.GetUrls()[0].GetShortUrl())
+	assert.Equal(t, base.Add(2*time.Minute), resp.GetUrls()[0].GetCreatedAt().AsTime())
+	p := resp.GetPagination()
+	assert.EqualValues(t, 1, p.GetPage())
+	assert.EqualValues(t, 2, p.GetLimit())
+	assert.EqualValues(t, 3, p.GetTotalItems())
+	assert.EqualValues(t, 2, p.GetTotalPages())
+	assert.True(t, p.GetHasNext())
+	assert.False(t, p.GetHasPrev())
+	assert.NotEmpty(t, p.GetNextCursor())
+
+	resp, err = s.client.ListURLs(ctx, &urlpb.ListURLsRequest{
+		UserId:     "user-1",
+		Pagination: &commonpb.PaginationRequest{Page: 2, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetUrls(), 1)
+	assert.Equal(t, "a", resp.GetUrls()[0].GetId())
+	assert.False(t, resp.GetPagination().GetHasNext())
+	assert.True(t, resp.GetPagination().GetHasPrev())
+	assert.Empty(t, resp.GetPagination().GetNextCursor())
+
+	_, err = s.client.ListURLs(ctx, &urlpb.ListURLsRequest{UserId: "user-1",
+		Pagination: &commonpb.PaginationRequest{Cursor: "forged"}})
+	assertStatus(t, err, codes.InvalidArgument, "")
+	_, err = s.client.ListURLs(ctx, &urlpb.ListURLsRequest{})
+	assertStatus(t, err, codes.InvalidArgument, "")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,97 @@
+package httphandler
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ClientIPResolver extracts the originating client IP, trusting forwarding
+// headers only when the request arrives through a trusted proxy
+type ClientIPResolver struct {
+	trusted []*net.IPNet
+}
+
+// NewClientIPResolver creates a resolver from proxy IPs or CIDR ranges
+func NewClientIPResolver(proxies []string) (*ClientIPResolver, error) {
+	r := &ClientIPResolver{}
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			r.trusted = append(r.trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		r.trusted = append(r.trusted, network)
+	}
+	return r, nil
+}
+
+// ClientIP returns the client IP for the request
+func (r *ClientIPResolver) ClientIP(req *http.Request) string {
+	remote := remoteIP(req.RemoteAddr)
+	if !r.isTrusted(remote) {
+		return remote
+	}
+
+	// Walk X-Forwarded-For right to left, the rightmost untrusted hop is the client
+	if xff := req.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			if !r.isTrusted(hop) || i == 0 {
+				return hop
+			}
+		}
+	}
+
+	if realIP := strings.TrimSpace(req.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+	return remote
+}
+
+// FromTrustedProxy reports whether the request arrived through a trusted proxy,
+// so headers the proxy sets can be believed
+func (r *ClientIPResolver) FromTrustedProxy(req *http.Request) bool {
+	return r.isTrusted(remoteIP(req.RemoteAddr))
+}
+
+func (r *ClientIPResolver) isTrusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range r.trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
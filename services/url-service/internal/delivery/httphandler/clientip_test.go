@@ -0,0 +1,53 @@
+package httphandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIPResolver_ClientIP(t *testing.T) {
+	ips, err := NewClientIPResolver([]string{"10.0.0.0/8", " 192.0.2.10 ", "", "2001:db8::1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		remote string
+		xff    []string
+		realIP string
+		want   string
+	}{
+		{"direct", "203.0.113.9:4000", nil, "", "203.0.113.9"},
+		{"untrusted peer cannot spoof", "203.0.113.9:4000", []string{"198.51.100.7"}, "198.51.100.8", "203.0.113.9"},
+		{"rightmost untrusted hop", "10.0.0.1:4000", []string{"198.51.100.7, 203.0.113.9, 10.0.0.2"}, "", "203.0.113.9"},
+		{"repeated headers", "192.0.2.10:4000", []string{"198.51.100.7", "203.0.113.9"}, "", "203.0.113.9"},
+		{"all hops trusted", "10.0.0.1:4000", []string{"10.0.0.3, 10.0.0.2"}, "", "10.0.0.3"},
+		{"garbage hop stops the walk", "10.0.0.1:4000", []string{"203.0.113.9, junk"}, "198.51.100.8", "198.51.100.8"},
+		{"real ip", "10.0.0.1:4000", nil, "198.51.100.8", "198.51.100.8"},
+		{"trusted peer without headers", "10.0.0.1:4000", nil, "", "10.0.0.1"},
+		{"ipv6 peer", "[2001:db8::1]:4000", []string{"203.0.113.9"}, "", "203.0.113.9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remote
+			for _, v := range tt.xff {
+				req.Header.Add("X-Forwarded-For", v)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			assert.Equal(t, tt.want, ips.ClientIP(req))
+		})
+	}
+}
+
+func TestNewClientIPResolver_Invalid(t *testing.T) {
+	for _, proxy := range []string{"10.0.0", "10.0.0.0/33"} {
+		_, err := NewClientIPResolver([]string{proxy})
+		assert.Error(t, err, proxy)
+	}
+}
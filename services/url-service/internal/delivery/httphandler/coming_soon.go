@@ -0,0 +1,63 @@
+package httphandler
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+)
+
+var comingSoonTemplate = template.Must(template.ParseFS(templateFS, "templates/coming_soon.html"))
+
+// ComingSoonOptions configures the response for scheduled links that are not active yet
+type ComingSoonOptions struct {
+	Status      int                // Status of the rendered page, defaults to 200
+	RedirectURL string             // Send visitors here instead of rendering a page
+	Template    *template.Template // Replaces the built-in page, executed with comingSoonPage
+}
+
+// comingSoonPage is the data rendered by the coming soon template
+type comingSoonPage struct {
+	Code        string
+	ActivatesAt time.Time // UTC
+}
+
+// renderComingSoon answers a request for a link that activates later
+func (h *RedirectHandler) renderComingSoon(w http.ResponseWriter, r *http.Request, appErr *apperrors.AppError) {
+	opts := h.comingSoon
+	activatesAt, _ := appErr.Details["activates_at"].(time.Time)
+
+	header := w.Header()
+	header.Set("Cache-Control", "no-store")
+	if opts.RedirectURL != "" {
+		http.Redirect(w, r, opts.RedirectURL, http.StatusFound)
+		return
+	}
+
+	tmpl := opts.Template
+	if tmpl == nil {
+		tmpl = comingSoonTemplate
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, comingSoonPage{Code: r.PathValue("code"), ActivatesAt: activatesAt.UTC()}); err != nil {
+		h.log.Error("failed to render coming soon page", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	status := opts.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if status == http.StatusServiceUnavailable && !activatesAt.IsZero() {
+		header.Set("Retry-After", activatesAt.UTC().Format(http.TimeFormat))
+	}
+	header.Set("Content-Type", "text/html; charset=utf-8")
+	header.Set("X-Frame-Options", "DENY")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,66 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+)
+
+// qrMaxAge is how long clients may cache QR code images
+const qrMaxAge = 24 * time.Hour
+
+// QRCodeRenderer renders the QR codes of short URLs
+type QRCodeRenderer interface {
+	QRCode(ctx context.Context, in application.QRCodeInput) (*application.QRCode, error)
+}
+
+// qrCode handles GET /{code}/qr?format=svg&size=512&level=H&fg=000000&bg=ffffff&logo=1
+func (h *RedirectHandler) qrCode(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	in := application.QRCodeInput{
+		ID:         h.urlID(r, r.PathValue("code")),
+		Format:     query.Get("format"),
+		Level:      query.Get("level"),
+		Foreground: query.Get("fg"),
+		Background: query.Get("bg"),
+	}
+	if v := query.Get("size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			http.Error(w, "size must be a number", http.StatusBadRequest)
+			return
+		}
+		in.Size = size
+	}
+	if v := query.Get("logo"); v != "" {
+		logo, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "logo must be true or false", http.StatusBadRequest)
+			return
+		}
+		in.Logo = logo
+	}
+
+	code, err := h.qr.QRCode(r.Context(), in)
+	if err != nil {
+		// Bad options are the caller's fault, not a missing link
+		if appErr := apperrors.AsAppError(err); appErr != nil && appErr.Code == apperrors.CodeValidation {
+			http.Error(w, appErr.Message, http.StatusBadRequest)
+			return
+		}
+		h.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", code.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(code.Data)))
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(qrMaxAge.Seconds())))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	// SVG is served from the short link origin, it must not run scripts there
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; img-src data:")
+	w.Write(code.Data)
+}
@@ -0,0 +1,256 @@
+package httphandler
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/redirect"
+)
+
+const clickTimeout = 5 * time.Second
+
+// URLResolver resolves short codes and records clicks
+type URLResolver interface {
+	GetURL(ctx context.Context, in application.GetURLInput) (*domain.URL, error)
+	UnlockURL(ctx context.Context, in application.UnlockURLInput) (*application.UnlockURLResult, error)
+	IncrementClick(ctx context.Context, id string) (int64, error)
+	Health(ctx context.Context) error
+}
+
+// RedirectOptions configures the redirect handler
+type RedirectOptions struct {
+	RedirectCode  int  // 301 or 302
+	SecureCookies bool // Mark unlock cookies Secure, set when served over HTTPS
+	ComingSoon    ComingSoonOptions
+
+	// Host of the base URL. Requests for other hosts resolve codes on the
+	// custom domain of that name; empty serves only the default domain.
+	DefaultHost string
+
+	// Visitor country for redirect rules: CountryHeader when a trusted proxy set
+	// it, otherwise Geo by client IP; either may be empty
+	CountryHeader string
+	Geo           domain.GeoLocator
+
+	Analytics domain.ClickRecorder // Nil skips reporting clicks
+	QRCodes   QRCodeRenderer       // Nil disables GET /{code}/qr
+}
+
+// RedirectHandler serves GET /{code} redirects and the unlock form of protected links
+type RedirectHandler struct {
+	urls          URLResolver
+	ips           *ClientIPResolver
+	log           *zap.Logger
+	redirectCode  int
+	secureCookies bool
+	comingSoon    ComingSoonOptions
+	defaultHost   string
+	countryHeader string
+	geo           domain.GeoLocator
+	matchers      *redirect.Cache
+	analytics     domain.ClickRecorder
+	qr            QRCodeRenderer
+
+	clicks sync.WaitGroup
+}
+
+// NewRedirectHandler creates a redirect handler
+func NewRedirectHandler(urls URLResolver, ips *ClientIPResolver, log *zap.Logger, opts RedirectOptions) *RedirectHandler {
+	redirectCode := opts.RedirectCode
+	if redirectCode != http.StatusMovedPermanently {
+		redirectCode = http.StatusFound
+	}
+	return &RedirectHandler{
+		urls:          urls,
+		ips:           ips,
+		log:           log,
+		redirectCode:  redirectCode,
+		secureCookies: opts.SecureCookies,
+		comingSoon:    opts.ComingSoon,
+		defaultHost:   canonicalHost(opts.DefaultHost),
+		countryHeader: opts.CountryHeader,
+		geo:           opts.Geo,
+		matchers:      redirect.NewCache(matcherCacheSize),
+		analytics:     opts.Analytics,
+		qr:            opts.QRCodes,
+	}
+}
+
+// Routes registers the handler routes on mux
+func (h *RedirectHandler) Routes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /health", h.health)
+	mux.HandleFunc("GET /{code}", h.redirect)
+	mux.HandleFunc("POST /{code}", h.unlock)
+	if h.qr != nil {
+		mux.HandleFunc("GET /{code}/qr", h.qrCode)
+	}
+}
+
+// Wait blocks until in-flight click recordings finish
+func (h *RedirectHandler) Wait() {
+	h.clicks.Wait()
+}
+
+func (h *RedirectHandler) redirect(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+
+	in := application.GetURLInput{ID: h.urlID(r, code), ClientIP: h.ips.ClientIP(r)}
+	if cookie, err := r.Cookie(unlockCookieName(code)); err == nil {
+		in.UnlockToken = cookie.Value
+	}
+
+	u, err := h.urls.GetURL(r.Context(), in)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	t := h.route(r, u)
+	if err := h.recordClick(u, r, t); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if t.cookie != nil {
+		http.SetCookie(w, t.cookie)
+	}
+
+	// Links that can change state or differ per visitor must not be cached by browsers
+	status := h.redirectCode
+	if u.ExpiresAt != nil || u.MaxClicks > 0 || u.IsPasswordProtected() || len(u.Rules) > 0 || len(u.Variants) > 0 {
+		status = http.StatusFound
+	}
+	if status == http.StatusFound {
+		w.Header().Set("Cache-Control", "private, no-cache")
+	}
+	http.Redirect(w, r, t.url, status)
+}
+
+func (h *RedirectHandler) health(w http.ResponseWriter, r *http.Request) {
+	if err := h.urls.Health(r.Context()); err != nil {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte("ok"))
+}
+
+// urlID returns the ID code is stored under on the requested host
+func (h *RedirectHandler) urlID(r *http.Request, code string) string {
+	if h.defaultHost == "" {
+		return code
+	}
+	host := canonicalHost(r.Host)
+	if host == "" || host == h.defaultHost || host == "localhost" {
+		return code
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		// Health checks and clients inside the cluster address replicas directly
+		return code
+	}
+	return domain.QualifiedID(host, code)
+}
+
+// canonicalHost strips the port and trailing dot from a Host header and lower-cases it
+func canonicalHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// recordClick bumps the click counter and reports the click to analytics. A
+// click-limited URL must claim its click before redirecting, so that is done
+// inline and its error returned; everything else happens without delaying the
+// redirect.
+func (h *RedirectHandler) recordClick(u *domain.URL, r *http.Request, t target) error {
+	limited := u.MaxClicks > 0
+	if limited {
+		if _, err := h.urls.IncrementClick(r.Context(), u.ID); err != nil {
+			return err
+		}
+	}
+
+	click := domain.Click{
+		URLID:     u.ID,
+		SessionID: t.session,
+		Variant:   t.variant,
+		ClientIP:  h.ips.ClientIP(r),
+		UserAgent: r.UserAgent(),
+		Referrer:  r.Referer(),
+		Source:    clickSource(r),
+		At:        time.Now(),
+	}
+
+	h.clicks.Add(1)
+	go func() {
+		defer h.clicks.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), clickTimeout)
+		defer cancel()
+		if !limited {
+			if _, err := h.urls.IncrementClick(ctx, click.URLID); err != nil {
+				h.log.Warn("failed to record click", zap.String("code", click.URLID),
+					zap.String("client_ip", click.ClientIP), zap.Error(err))
+			}
+		}
+		if h.analytics != nil {
+			if err := h.analytics.RecordClick(ctx, click); err != nil {
+				h.log.Warn("failed to report click", zap.String("code", click.URLID), zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
+
+// clickSource returns the src marker of the request, e.g. "qr" for QR code
+// scans; anything but a short lower-case token is ignored
+func clickSource(r *http.Request) string {
+	src := r.URL.Query().Get("src")
+	if len(src) == 0 || len(src) > 32 {
+		return ""
+	}
+	for i := 0; i < len(src); i++ {
+		if c := src[i]; (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return ""
+		}
+	}
+	return src
+}
+
+// writeError maps application errors to HTTP responses
+func (h *RedirectHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	appErr := apperrors.AsAppError(err)
+	if appErr == nil {
+		h.log.Error("redirect failed", zap.String("path", r.URL.Path), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	switch appErr.Code {
+	case apperrors.CodeNotFound, apperrors.CodeValidation:
+		http.Error(w, "Short link not found", http.StatusNotFound)
+	case apperrors.CodeURLExpired:
+		http.Error(w, "This short link has expired", http.StatusGone)
+	case apperrors.CodeURLBlocked:
+		http.Error(w, "This short link has been disabled because its destination was flagged as unsafe", http.StatusGone)
+	case apperrors.CodeURLNotYetActive:
+		h.renderComingSoon(w, r, appErr)
+	case apperrors.CodePasswordRequired, apperrors.CodeInvalidPassword, apperrors.CodeRateLimit:
+		h.renderUnlock(w, r, appErr)
+	default:
+		if appErr.HTTPStatus() >= http.StatusInternalServerError {
+			h.log.Error("redirect failed", zap.String("path", r.URL.Path), zap.Error(err))
+		}
+		http.Error(w, http.StatusText(appErr.HTTPStatus()), appErr.HTTPStatus())
+	}
+}
@@ -0,0 +1,222 @@
+package httphandler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// fakeResolver serves stored URLs and records what the handler asked for
+type fakeResolver struct {
+	mu      sync.Mutex
+	urls    map[string]*domain.URL
+	errs    map[string]error
+	unlock  func(in application.UnlockURLInput) (*application.UnlockURLResult, error)
+	gets    []application.GetURLInput
+	clicks  []string
+	clickFn func(id string) error // Runs inside IncrementClick when set
+}
+
+func newFakeResolver(urls ...*domain.URL) *fakeResolver {
+	f := &fakeResolver{urls: make(map[string]*domain.URL), errs: make(map[string]error)}
+	for _, u := range urls {
+		f.urls[u.ID] = u
+	}
+	return f
+}
+
+func (f *fakeResolver) GetURL(_ context.Context, in application.GetURLInput) (*domain.URL, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gets = append(f.gets, in)
+	if err, ok := f.errs[in.ID]; ok {
+		return nil, err
+	}
+	u, ok := f.urls[in.ID]
+	if !ok {
+		return nil, apperrors.NotFoundf("short url %q not found", in.ID)
+	}
+	return u, nil
+}
+
+func (f *fakeResolver) UnlockURL(_ context.Context, in application.UnlockURLInput) (*application.UnlockURLResult, error) {
+	return f.unlock(in)
+}
+
+func (f *fakeResolver) IncrementClick(_ context.Context, id string) (int64, error) {
+	if f.clickFn != nil {
+		if err := f.clickFn(id); err != nil {
+			return 0, err
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.clicks = append(f.clicks, id)
+	return int64(len(f.clicks)), nil
+}
+
+func (f *fakeResolver) Health(context.Context) error {
+	return nil
+}
+
+func (f *fakeResolver) clicked() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.clicks...)
+}
+
+func newTestHandler(t *testing.T, urls URLResolver, opts RedirectOptions) (*RedirectHandler, http.Handler) {
+	ips, err := NewClientIPResolver([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	h := NewRedirectHandler(urls, ips, zap.NewNop(), opts)
+	t.Cleanup(h.Wait)
+	mux := http.NewServeMux()
+	h.Routes(mux)
+	return h, mux
+}
+
+func get(handler http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestRedirect_Status(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	urls := newFakeResolver(
+		&domain.URL{ID: "plain", OriginalURL: "https://example.com/plain", IsActive: true},
+		&domain.URL{ID: "expiring", OriginalURL: "https://example.com/expiring", IsActive: true, ExpiresAt: &expires},
+		&domain.URL{ID: "limited", OriginalURL: "https://example.com/limited", IsActive: true, MaxClicks: 5},
+	)
+
+	tests := []struct {
+		name         string
+		redirectCode int
+		code         string
+		want         int
+	}{
+		{"permanent", http.StatusMovedPermanently, "plain", http.StatusMovedPermanently},
+		{"temporary", http.StatusFound, "plain", http.StatusFound},
+		{"unsupported code falls back to temporary", http.StatusTemporaryRedirect, "plain", http.StatusFound},
+		// Links that can stop working must not be cached by browsers
+		{"expiring", http.StatusMovedPermanently, "expiring", http.StatusFound},
+		{"limited", http.StatusMovedPermanently, "limited", http.StatusFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, handler := newTestHandler(t, urls, RedirectOptions{RedirectCode: tt.redirectCode})
+
+			rec := get(handler, "/"+tt.code)
+			assert.Equal(t, tt.want, rec.Code)
+			assert.Equal(t, "https://example.com/"+tt.code, rec.Header().Get("Location"))
+			if tt.want == http.StatusFound {
+				assert.Equal(t, "private, no-cache", rec.Header().Get("Cache-Control"))
+			} else {
+				assert.Empty(t, rec.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
+
+func TestRedirect_Unavailable(t *testing.T) {
+	urls := newFakeResolver()
+	urls.errs["disabled"] = apperrors.NotFoundf("short url %q is disabled", "disabled")
+	urls.errs["expired"] = apperrors.Newf(apperrors.CodeURLExpired, "short url %q has expired", "expired")
+	urls.errs["exhausted"] = apperrors.Newf(apperrors.CodeURLExpired, "short url %q has reached its click limit", "exhausted")
+	urls.errs["blocked"] = apperrors.Newf(apperrors.CodeURLBlocked, "short url %q was disabled", "blocked")
+	urls.errs["broken"] = apperrors.Internal("failed to load url")
+	_, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	tests := map[string]int{
+		"missing":   http.StatusNotFound,
+		"disabled":  http.StatusNotFound,
+		"expired":   http.StatusGone,
+		"exhausted": http.StatusGone,
+		"blocked":   http.StatusGone,
+		"broken":    http.StatusInternalServerError,
+	}
+	for code, want := range tests {
+		rec := get(handler, "/"+code)
+		assert.Equal(t, want, rec.Code, code)
+		assert.Empty(t, rec.Header().Get("Location"), code)
+	}
+	assert.Empty(t, urls.clicked())
+}
+
+func TestRedirect_ClientIP(t *testing.T) {
+	urls := newFakeResolver(&domain.URL{ID: "plain", OriginalURL: "https://example.com", IsActive: true})
+	_, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	req := httptest.NewRequest(http.MethodGet, "/plain", nil)
+	req.RemoteAddr = "10.0.0.1:4000"
+	req.Header.Set("X-Forwarded-For", "198.51.100.7, 203.0.113.9, 10.0.0.2")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest(http.MethodGet, "/plain", nil)
+	req.RemoteAddr = "192.0.2.1:4000"
+	req.Header.Set("X-Forwarded-For", "203.0.113.9")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, urls.gets, 2)
+	assert.Equal(t, "203.0.113.9", urls.gets[0].ClientIP)
+	assert.Equal(t, "192.0.2.1", urls.gets[1].ClientIP)
+}
+
+func TestRedirect_RecordsClicksAsync(t *testing.T) {
+	urls := newFakeResolver(&domain.URL{ID: "plain", OriginalURL: "https://example.com", IsActive: true})
+	release := make(chan struct{})
+	urls.clickFn = func(string) error {
+		<-release
+		return nil
+	}
+	h, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	// The redirect does not wait for the click to be stored
+	rec := get(handler, "/plain")
+	assert.Equal(t, http.StatusFound, rec.Code)
+	assert.Empty(t, urls.clicked())
+
+	// Wait lets shutdown finish recording clicks already served
+	done := make(chan struct{})
+	go func() {
+		h.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("Wait returned before the click was recorded")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	<-done
+	assert.Equal(t, []string{"plain"}, urls.clicked())
+}
+
+func TestRedirect_RecordsLimitedClicksInline(t *testing.T) {
+	urls := newFakeResolver(&domain.URL{ID: "limited", OriginalURL: "https://example.com", IsActive: true, MaxClicks: 1})
+	_, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	rec := get(handler, "/limited")
+	assert.Equal(t, http.StatusFound, rec.Code)
+	assert.Equal(t, []string{"limited"}, urls.clicked())
+
+	// A visitor who loses the race for the last click is not redirected
+	urls.clickFn = func(id string) error {
+		return apperrors.Newf(apperrors.CodeURLExpired, "short url %q has reached its click limit", id)
+	}
+	rec = get(handler, "/limited")
+	assert.Equal(t, http.StatusGone, rec.Code)
+	assert.Empty(t, rec.Header().Get("Location"))
+	assert.Equal(t, []string{"limited"}, urls.clicked())
+}
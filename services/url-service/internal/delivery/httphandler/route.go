@@ -0,0 +1,120 @@
+package httphandler
+
+import (
+	crand "crypto/rand"
+	"encoding/hex"
+	"math/rand/v2"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain/redirect"
+)
+
+// matcherCacheSize bounds the compiled redirect rules kept in memory
+const matcherCacheSize = 10000
+
+// variantCookieTTL is how long a visitor sticks to an A/B variant
+const variantCookieTTL = 30 * 24 * time.Hour
+
+// target is where a visitor is sent and what is reported with the click
+type target struct {
+	url     string
+	variant string       // A/B variant served, empty for a single destination
+	session string       // Visitor ID kept in the variant cookie
+	cookie  *http.Cookie // Sticks the visitor to a newly drawn variant, nil if unchanged
+}
+
+// variantCookieName returns the cookie that pins a visitor to a variant of code
+func variantCookieName(code string) string {
+	return "ab_" + code
+}
+
+// route picks the destination: the first redirect rule the visitor matches,
+// then the visitor's A/B variant, then the original URL
+func (h *RedirectHandler) route(r *http.Request, u *domain.URL) target {
+	if len(u.Rules) > 0 {
+		if dest, _, ok := h.matchers.Matcher(u).Match(h.visitor(r)); ok {
+			return target{url: dest}
+		}
+	}
+	if len(u.Variants) > 0 {
+		if t, ok := h.variant(r, u); ok {
+			return t
+		}
+	}
+	return target{url: u.OriginalURL}
+}
+
+// variant draws the visitor's variant, reusing the one in their cookie while it
+// is still served. The cookie also carries a random visitor ID, which analytics
+// counts unique visitors per variant by.
+func (h *RedirectHandler) variant(r *http.Request, u *domain.URL) (target, bool) {
+	var sticky, session string
+	if cookie, err := r.Cookie(variantCookieName(u.Code())); err == nil {
+		sticky, session, _ = strings.Cut(cookie.Value, ".")
+	}
+
+	v, ok := redirect.PickVariant(u.Variants, sticky, rand.IntN)
+	if !ok {
+		return target{}, false
+	}
+	t := target{url: v.DestinationURL, variant: v.ID, session: session}
+	if v.ID == sticky && session != "" {
+		return t, true
+	}
+
+	if t.session == "" {
+		t.session = newSessionID()
+	}
+	t.cookie = &http.Cookie{
+		Name:     variantCookieName(u.Code()),
+		Value:    v.ID + "." + t.session,
+		Path:     "/" + u.Code(),
+		MaxAge:   int(variantCookieTTL.Seconds()),
+		Secure:   h.secureCookies,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	return t, true
+}
+
+// visitor describes the request for redirect rule matching
+func (h *RedirectHandler) visitor(r *http.Request) redirect.Visitor {
+	device, os := redirect.ParseUserAgent(r.UserAgent())
+	return redirect.Visitor{
+		Country:    h.country(r),
+		DeviceType: device,
+		OS:         os,
+		Language:   redirect.PreferredLanguage(r.Header.Get("Accept-Language")),
+		Time:       time.Now(),
+	}
+}
+
+// country returns the visitor's ISO country code, or "" if unknown
+func (h *RedirectHandler) country(r *http.Request) string {
+	if h.countryHeader != "" && h.ips.FromTrustedProxy(r) {
+		// CDNs use XX and T1 for unknown and Tor
+		if c := strings.ToUpper(strings.TrimSpace(r.Header.Get(h.countryHeader))); len(c) == 2 && c != "XX" && c != "T1" {
+			return c
+		}
+	}
+	if h.geo == nil {
+		return ""
+	}
+	addr, err := netip.ParseAddr(h.ips.ClientIP(r))
+	if err != nil {
+		return ""
+	}
+	return h.geo.Country(addr)
+}
+
+// newSessionID returns a random visitor ID
+func newSessionID() string {
+	b := make([]byte, 12)
+	// crypto/rand only fails when the OS entropy source is broken
+	crand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,24 @@
+package httphandler
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// NewRouter builds the HTTP handler with middlewares enabled by config
+func NewRouter(cfg config.ServerConfig, redirects *RedirectHandler, ips *ClientIPResolver, log *zap.Logger) http.Handler {
+	mux := http.NewServeMux()
+	redirects.Routes(mux)
+
+	middlewares := []Middleware{Recover(log), Logging(log, ips)}
+	if cfg.EnableCORS {
+		middlewares = append(middlewares, CORS())
+	}
+	if cfg.EnableCompression {
+		middlewares = append(middlewares, Compress())
+	}
+	return Chain(mux, middlewares...)
+}
@@ -0,0 +1,135 @@
+package httphandler
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+)
+
+// maxUnlockFormSize bounds the unlock form body
+const maxUnlockFormSize = 4 << 10
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var unlockTemplate = template.Must(template.ParseFS(templateFS, "templates/unlock.html"))
+
+// unlockPage is the data rendered by the unlock template
+type unlockPage struct {
+	Code     string
+	Error    string
+	Disabled bool
+}
+
+// unlockCookieName returns the cookie that carries the unlock token for code
+func unlockCookieName(code string) string {
+	return "unlock_" + code
+}
+
+// unlock handles POST /{code} from the unlock form
+func (h *RedirectHandler) unlock(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUnlockFormSize)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form", http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.urls.UnlockURL(r.Context(), application.UnlockURLInput{
+		ID:       h.urlID(r, code),
+		Password: r.PostForm.Get("password"),
+		ClientIP: h.ips.ClientIP(r),
+	})
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	t := h.route(r, res.URL)
+	if err := h.recordClick(res.URL, r, t); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if t.cookie != nil {
+		http.SetCookie(w, t.cookie)
+	}
+
+	if res.Token != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     unlockCookieName(res.URL.Code()),
+			Value:    res.Token,
+			Path:     "/" + res.URL.Code(),
+			Expires:  res.ExpiresAt,
+			MaxAge:   int(time.Until(res.ExpiresAt).Seconds()),
+			Secure:   h.secureCookies,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	// 303 turns the form POST into a GET on the destination
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, t.url, http.StatusSeeOther)
+}
+
+// renderUnlock writes the unlock form for a password error
+func (h *RedirectHandler) renderUnlock(w http.ResponseWriter, r *http.Request, appErr *apperrors.AppError) {
+	page := unlockPage{Code: r.PathValue("code")}
+	status := http.StatusUnauthorized
+
+	switch appErr.Code {
+	case apperrors.CodeInvalidPassword:
+		page.Error = "Incorrect password, please try again."
+	case apperrors.CodeRateLimit:
+		status = http.StatusTooManyRequests
+		page.Disabled = true
+		page.Error = "Too many wrong attempts. Please try again later."
+		if retry, ok := appErr.Details["retry_after"].(int); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(retry))
+			page.Error = fmt.Sprintf("Too many wrong attempts. Please try again in %s.", retryIn(retry))
+		}
+	case apperrors.CodePasswordRequired:
+		// Only show a hint when the form was submitted empty
+		if r.Method == http.MethodPost {
+			page.Error = "Please enter the password."
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := unlockTemplate.Execute(&buf, page); err != nil {
+		h.log.Error("failed to render unlock page", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/html; charset=utf-8")
+	header.Set("Cache-Control", "no-store")
+	header.Set("X-Frame-Options", "DENY")
+	header.Set("Referrer-Policy", "no-referrer")
+	header.Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors 'none'")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// retryIn formats a Retry-After value in seconds for humans
+func retryIn(seconds int) string {
+	if seconds < 60 {
+		return "a minute"
+	}
+	minutes := (seconds + 59) / 60
+	if minutes == 1 {
+		return "1 minute"
+	}
+	return strconv.Itoa(minutes) + " minutes"
+}
@@ -0,0 +1,117 @@
+package httphandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/url-service/internal/application"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func postPassword(handler http.Handler, path, password string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(url.Values{"password": {password}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.RemoteAddr = "192.0.2.1:4000"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// assertUnlockPage checks the unlock form is served with its security headers
+func assertUnlockPage(t *testing.T, rec *httptest.ResponseRecorder, status int, message string) {
+	t.Helper()
+	assert.Equal(t, status, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "no-referrer", rec.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors 'none'", rec.Header().Get("Content-Security-Policy"))
+	assert.Contains(t, rec.Body.String(), message)
+}
+
+func TestUnlock_Form(t *testing.T) {
+	urls := newFakeResolver()
+	urls.errs["secret"] = apperrors.New(apperrors.CodePasswordRequired, "password required").WithField("password")
+	_, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	rec := get(handler, "/secret")
+	assertUnlockPage(t, rec, http.StatusUnauthorized, "This link is password protected")
+	assert.NotContains(t, rec.Body.String(), "Please enter the password.")
+	assert.Empty(t, rec.Header().Get("Location"))
+}
+
+func TestUnlock_SetsCookie(t *testing.T) {
+	expires := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	u := &domain.URL{ID: "secret", OriginalURL: "https://example.com/private", IsActive: true, PasswordHash: "hash"}
+	urls := newFakeResolver()
+	urls.unlock = func(in application.UnlockURLInput) (*application.UnlockURLResult, error) {
+		if in.Password != "open sesame" {
+			return nil, apperrors.New(apperrors.CodeInvalidPassword, "invalid password").WithField("password")
+		}
+		assert.Equal(t, "192.0.2.1", in.ClientIP)
+		return &application.UnlockURLResult{URL: u, Token: "signed-token", ExpiresAt: expires}, nil
+	}
+	h, handler := newTestHandler(t, urls, RedirectOptions{SecureCookies: true})
+
+	rec := postPassword(handler, "/secret", "guess")
+	assertUnlockPage(t, rec, http.StatusUnauthorized, "Incorrect password, please try again.")
+	assert.Empty(t, rec.Result().Cookies())
+
+	rec = postPassword(handler, "/secret", "open sesame")
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "https://example.com/private", rec.Header().Get("Location"))
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	h.Wait()
+	assert.Equal(t, []string{"secret"}, urls.clicked())
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	c := cookies[0]
+	assert.Equal(t, "unlock_secret", c.Name)
+	assert.Equal(t, "signed-token", c.Value)
+	assert.Equal(t, "/secret", c.Path)
+	assert.True(t, c.HttpOnly)
+	assert.True(t, c.Secure)
+	assert.Equal(t, http.SameSiteLaxMode, c.SameSite)
+	assert.InDelta(t, (10 * time.Minute).Seconds(), c.MaxAge, 2)
+
+	// The cookie is handed back on later visits
+	req := httptest.NewRequest(http.MethodGet, "/secret", nil)
+	req.AddCookie(c)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Len(t, urls.gets, 1)
+	assert.Equal(t, "signed-token", urls.gets[0].UnlockToken)
+}
+
+func TestUnlock_Throttled(t *testing.T) {
+	urls := newFakeResolver()
+	urls.unlock = func(application.UnlockURLInput) (*application.UnlockURLResult, error) {
+		return nil, apperrors.RateLimit("too many wrong password attempts, try again later").
+			WithField("password").WithDetail("retry_after", 125)
+	}
+	_, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	rec := postPassword(handler, "/secret", "guess")
+	assertUnlockPage(t, rec, http.StatusTooManyRequests, "Please try again in 3 minutes.")
+	assert.Equal(t, "125", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), "disabled")
+}
+
+func TestUnlock_EmptyPassword(t *testing.T) {
+	urls := newFakeResolver()
+	urls.unlock = func(application.UnlockURLInput) (*application.UnlockURLResult, error) {
+		return nil, apperrors.New(apperrors.CodePasswordRequired, "password required").WithField("password")
+	}
+	_, handler := newTestHandler(t, urls, RedirectOptions{})
+
+	rec := postPassword(handler, "/secret", "")
+	assertUnlockPage(t, rec, http.StatusUnauthorized, "Please enter the password.")
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Click is a redirect reported to analytics
+type Click struct {
+	URLID     string
+	SessionID string // Stable per visitor while a variant sticks, empty otherwise
+	Variant   string // A/B variant served, empty for single-destination links
+	ClientIP  string
+	UserAgent string
+	Referrer  string
+	Source    string // Marker from the short URL's src parameter, e.g. QRSourceMarker
+	At        time.Time
+}
+
+// ClickRecorder reports clicks to analytics
+type ClickRecorder interface {
+	RecordClick(ctx context.Context, click Click) error
+}
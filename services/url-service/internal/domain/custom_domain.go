@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Errors returned by DomainRepository
+var (
+	ErrDomainNotFound = errors.New("domain not found")
+	ErrDomainTaken    = errors.New("domain already claimed")
+)
+
+// VerificationRecordPrefix is prepended to a hostname to name the TXT record
+// proving its ownership
+const VerificationRecordPrefix = "_shortener-verify."
+
+// CustomDomain is a hostname a user has claimed for branded short links. Links
+// can be created on it once a TXT record proves the user controls its DNS.
+type CustomDomain struct {
+	Hostname          string // Lower case, without port or trailing dot
+	UserID            string
+	VerificationToken string // Value expected in the TXT record
+	CreatedAt         time.Time
+	VerifiedAt        *time.Time // Nil until verified
+	CheckedAt         *time.Time // Last verification attempt
+}
+
+// IsVerified reports whether ownership of the hostname has been proven
+func (d *CustomDomain) IsVerified() bool {
+	return d.VerifiedAt != nil
+}
+
+// VerificationRecord returns the name of the TXT record checked for the token
+func (d *CustomDomain) VerificationRecord() string {
+	return VerificationRecordPrefix + d.Hostname
+}
+
+// QualifiedID returns the ID a short code is stored under on host. Codes on the
+// default domain are stored bare; branded ones are prefixed with the hostname,
+// which keeps codes unique per domain. Codes never contain '/', so the two
+// forms cannot collide.
+func QualifiedID(host, code string) string {
+	if host == "" {
+		return code
+	}
+	return host + "/" + code
+}
+
+// SplitID splits a URL ID into its branded hostname, empty for the default
+// domain, and its short code
+func SplitID(id string) (host, code string) {
+	if i := strings.LastIndexByte(id, '/'); i >= 0 {
+		return id[:i], id[i+1:]
+	}
+	return "", id
+}
+
+// DomainRepository persists custom domains. A hostname may be claimed by
+// several users but verified by only one.
+type DomainRepository interface {
+	// Create stores a claim, returns ErrDomainTaken if the user already claimed
+	// the hostname or another user verified it
+	Create(ctx context.Context, d *CustomDomain) error
+	// Get returns a user's claim or ErrDomainNotFound
+	Get(ctx context.Context, hostname, userID string) (*CustomDomain, error)
+	// GetVerified returns the verified claim of a hostname or ErrDomainNotFound
+	GetVerified(ctx context.Context, hostname string) (*CustomDomain, error)
+	// ListByUser returns a user's claims, newest first
+	ListByUser(ctx context.Context, userID string) ([]*CustomDomain, error)
+	// MarkChecked records a verification attempt, and success when verified is
+	// set; returns ErrDomainTaken if another user verified the hostname first
+	MarkChecked(ctx context.Context, hostname, userID string, at time.Time, verified bool) error
+}
+
+// TXTResolver looks up DNS TXT records; *net.Resolver implements it
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
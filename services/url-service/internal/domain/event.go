@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Domain event types
+const (
+	EventURLExpired  = "url.expired"
+	EventURLExpiring = "url.expiring"
+	EventURLBlocked  = "url.blocked"
+)
+
+// Event is a domain event recorded in the outbox alongside the change that caused it
+type Event struct {
+	ID          int64
+	Type        string
+	AggregateID string // Short code, or user ID for owner notices
+	Payload     json.RawMessage
+	OccurredAt  time.Time
+}
+
+// URLExpiredPayload is the payload of EventURLExpired
+type URLExpiredPayload struct {
+	URLID       string    `json:"url_id"`
+	UserID      string    `json:"user_id,omitempty"`
+	OriginalURL string    `json:"original_url"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+// URLBlockedPayload is the payload of EventURLBlocked
+type URLBlockedPayload struct {
+	URLID       string    `json:"url_id"`
+	UserID      string    `json:"user_id,omitempty"`
+	OriginalURL string    `json:"original_url"`
+	Reason      string    `json:"reason"`
+	BlockedAt   time.Time `json:"blocked_at"`
+}
+
+// URLExpiringPayload is the payload of EventURLExpiring, one per owner notice
+type URLExpiringPayload struct {
+	UserID string            `json:"user_id"`
+	Email  string            `json:"email"`
+	Name   string            `json:"name,omitempty"`
+	URLs   []ExpiringURLInfo `json:"urls"`
+}
+
+// ExpiringURLInfo describes one link in an expiry notice
+type ExpiringURLInfo struct {
+	URLID       string    `json:"url_id"`
+	OriginalURL string    `json:"original_url"`
+	Title       string    `json:"title,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// NewEvent builds an event with a JSON encoded payload
+func NewEvent(eventType, aggregateID string, payload interface{}, at time.Time) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{Type: eventType, AggregateID: aggregateID, Payload: data, OccurredAt: at}, nil
+}
+
+// EventPublisher delivers domain events to subscribers
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Outbox stores events until they are relayed to an EventPublisher
+type Outbox interface {
+	// Relay publishes up to limit pending events in order, skipping events another
+	// relay is handling. Events are marked published once publish returns nil.
+	Relay(ctx context.Context, limit int, publish func(context.Context, Event) error) (int, error)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrOwnerNotFound is returned by OwnerDirectory for unknown users
+var ErrOwnerNotFound = errors.New("owner not found")
+
+// ExpirationRepository deactivates and reports expiring URLs. Implementations lock
+// rows so several workers can run concurrently without handling a URL twice.
+type ExpirationRepository interface {
+	// ExpireBatch deactivates up to limit active URLs expired at now and records
+	// an EventURLExpired for each in the same transaction
+	ExpireBatch(ctx context.Context, now time.Time, limit int) ([]*URL, error)
+
+	// ClaimExpiring marks up to limit owned URLs expiring in (now, deadline] that
+	// have not been notified, then passes them to notify without holding locks
+	// and records the returned events. The marks are released if notify fails.
+	ClaimExpiring(ctx context.Context, now, deadline time.Time, limit int,
+		notify func(ctx context.Context, urls []*URL) ([]Event, error)) (int, error)
+}
+
+// Owner holds the contact details and preferences of a URL owner
+type Owner struct {
+	ID                 string
+	Email              string
+	Name               string
+	Active             bool
+	EmailNotifications bool
+}
+
+// OwnerDirectory looks up URL owners
+type OwnerDirectory interface {
+	Owner(ctx context.Context, userID string) (*Owner, error)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// Errors returned by PageInspector
+var (
+	ErrDestinationBlocked = errors.New("destination address is not allowed")
+	ErrTooManyRedirects   = errors.New("too many redirects")
+)
+
+// PageInfo describes what a destination URL served when it was fetched
+type PageInfo struct {
+	FinalURL    string // URL after following redirects
+	Redirects   int    // Redirects followed to reach FinalURL
+	StatusCode  int
+	ContentType string // MIME type without parameters
+
+	// Scraped from HTML, OpenGraph tags take precedence over <title> and meta description
+	Title       string
+	Description string
+	Image       string
+	SiteName    string
+}
+
+// Accessible reports whether the destination answered with a success status
+func (p *PageInfo) Accessible() bool {
+	return p.StatusCode >= 200 && p.StatusCode < 300
+}
+
+// PageInspector fetches destination URLs without exposing internal networks
+type PageInspector interface {
+	// Inspect fetches rawURL and returns page details for any HTTP response.
+	// Transport failures, blocked addresses and redirect loops return an error.
+	Inspect(ctx context.Context, rawURL string) (*PageInfo, error)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"image/color"
+)
+
+// QR code image formats
+const (
+	QRFormatPNG = "png"
+	QRFormatSVG = "svg"
+)
+
+// QR code error correction levels, restoring about 7%, 15%, 25% and 30% of a
+// damaged or covered code
+const (
+	QRLevelLow      = "L"
+	QRLevelMedium   = "M"
+	QRLevelQuartile = "Q"
+	QRLevelHigh     = "H"
+)
+
+// QRSourceMarker is the src query parameter QR codes add to the short URL, so
+// analytics can tell scans from other clicks
+const QRSourceMarker = "qr"
+
+// QROptions controls how a QR code is rendered
+type QROptions struct {
+	Format     string // QRFormat constant
+	Size       int    // Width and height in pixels, including the quiet zone
+	Level      string // QRLevel constant
+	Foreground color.RGBA
+	Background color.RGBA
+	Logo       bool // Overlay the configured logo in the centre
+}
+
+// QRRenderer encodes content as a QR code image
+type QRRenderer interface {
+	Render(ctx context.Context, content string, opts QROptions) ([]byte, error)
+}
@@ -0,0 +1,241 @@
+// Package redirect picks the destination of a short link for a visitor from
+// the link's redirect rules and A/B variants
+package redirect
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// Visitor describes the request being redirected; empty fields are unknown and
+// never match a condition on them
+type Visitor struct {
+	Country    string // ISO 3166-1 alpha-2, upper case
+	DeviceType string
+	OS         string
+	Language   string // Preferred language tag, lower case
+	Time       time.Time
+}
+
+// Matcher evaluates a compiled rule list
+type Matcher struct {
+	rules []rule
+}
+
+// rule is a domain.RedirectRule with its conditions prepared for matching
+type rule struct {
+	id          string
+	destination string
+	countries   []string
+	devices     []string
+	systems     []string
+	languages   []string
+	window      *window
+}
+
+// window is a TimeWindow with its location loaded and weekdays as a bit set
+type window struct {
+	start, end int
+	loc        *time.Location // Nil if the time zone is unknown, the window never matches
+	days       uint8          // Bit per time.Weekday, 0 for every day
+}
+
+// Compile prepares rules for matching, skipping disabled ones
+func Compile(rules []domain.RedirectRule) *Matcher {
+	m := &Matcher{rules: make([]rule, 0, len(rules))}
+	for _, r := range rules {
+		if r.Disabled {
+			continue
+		}
+		c := rule{
+			id:          r.ID,
+			destination: r.DestinationURL,
+			countries:   r.Countries,
+			devices:     r.DeviceTypes,
+			systems:     r.OperatingSystems,
+			languages:   r.Languages,
+		}
+		if tw := r.TimeWindow; tw != nil {
+			c.window = &window{start: tw.Start, end: tw.End, loc: loadLocation(tw.Timezone)}
+			for _, d := range tw.Weekdays {
+				c.window.days |= 1 << uint(d)
+			}
+		}
+		m.rules = append(m.rules, c)
+	}
+	return m
+}
+
+// Match returns the destination and ID of the first rule v satisfies
+func (m *Matcher) Match(v Visitor) (destination, ruleID string, ok bool) {
+	for i := range m.rules {
+		r := &m.rules[i]
+		if r.matches(v) {
+			return r.destination, r.id, true
+		}
+	}
+	return "", "", false
+}
+
+func (r *rule) matches(v Visitor) bool {
+	if len(r.countries) > 0 && !contains(r.countries, v.Country) {
+		return false
+	}
+	if len(r.devices) > 0 && !contains(r.devices, v.DeviceType) {
+		return false
+	}
+	if len(r.systems) > 0 && !contains(r.systems, v.OS) {
+		return false
+	}
+	if len(r.languages) > 0 && !matchLanguage(r.languages, v.Language) {
+		return false
+	}
+	return r.window == nil || r.window.contains(v.Time)
+}
+
+// contains reports whether value is in the list; lists are short, a scan beats a map
+func contains(list []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// matchLanguage matches tag against language ranges, "en" matching "en-gb" too
+func matchLanguage(ranges []string, tag string) bool {
+	if tag == "" {
+		return false
+	}
+	for _, r := range ranges {
+		if tag == r || (strings.HasPrefix(tag, r) && tag[len(r)] == '-') {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether t falls in the window. The part of a window wrapping
+// past midnight belongs to the day it started on.
+func (w *window) contains(t time.Time) bool {
+	if w.loc == nil || t.IsZero() {
+		return false
+	}
+	local := t.In(w.loc)
+	minute := local.Hour()*60 + local.Minute()
+	day := local.Weekday()
+
+	switch {
+	case w.start < w.end:
+		if minute < w.start || minute >= w.end {
+			return false
+		}
+	case minute >= w.start:
+	case minute < w.end:
+		day = (day + 6) % 7
+	default:
+		return false
+	}
+	return w.days == 0 || w.days&(1<<uint(day)) != 0
+}
+
+var locations sync.Map // Time zone name to *time.Location, nil if unknown
+
+// loadLocation returns the named time zone, caching lookups since loading reads
+// the zone database
+func loadLocation(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc = nil
+	}
+	locations.Store(name, loc)
+	return loc
+}
+
+// ValidTimezone reports whether name is a known IANA time zone, empty meaning UTC
+func ValidTimezone(name string) bool {
+	return loadLocation(name) != nil
+}
+
+// Cache keeps compiled matchers per URL so hot links are not recompiled on every
+// redirect. An entry is reused while the URL's UpdatedAt is unchanged, which every
+// rule edit bumps.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	version time.Time
+	matcher *Matcher
+}
+
+// NewCache creates a cache holding up to size matchers
+func NewCache(size int) *Cache {
+	return &Cache{size: size, entries: make(map[string]cacheEntry, size)}
+}
+
+// Matcher returns the compiled rules of u
+func (c *Cache) Matcher(u *domain.URL) *Matcher {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[u.ID]; ok && e.version.Equal(u.UpdatedAt) {
+		return e.matcher
+	}
+	// Evict an arbitrary entry when full, map iteration order is random enough
+	if len(c.entries) >= c.size {
+		for id := range c.entries {
+			delete(c.entries, id)
+			break
+		}
+	}
+	m := Compile(u.Rules)
+	c.entries[u.ID] = cacheEntry{version: u.UpdatedAt, matcher: m}
+	return m
+}
+
+// PickVariant returns the variant named sticky while it is still served,
+// otherwise one drawn by weight; roll returns a random number in [0, n).
+// ok is false when no variant has weight.
+func PickVariant(variants []domain.Variant, sticky string, roll func(n int) int) (v domain.Variant, ok bool) {
+	total := 0
+	for _, v := range variants {
+		if v.Weight <= 0 {
+			continue
+		}
+		if v.ID == sticky {
+			return v, true
+		}
+		total += v.Weight
+	}
+	if total == 0 {
+		return v, false
+	}
+
+	n := roll(total)
+	for _, v := range variants {
+		if v.Weight <= 0 {
+			continue
+		}
+		if n < v.Weight {
+			return v, true
+		}
+		n -= v.Weight
+	}
+	return v, false
+}
@@ -0,0 +1,114 @@
+package redirect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestMatcher_FirstMatchWins(t *testing.T) {
+	m := Compile([]domain.RedirectRule{
+		{ID: "off", DestinationURL: "https://off.example.com", Countries: []string{"DE"}, Disabled: true},
+		{ID: "de-mobile", DestinationURL: "https://m.example.de", Countries: []string{"DE"}, DeviceTypes: []string{domain.DeviceMobile}},
+		{ID: "de", DestinationURL: "https://example.de", Countries: []string{"DE", "AT"}},
+		{ID: "french", DestinationURL: "https://example.fr", Languages: []string{"fr"}},
+	})
+
+	dest, id, ok := m.Match(Visitor{Country: "DE", DeviceType: domain.DeviceMobile})
+	assert.True(t, ok)
+	assert.Equal(t, "de-mobile", id)
+	assert.Equal(t, "https://m.example.de", dest)
+
+	_, id, _ = m.Match(Visitor{Country: "AT", DeviceType: domain.DeviceMobile})
+	assert.Equal(t, "de", id)
+
+	_, id, _ = m.Match(Visitor{Country: "CH", Language: "fr-ch"})
+	assert.Equal(t, "french", id)
+
+	// "fr" must not match a different language sharing its prefix
+	_, _, ok = m.Match(Visitor{Language: "fry"})
+	assert.False(t, ok)
+	_, _, ok = m.Match(Visitor{})
+	assert.False(t, ok)
+}
+
+func TestMatcher_TimeWindow(t *testing.T) {
+	m := Compile([]domain.RedirectRule{{
+		ID:             "friday-night",
+		DestinationURL: "https://late.example.com",
+		TimeWindow: &domain.TimeWindow{
+			Start: 22 * 60, End: 2 * 60, Timezone: "Europe/Berlin",
+			Weekdays: []time.Weekday{time.Friday},
+		},
+	}})
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+
+	cases := map[string]struct {
+		at   time.Time
+		want bool
+	}{
+		"friday before window":  {time.Date(2024, 3, 1, 21, 59, 0, 0, berlin), false},
+		"friday in window":      {time.Date(2024, 3, 1, 23, 0, 0, 0, berlin), true},
+		"after midnight":        {time.Date(2024, 3, 2, 1, 30, 0, 0, berlin), true},
+		"window end":            {time.Date(2024, 3, 2, 2, 0, 0, 0, berlin), false},
+		"saturday night":        {time.Date(2024, 3, 2, 23, 0, 0, 0, berlin), false},
+		"friday in UTC evening": {time.Date(2024, 3, 1, 21, 30, 0, 0, time.UTC), true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, _, ok := m.Match(Visitor{Time: tc.at})
+			assert.Equal(t, tc.want, ok)
+		})
+	}
+}
+
+func TestParseUserAgent(t *testing.T) {
+	cases := []struct {
+		ua, device, os string
+	}{
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 Mobile/15E148", domain.DeviceMobile, domain.OSIOS},
+		{"Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15", domain.DeviceTablet, domain.OSIOS},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 Chrome/120.0 Mobile Safari/537.36", domain.DeviceMobile, domain.OSAndroid},
+		{"Mozilla/5.0 (Linux; Android 13; SM-X710) AppleWebKit/537.36 Chrome/120.0 Safari/537.36", domain.DeviceTablet, domain.OSAndroid},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 Chrome/120.0 Safari/537.36", domain.DeviceDesktop, domain.OSWindows},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 Safari/605.1.15", domain.DeviceDesktop, domain.OSMacOS},
+		{"Mozilla/5.0 (X11; CrOS x86_64 14541.0.0) AppleWebKit/537.36 Chrome/120.0 Safari/537.36", domain.DeviceDesktop, domain.OSChromeOS},
+		{"curl/8.4.0", "", ""},
+	}
+	for _, tc := range cases {
+		device, os := ParseUserAgent(tc.ua)
+		assert.Equal(t, tc.device, device, tc.ua)
+		assert.Equal(t, tc.os, os, tc.ua)
+	}
+}
+
+func TestPreferredLanguage(t *testing.T) {
+	assert.Equal(t, "fr-ch", PreferredLanguage("fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5"))
+	assert.Equal(t, "de", PreferredLanguage("en;q=0.5, de;q=0.7"))
+	assert.Equal(t, "en", PreferredLanguage("*, en;q=0.1"))
+	assert.Equal(t, "", PreferredLanguage("en;q=0"))
+	assert.Equal(t, "", PreferredLanguage(""))
+}
+
+func TestPickVariant(t *testing.T) {
+	variants := []domain.Variant{{ID: "a", Weight: 70}, {ID: "paused", Weight: 0}, {ID: "b", Weight: 30}}
+
+	v, ok := PickVariant(variants, "b", func(int) int { panic("sticky variant must not roll") })
+	assert.True(t, ok)
+	assert.Equal(t, "b", v.ID)
+
+	// A paused variant no longer sticks
+	v, _ = PickVariant(variants, "paused", func(n int) int { return 69 })
+	assert.Equal(t, "a", v.ID)
+	v, _ = PickVariant(variants, "", func(n int) int { assert.Equal(t, 100, n); return 70 })
+	assert.Equal(t, "b", v.ID)
+
+	_, ok = PickVariant([]domain.Variant{{ID: "a"}}, "", func(int) int { return 0 })
+	assert.False(t, ok)
+}
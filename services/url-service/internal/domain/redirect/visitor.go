@@ -0,0 +1,71 @@
+package redirect
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ParseUserAgent classifies a User-Agent header into a device type and an
+// operating system, returning "" for what it cannot tell. iPads requesting
+// desktop sites identify as Macs and are classified as such.
+func ParseUserAgent(ua string) (device, os string) {
+	if ua == "" {
+		return "", ""
+	}
+	ua = strings.ToLower(ua)
+
+	switch {
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ipod"):
+		os = domain.OSIOS
+	case strings.Contains(ua, "android"):
+		os = domain.OSAndroid
+	case strings.Contains(ua, "; cros "):
+		os = domain.OSChromeOS
+	case strings.Contains(ua, "windows"):
+		os = domain.OSWindows
+	case strings.Contains(ua, "macintosh"), strings.Contains(ua, "mac os x"):
+		os = domain.OSMacOS
+	case strings.Contains(ua, "linux"):
+		os = domain.OSLinux
+	}
+
+	switch {
+	case strings.Contains(ua, "ipad"), strings.Contains(ua, "tablet"),
+		os == domain.OSAndroid && !strings.Contains(ua, "mobile"):
+		device = domain.DeviceTablet
+	case strings.Contains(ua, "mobi"), strings.Contains(ua, "iphone"), strings.Contains(ua, "ipod"),
+		strings.Contains(ua, "windows phone"):
+		device = domain.DeviceMobile
+	case os != "":
+		device = domain.DeviceDesktop
+	}
+	return device, os
+}
+
+// PreferredLanguage returns the highest weighted language tag of an
+// Accept-Language header in lower case, the first one on ties, or "" if none
+func PreferredLanguage(header string) string {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > bestQ {
+			best, bestQ = tag, q
+		}
+	}
+	return best
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"net/netip"
+	"time"
+)
+
+// ErrRuleNotFound is returned when a URL has no redirect rule with the given ID
+var ErrRuleNotFound = errors.New("redirect rule not found")
+
+// Device types redirect rules can match
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+)
+
+// Operating systems redirect rules can match
+const (
+	OSWindows  = "windows"
+	OSMacOS    = "macos"
+	OSIOS      = "ios"
+	OSAndroid  = "android"
+	OSLinux    = "linux"
+	OSChromeOS = "chromeos"
+)
+
+// RedirectRule sends visitors matching every condition it sets to DestinationURL.
+// A URL's rules are evaluated in order, the first match wins and visitors no rule
+// matches go to the URL's OriginalURL. Rules are stored as JSON with their URL.
+type RedirectRule struct {
+	ID             string `json:"id"`
+	DestinationURL string `json:"destination_url"`
+
+	Countries        []string    `json:"countries,omitempty"`         // ISO 3166-1 alpha-2, upper case
+	DeviceTypes      []string    `json:"device_types,omitempty"`      // Device constants
+	OperatingSystems []string    `json:"operating_systems,omitempty"` // OS constants
+	Languages        []string    `json:"languages,omitempty"`         // Lower case language tags, "en" also matches "en-gb"
+	TimeWindow       *TimeWindow `json:"time_window,omitempty"`
+
+	Disabled  bool      `json:"disabled,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TimeWindow is a daily time range in a time zone
+type TimeWindow struct {
+	Start    int            `json:"start"`              // Minutes after midnight, inclusive
+	End      int            `json:"end"`                // Minutes after midnight, exclusive; before Start wraps past midnight
+	Timezone string         `json:"timezone,omitempty"` // IANA name, UTC when empty
+	Weekdays []time.Weekday `json:"weekdays,omitempty"` // Days the window starts on, every day when empty
+}
+
+// Variant is an A/B split destination. Visitors no rule matches are spread over
+// a URL's variants in proportion to their weights.
+type Variant struct {
+	ID             string `json:"id"`
+	DestinationURL string `json:"destination_url"`
+	Weight         int    `json:"weight"` // 0 pauses the variant
+}
+
+// GeoLocator maps client addresses to countries
+type GeoLocator interface {
+	// Country returns the ISO 3166-1 alpha-2 code for addr, or "" if unknown
+	Country(addr netip.Addr) string
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// URLRevision is an immutable record of one edit of a URL
+type URLRevision struct {
+	ID           int64
+	URLID        string
+	UserID       string // Who made the edit
+	Changes      []FieldChange
+	RestoredFrom int64 // Revision the edit restored, 0 for a plain edit
+	CreatedAt    time.Time
+}
+
+// FieldChange is a field an edit changed, with its values encoded as JSON.
+// Passwords are recorded only as whether one is set.
+type FieldChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Threat sources
+const (
+	ThreatBlocklist = "blocklist" // Matched a blocklist entry
+	ThreatHeuristic = "heuristic" // Looks like abuse without being listed
+)
+
+// Threat explains why a destination was flagged as unsafe
+type Threat struct {
+	Source string // Threat source constant
+	Reason string // e.g. `host "login-paypal.example" is blocklisted`
+}
+
+// String returns the reason recorded on blocked URLs
+func (t *Threat) String() string {
+	return t.Source + ": " + t.Reason
+}
+
+// DestinationScreener flags unsafe destinations from the URL alone, without
+// fetching it
+type DestinationScreener interface {
+	// Screen returns the threat rawURL poses, or nil if none is known
+	Screen(rawURL string) *Threat
+}
+
+// ScreeningRepository supports periodic re-screening of stored URLs
+type ScreeningRepository interface {
+	// ScanBatch locks up to limit active URLs not scanned since cutoff, least
+	// recently scanned first, and passes each to check. All are stamped scanned
+	// at; those check returns a threat for are deactivated with its reason and
+	// an EventURLBlocked in the same transaction. Returns the URLs scanned and
+	// those blocked.
+	ScanBatch(ctx context.Context, cutoff, at time.Time, limit int, check func(u *URL) *Threat) (int, []*URL, error)
+}
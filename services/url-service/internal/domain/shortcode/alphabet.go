@@ -0,0 +1,93 @@
+package shortcode
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Alphabet maps digits to characters for code encoding
+type Alphabet struct {
+	chars string
+	index [256]int16
+}
+
+// NewAlphabet validates and creates an alphabet of unique ASCII characters
+func NewAlphabet(chars string) (*Alphabet, error) {
+	if len(chars) < 2 {
+		return nil, fmt.Errorf("alphabet must have at least 2 characters")
+	}
+
+	a := &Alphabet{chars: chars}
+	for i := range a.index {
+		a.index[i] = -1
+	}
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		if c >= 0x80 || c <= ' ' || c == '/' || c == '?' || c == '#' || c == '%' {
+			return nil, fmt.Errorf("alphabet contains unsupported character %q", c)
+		}
+		if a.index[c] >= 0 {
+			return nil, fmt.Errorf("alphabet contains duplicate character %q", c)
+		}
+		a.index[c] = int16(i)
+	}
+	return a, nil
+}
+
+// Size returns the number of characters in the alphabet
+func (a *Alphabet) Size() int {
+	return len(a.chars)
+}
+
+// Encode encodes n, left-padded with the zero digit to at least minLength characters
+func (a *Alphabet) Encode(n uint64, minLength int) string {
+	base := uint64(len(a.chars))
+
+	buf := make([]byte, 0, 16)
+	for n > 0 {
+		buf = append(buf, a.chars[n%base])
+		n /= base
+	}
+	for len(buf) < minLength {
+		buf = append(buf, a.chars[0])
+	}
+
+	// Digits were produced least significant first
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	return string(buf)
+}
+
+// EncodeBig encodes the low digits of n into exactly length characters
+func (a *Alphabet) EncodeBig(n *big.Int, length int) string {
+	base := big.NewInt(int64(len(a.chars)))
+	rem := new(big.Int)
+	v := new(big.Int).Set(n)
+
+	buf := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		v.QuoRem(v, base, rem)
+		buf[i] = a.chars[rem.Int64()]
+	}
+	return string(buf)
+}
+
+// Decode converts a code back into its numeric value
+func (a *Alphabet) Decode(code string) (uint64, error) {
+	base := uint64(len(a.chars))
+
+	var n uint64
+	for i := 0; i < len(code); i++ {
+		d := a.index[code[i]]
+		if d < 0 {
+			return 0, fmt.Errorf("character %q not in alphabet", code[i])
+		}
+		if n > (math.MaxUint64-uint64(d))/base {
+			return 0, fmt.Errorf("code %q overflows uint64", code)
+		}
+		n = n*base + uint64(d)
+	}
+	return n, nil
+}
@@ -0,0 +1,62 @@
+package shortcode
+
+import "strings"
+
+// DefaultProfanity holds words rejected anywhere inside a code. Only words that are
+// offensive as a substring are listed, so innocent codes like "classic" still pass.
+var DefaultProfanity = []string{
+	"fuck", "shit", "cunt", "nigger", "nigga", "faggot", "wank", "twat", "bollock",
+}
+
+// leetReplacer folds digits commonly used to dodge filters back to letters
+var leetReplacer = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "_", "", "-", "")
+
+// Blocklist holds reserved codes, matched case-insensitively, and profane words
+type Blocklist struct {
+	words     map[string]struct{}
+	profanity []string
+}
+
+// NewBlocklist creates a blocklist from reserved words and profane substrings
+func NewBlocklist(words, profanity []string) *Blocklist {
+	b := &Blocklist{words: make(map[string]struct{}, len(words))}
+	for _, w := range words {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w != "" {
+			b.words[w] = struct{}{}
+		}
+	}
+	for _, w := range profanity {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w != "" {
+			b.profanity = append(b.profanity, w)
+		}
+	}
+	return b
+}
+
+// IsReserved reports whether code is blocked
+func (b *Blocklist) IsReserved(code string) bool {
+	_, ok := b.words[strings.ToLower(code)]
+	return ok
+}
+
+// IsProfane reports whether code contains a profane word, ignoring case,
+// separators and digit look-alikes
+func (b *Blocklist) IsProfane(code string) bool {
+	if len(b.profanity) == 0 {
+		return false
+	}
+	folded := leetReplacer.Replace(strings.ToLower(code))
+	for _, w := range b.profanity {
+		if strings.Contains(folded, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows reports whether code is neither reserved nor profane
+func (b *Blocklist) Allows(code string) bool {
+	return !b.IsReserved(code) && !b.IsProfane(code)
+}
@@ -0,0 +1,89 @@
+package shortcode
+
+import (
+	"hash/fnv"
+	"math"
+	"sync"
+)
+
+// BloomFilter is a concurrency-safe set of codes that answers "definitely absent"
+// or "maybe present". Codes are matched case-sensitively, like the urls table.
+type BloomFilter struct {
+	mu     sync.RWMutex
+	bits   []uint64
+	m      uint64 // Number of bits
+	k      uint64 // Number of hash functions
+	filled int    // Codes added, used to report saturation
+	limit  int    // Capacity the filter was sized for
+}
+
+// NewBloomFilter sizes a filter for capacity codes at the given false positive rate
+func NewBloomFilter(capacity int, fpRate float64) *BloomFilter {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.01
+	}
+
+	// m = -n·ln(p) / ln(2)², k = m/n · ln(2)
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	m = (m + 63) / 64 * 64
+	k := uint64(math.Round(float64(m) / float64(capacity) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &BloomFilter{
+		bits:  make([]uint64, m/64),
+		m:     m,
+		k:     k,
+		limit: capacity,
+	}
+}
+
+// Add records code as present
+func (f *BloomFilter) Add(code string) {
+	h1, h2 := bloomHashes(code)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+	f.filled++
+}
+
+// MayContain reports false only if code was never added
+func (f *BloomFilter) MayContain(code string) bool {
+	h1, h2 := bloomHashes(code)
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Saturated reports whether more codes were added than the filter was sized for,
+// after which the false positive rate climbs above the configured one
+func (f *BloomFilter) Saturated() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.filled > f.limit
+}
+
+// bloomHashes derives two independent hashes for double hashing (Kirsch–Mitzenmacher)
+func bloomHashes(code string) (uint64, uint64) {
+	h := fnv.New64a()
+	h.Write([]byte(code))
+	h1 := h.Sum64()
+	h.Write([]byte{0})
+	h2 := h.Sum64() | 1 // Odd, so every probe sequence visits distinct bits
+	return h1, h2
+}
@@ -0,0 +1,49 @@
+package shortcode
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Counter encodes values from a sequence, producing short, collision-free codes
+type Counter struct {
+	alphabet *Alphabet
+	length   int
+	seq      Sequence
+}
+
+// NewCounter creates a counter generator; codes are left-padded to length
+func NewCounter(alphabet *Alphabet, length int, seq Sequence) *Counter {
+	return &Counter{alphabet: alphabet, length: length, seq: seq}
+}
+
+// Generate encodes the next sequence value; retries simply draw the next value
+func (g *Counter) Generate(ctx context.Context, _ string, _ int) (string, error) {
+	n, err := g.seq.Next(ctx)
+	if err != nil {
+		return "", err
+	}
+	return g.alphabet.Encode(n, g.length), nil
+}
+
+// Deterministic implements Generator
+func (g *Counter) Deterministic() bool {
+	return false
+}
+
+// MemorySequence is an in-process Sequence, useful for tests and single-node setups
+type MemorySequence struct {
+	n atomic.Uint64
+}
+
+// NewMemorySequence creates a sequence whose first value is start
+func NewMemorySequence(start uint64) *MemorySequence {
+	s := &MemorySequence{}
+	s.n.Store(start)
+	return s
+}
+
+// Next implements Sequence
+func (s *MemorySequence) Next(_ context.Context) (uint64, error) {
+	return s.n.Add(1) - 1, nil
+}
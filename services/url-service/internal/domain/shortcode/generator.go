@@ -0,0 +1,199 @@
+// Package shortcode generates short codes for URLs using pluggable strategies
+package shortcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Strategy names
+const (
+	StrategyRandom  = "random"
+	StrategyCounter = "counter"
+	StrategyHash    = "hash"
+)
+
+// DefaultAlphabet is the base62 alphabet
+const DefaultAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// Defaults
+const (
+	DefaultLength      = 7
+	DefaultMaxAttempts = 5
+)
+
+// DefaultReserved holds codes that collide with service routes
+var DefaultReserved = []string{
+	"api", "admin", "health", "healthz", "metrics", "login", "logout",
+	"register", "static", "assets", "favicon.ico", "robots.txt", "www",
+}
+
+var (
+	// ErrExhausted is returned when no free code was found within the attempt budget
+	ErrExhausted = errors.New("short code attempts exhausted")
+	// ErrUnknownStrategy is returned for unsupported strategy names
+	ErrUnknownStrategy = errors.New("unknown short code strategy")
+)
+
+// Generator produces candidate short codes
+type Generator interface {
+	// Generate returns a candidate code for originalURL; attempt starts at 0
+	// and increases after every collision or reserved candidate
+	Generate(ctx context.Context, originalURL string, attempt int) (string, error)
+	// Deterministic reports whether the same input always yields the same first candidate
+	Deterministic() bool
+}
+
+// Sequence provides monotonically increasing numbers for the counter strategy
+type Sequence interface {
+	Next(ctx context.Context) (uint64, error)
+}
+
+// Options configures a generator
+type Options struct {
+	Strategy    string
+	Length      int
+	Alphabet    string
+	MaxAttempts int
+	Salt        string   // Mixed into hash codes
+	Reserved    []string // Codes never handed out
+	Profanity   []string // Words never allowed inside a code
+}
+
+// withDefaults fills unset options
+func (o Options) withDefaults() Options {
+	if o.Strategy == "" {
+		o.Strategy = StrategyRandom
+	}
+	if o.Length <= 0 {
+		o.Length = DefaultLength
+	}
+	if o.Alphabet == "" {
+		o.Alphabet = DefaultAlphabet
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = DefaultMaxAttempts
+	}
+	if o.Reserved == nil {
+		o.Reserved = DefaultReserved
+	}
+	if o.Profanity == nil {
+		o.Profanity = DefaultProfanity
+	}
+	return o
+}
+
+// New creates a generator for the configured strategy; seq is only used by the counter strategy
+func New(opts Options, seq Sequence) (Generator, error) {
+	opts = opts.withDefaults()
+
+	alphabet, err := NewAlphabet(opts.Alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	switch opts.Strategy {
+	case StrategyRandom:
+		return NewRandom(alphabet, opts.Length), nil
+	case StrategyCounter:
+		if seq == nil {
+			return nil, fmt.Errorf("counter strategy requires a sequence")
+		}
+		return NewCounter(alphabet, opts.Length, seq), nil
+	case StrategyHash:
+		return NewHash(alphabet, opts.Length, opts.Salt), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStrategy, opts.Strategy)
+	}
+}
+
+// Allocator combines a generator with the blocklist and an attempt budget.
+// Deterministic generators only have maxAttempts candidates per URL, which
+// other owners or differently configured links may already hold, so their
+// budget continues with as many random candidates.
+type Allocator struct {
+	gen         Generator
+	fallback    Generator
+	blocklist   *Blocklist
+	maxAttempts int
+}
+
+// NewAllocator creates an allocator from options
+func NewAllocator(opts Options, seq Sequence) (*Allocator, error) {
+	opts = opts.withDefaults()
+
+	gen, err := New(opts, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Allocator{
+		gen:         gen,
+		blocklist:   NewBlocklist(opts.Reserved, opts.Profanity),
+		maxAttempts: opts.MaxAttempts,
+	}
+	if gen.Deterministic() {
+		alphabet, err := NewAlphabet(opts.Alphabet)
+		if err != nil {
+			return nil, err
+		}
+		a.fallback = NewRandom(alphabet, opts.Length)
+	}
+	return a, nil
+}
+
+// Blocklist returns the reserved and profane word lists
+func (a *Allocator) Blocklist() *Blocklist {
+	return a.blocklist
+}
+
+// Deterministic reports whether the underlying generator is deterministic
+func (a *Allocator) Deterministic() bool {
+	return a.gen.Deterministic()
+}
+
+// MaxAttempts returns the number of candidates tried per code, including
+// the random fallback of deterministic generators
+func (a *Allocator) MaxAttempts() int {
+	if a.fallback != nil {
+		return 2 * a.maxAttempts
+	}
+	return a.maxAttempts
+}
+
+// Candidate returns the code to try for originalURL on the given attempt;
+// ok is false when that code is blocked and the attempt should be skipped
+func (a *Allocator) Candidate(ctx context.Context, originalURL string, attempt int) (code string, ok bool, err error) {
+	gen := a.gen
+	if attempt >= a.maxAttempts && a.fallback != nil {
+		gen = a.fallback
+	}
+	code, err = gen.Generate(ctx, originalURL, attempt)
+	if err != nil {
+		return "", false, err
+	}
+	return code, a.blocklist.Allows(code), nil
+}
+
+// Allocate generates codes until claim succeeds; claim returns taken=true on collision
+func (a *Allocator) Allocate(ctx context.Context, originalURL string, claim func(code string) (taken bool, err error)) (string, error) {
+	for attempt := 0; attempt < a.MaxAttempts(); attempt++ {
+		code, ok, err := a.Candidate(ctx, originalURL, attempt)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+
+		taken, err := claim(code)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return code, nil
+		}
+	}
+	return "", ErrExhausted
+}
@@ -0,0 +1,178 @@
+package shortcode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAlphabet(t *testing.T) *Alphabet {
+	a, err := NewAlphabet(DefaultAlphabet)
+	require.NoError(t, err)
+	return a
+}
+
+// assertCode checks that code has length characters, all from DefaultAlphabet
+func assertCode(t *testing.T, code string, length int) {
+	t.Helper()
+	assert.Len(t, code, length)
+	for _, c := range code {
+		assert.True(t, strings.ContainsRune(DefaultAlphabet, c), "unexpected %q in %q", c, code)
+	}
+}
+
+func TestRandom_Generate(t *testing.T) {
+	ctx := context.Background()
+	gen := NewRandom(newTestAlphabet(t), 7)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		code, err := gen.Generate(ctx, "https://example.com", 0)
+		require.NoError(t, err)
+		assertCode(t, code, 7)
+		seen[code] = true
+	}
+	assert.Greater(t, len(seen), 95)
+	assert.False(t, gen.Deterministic())
+}
+
+func TestRandom_RejectsBiasedBytes(t *testing.T) {
+	gen := NewRandom(newTestAlphabet(t), 3)
+	// 62*4 = 248, so bytes from 248 up would favour the first characters
+	gen.reader = bytes.NewReader([]byte{255, 248, 0, 61, 62, 0, 0, 0, 0})
+
+	code, err := gen.Generate(context.Background(), "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "0z0", code)
+}
+
+func TestCounter_Generate(t *testing.T) {
+	ctx := context.Background()
+	gen := NewCounter(newTestAlphabet(t), 4, NewMemorySequence(61))
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		code, err := gen.Generate(ctx, "https://example.com", 0)
+		require.NoError(t, err)
+		got = append(got, code)
+	}
+	assert.Equal(t, []string{"000z", "0010", "0011"}, got)
+
+	// Codes grow past the minimum length instead of wrapping
+	gen = NewCounter(newTestAlphabet(t), 2, NewMemorySequence(62*62))
+	code, err := gen.Generate(ctx, "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "100", code)
+}
+
+func TestHash_Generate(t *testing.T) {
+	ctx := context.Background()
+	gen := NewHash(newTestAlphabet(t), 7, "salt")
+
+	first, err := gen.Generate(ctx, "https://example.com/a", 0)
+	require.NoError(t, err)
+	assertCode(t, first, 7)
+	again, err := NewHash(newTestAlphabet(t), 7, "salt").Generate(ctx, "https://example.com/a", 0)
+	require.NoError(t, err)
+	assert.Equal(t, first, again)
+	assert.True(t, gen.Deterministic())
+
+	others := map[string]string{}
+	others["url"], _ = gen.Generate(ctx, "https://example.com/b", 0)
+	others["attempt"], _ = gen.Generate(ctx, "https://example.com/a", 1)
+	others["salt"], _ = NewHash(newTestAlphabet(t), 7, "pepper").Generate(ctx, "https://example.com/a", 0)
+	for name, code := range others {
+		assert.NotEqual(t, first, code, name)
+	}
+}
+
+func TestAllocator_Allocate(t *testing.T) {
+	ctx := context.Background()
+	alloc, err := NewAllocator(Options{Strategy: StrategyCounter, Length: 3}, NewMemorySequence(1))
+	require.NoError(t, err)
+
+	var tried []string
+	code, err := alloc.Allocate(ctx, "https://example.com", func(code string) (bool, error) {
+		tried = append(tried, code)
+		return len(tried) < 3, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "003", code)
+	assert.Equal(t, []string{"001", "002", "003"}, tried)
+}
+
+func TestAllocator_Allocate_Exhausted(t *testing.T) {
+	ctx := context.Background()
+	alloc, err := NewAllocator(Options{Strategy: StrategyHash, MaxAttempts: 4}, nil)
+	require.NoError(t, err)
+
+	claims := 0
+	_, err = alloc.Allocate(ctx, "https://example.com", func(string) (bool, error) {
+		claims++
+		return true, nil
+	})
+	assert.ErrorIs(t, err, ErrExhausted)
+	// Four hash candidates, then four random ones
+	assert.Equal(t, 8, claims)
+
+	// Claim errors end allocation at once
+	boom := errors.New("boom")
+	_, err = alloc.Allocate(ctx, "https://example.com", func(string) (bool, error) { return false, boom })
+	assert.ErrorIs(t, err, boom)
+}
+
+func TestAllocator_HashFallsBackToRandom(t *testing.T) {
+	ctx := context.Background()
+	alloc, err := NewAllocator(Options{Strategy: StrategyHash, MaxAttempts: 3}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 6, alloc.MaxAttempts())
+
+	var hashed []string
+	for attempt := 0; attempt < 3; attempt++ {
+		code, _, err := alloc.Candidate(ctx, "https://example.com", attempt)
+		require.NoError(t, err)
+		hashed = append(hashed, code)
+	}
+
+	// Every deterministic candidate is held elsewhere, so the next claim is random
+	tried := 0
+	code, err := alloc.Allocate(ctx, "https://example.com", func(code string) (bool, error) {
+		tried++
+		return slices.Contains(hashed, code), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, tried)
+	assert.NotContains(t, hashed, code)
+	assert.Len(t, code, DefaultLength)
+}
+
+func TestAllocator_SkipsBlockedCodes(t *testing.T) {
+	ctx := context.Background()
+	// The sequence starts at "api", then gives "apj"
+	start, err := newTestAlphabet(t).Decode("api")
+	require.NoError(t, err)
+	alloc, err := NewAllocator(Options{Strategy: StrategyCounter, Length: 3}, NewMemorySequence(start))
+	require.NoError(t, err)
+
+	var tried []string
+	code, err := alloc.Allocate(ctx, "https://example.com", func(code string) (bool, error) {
+		tried = append(tried, code)
+		return false, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "apj", code)
+	assert.Equal(t, []string{"apj"}, tried)
+}
+
+func TestNew_UnknownStrategy(t *testing.T) {
+	_, err := New(Options{Strategy: "sequential"}, nil)
+	assert.ErrorIs(t, err, ErrUnknownStrategy)
+	_, err = New(Options{Strategy: StrategyCounter}, nil)
+	assert.Error(t, err)
+}
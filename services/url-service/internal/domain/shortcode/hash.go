@@ -0,0 +1,39 @@
+package shortcode
+
+import (
+	"context"
+	"crypto/sha256"
+	"math/big"
+	"strconv"
+)
+
+// Hash derives codes from a digest of the URL so the same URL maps to the same code
+type Hash struct {
+	alphabet *Alphabet
+	length   int
+	salt     string
+}
+
+// NewHash creates a hash-of-URL generator
+func NewHash(alphabet *Alphabet, length int, salt string) *Hash {
+	return &Hash{alphabet: alphabet, length: length, salt: salt}
+}
+
+// Generate hashes the URL; each further attempt re-hashes with the attempt number
+func (g *Hash) Generate(_ context.Context, originalURL string, attempt int) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(g.salt))
+	h.Write([]byte{0})
+	h.Write([]byte(originalURL))
+	if attempt > 0 {
+		h.Write([]byte{0})
+		h.Write([]byte(strconv.Itoa(attempt)))
+	}
+
+	return g.alphabet.EncodeBig(new(big.Int).SetBytes(h.Sum(nil)), g.length), nil
+}
+
+// Deterministic implements Generator
+func (g *Hash) Deterministic() bool {
+	return true
+}
@@ -0,0 +1,49 @@
+package shortcode
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+)
+
+// Random generates uniformly distributed random codes
+type Random struct {
+	alphabet *Alphabet
+	length   int
+	reader   io.Reader
+}
+
+// NewRandom creates a random code generator backed by crypto/rand
+func NewRandom(alphabet *Alphabet, length int) *Random {
+	return &Random{alphabet: alphabet, length: length, reader: rand.Reader}
+}
+
+// Generate returns a fresh random code; attempt is ignored since every call is independent
+func (g *Random) Generate(_ context.Context, _ string, _ int) (string, error) {
+	size := g.alphabet.Size()
+	// Reject bytes above the largest multiple of size to avoid modulo bias
+	limit := 256 - 256%size
+
+	code := make([]byte, 0, g.length)
+	buf := make([]byte, g.length+g.length/2)
+	for len(code) < g.length {
+		if _, err := io.ReadFull(g.reader, buf); err != nil {
+			return "", err
+		}
+		for _, b := range buf {
+			if int(b) >= limit {
+				continue
+			}
+			code = append(code, g.alphabet.chars[int(b)%size])
+			if len(code) == g.length {
+				break
+			}
+		}
+	}
+	return string(code), nil
+}
+
+// Deterministic implements Generator
+func (g *Random) Deterministic() bool {
+	return false
+}
@@ -0,0 +1,100 @@
+package shortcode
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// collisionSampleSize is the number of codes generated per collision run
+const collisionSampleSize = 10_000_000
+
+func BenchmarkGenerate(b *testing.B) {
+	ctx := context.Background()
+
+	for _, strategy := range []string{StrategyRandom, StrategyCounter, StrategyHash} {
+		b.Run(strategy, func(b *testing.B) {
+			gen, err := New(Options{Strategy: strategy}, NewMemorySequence(1))
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := gen.Generate(ctx, fmt.Sprintf("https://example.com/%d", i), 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkCollisions generates collisionSampleSize codes per iteration and reports
+// how many were duplicates. Counter codes never collide; random and hash codes follow
+// the birthday bound n²/2·|alphabet|^length (≈14 for 10M codes of length 7).
+func BenchmarkCollisions(b *testing.B) {
+	if testing.Short() {
+		b.Skip("generates 10M codes per run")
+	}
+	ctx := context.Background()
+
+	cases := []struct {
+		strategy string
+		length   int
+	}{
+		{StrategyRandom, 6},
+		{StrategyRandom, 7},
+		{StrategyRandom, 8},
+		{StrategyHash, 7},
+		{StrategyCounter, 7},
+	}
+
+	for _, tc := range cases {
+		b.Run(fmt.Sprintf("%s/len=%d", tc.strategy, tc.length), func(b *testing.B) {
+			gen, err := New(Options{Strategy: tc.strategy, Length: tc.length}, NewMemorySequence(1))
+			if err != nil {
+				b.Fatal(err)
+			}
+			alphabet, err := NewAlphabet(DefaultAlphabet)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			var collisions int
+			values := make([]uint64, collisionSampleSize)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := range values {
+					code, err := gen.Generate(ctx, fmt.Sprintf("https://example.com/page/%d/%d", i, j), 0)
+					if err != nil {
+						b.Fatal(err)
+					}
+					// Codes up to length 10 fit in a uint64, far cheaper to dedupe than strings
+					if values[j], err = alphabet.Decode(code); err != nil {
+						b.Fatal(err)
+					}
+				}
+				collisions += countDuplicates(values)
+			}
+
+			b.ReportMetric(float64(collisions)/float64(b.N), "collisions/run")
+			b.ReportMetric(float64(collisions)/float64(b.N*collisionSampleSize), "collision-rate")
+		})
+	}
+}
+
+// countDuplicates sorts values in place and counts entries equal to their predecessor
+func countDuplicates(values []uint64) int {
+	slices.Sort(values)
+
+	var dups int
+	for i := 1; i < len(values); i++ {
+		if values[i] == values[i-1] {
+			dups++
+		}
+	}
+	return dups
+}
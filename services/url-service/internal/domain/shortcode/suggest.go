@@ -0,0 +1,217 @@
+package shortcode
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Suggestion kinds, from most to least preferred
+const (
+	SuggestHyphenated   = "hyphenated"
+	SuggestNumeric      = "numeric"
+	SuggestTitle        = "title"
+	SuggestAbbreviation = "abbreviation"
+)
+
+// kindWeights rank suggestion kinds; candidates closer in length to the requested code rank higher within a kind
+var kindWeights = map[string]int{
+	SuggestHyphenated:   400,
+	SuggestNumeric:      300,
+	SuggestTitle:        200,
+	SuggestAbbreviation: 100,
+}
+
+const (
+	maxTitleWords    = 3
+	minTitleWordLen  = 3
+	minAbbreviation  = 3
+	maxNumericSuffix = 9
+)
+
+// stopWords are URL words that make poor codes
+var stopWords = map[string]struct{}{
+	"www": {}, "com": {}, "net": {}, "org": {}, "html": {}, "htm": {}, "php": {}, "aspx": {},
+	"index": {}, "the": {}, "and": {}, "for": {}, "with": {}, "from": {}, "http": {}, "https": {},
+}
+
+// Suggestion is a ranked alternative to a taken code
+type Suggestion struct {
+	Code  string
+	Kind  string
+	Score int
+}
+
+// Suggest returns alternatives to a taken code, best first. Words of originalURL,
+// which may be empty, seed title based candidates. Candidates only use letters,
+// digits and '-', but are neither length checked nor checked for availability.
+func Suggest(code, originalURL string) []Suggestion {
+	s := suggester{code: code, seen: map[string]struct{}{strings.ToLower(code): {}}}
+
+	words := splitWords(code)
+	if len(words) > 1 {
+		s.add(strings.Join(words, "-"), SuggestHyphenated, 0)
+		s.add(strings.Join(words, ""), SuggestHyphenated, 0)
+	}
+
+	base := strings.Join(words, "")
+	for n := 2; n <= maxNumericSuffix; n++ {
+		s.add(base+strconv.Itoa(n), SuggestNumeric, n)
+	}
+
+	title := titleWords(originalURL)
+	for _, w := range title {
+		s.add(strings.Join(words, "-")+"-"+w, SuggestTitle, 0)
+	}
+	if len(title) > 1 {
+		s.add(strings.Join(title[:2], "-"), SuggestTitle, 0)
+	}
+
+	if len(words) > 1 {
+		var initials strings.Builder
+		for _, w := range words {
+			initials.WriteString(w[:1])
+		}
+		s.add(initials.String(), SuggestAbbreviation, 0)
+	}
+	s.add(dropVowels(base), SuggestAbbreviation, 0)
+
+	sort.SliceStable(s.out, func(i, j int) bool {
+		return s.out[i].Score > s.out[j].Score
+	})
+	return s.out
+}
+
+type suggester struct {
+	code string
+	seen map[string]struct{}
+	out  []Suggestion
+}
+
+// add records a candidate once; penalty lowers its rank within its kind
+func (s *suggester) add(candidate, kind string, penalty int) {
+	if candidate == "" {
+		return
+	}
+	key := strings.ToLower(candidate)
+	if _, ok := s.seen[key]; ok {
+		return
+	}
+	s.seen[key] = struct{}{}
+
+	distance := len(candidate) - len(s.code)
+	if distance < 0 {
+		distance = -distance
+	}
+	s.out = append(s.out, Suggestion{
+		Code:  candidate,
+		Kind:  kind,
+		Score: kindWeights[kind] - penalty - distance,
+	})
+}
+
+// splitWords splits a code on separators, case changes and letter/digit boundaries
+func splitWords(s string) []string {
+	var (
+		words []string
+		cur   []rune
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = cur[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if len(cur) > 0 {
+			prev := runes[i-1]
+			switch {
+			case unicode.IsLower(prev) && unicode.IsUpper(r),
+				unicode.IsLetter(prev) != unicode.IsLetter(r):
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return words
+}
+
+// titleWords extracts meaningful lowercase words from a URL's host and path
+func titleWords(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil
+	}
+
+	var parts []string
+	labels := strings.Split(u.Hostname(), ".")
+	if len(labels) > 1 {
+		labels = labels[:len(labels)-1] // Drop the TLD
+	}
+	// Path words describe the page better than the host, so they come first
+	parts = append(parts, strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })...)
+	parts = append(parts, labels...)
+
+	var words []string
+	seen := make(map[string]struct{})
+	for _, part := range parts {
+		for _, w := range splitWords(part) {
+			w = strings.ToLower(w)
+			if len(w) < minTitleWordLen || !isASCIIAlpha(w) {
+				continue
+			}
+			if _, ok := stopWords[w]; ok {
+				continue
+			}
+			if _, ok := seen[w]; ok {
+				continue
+			}
+			seen[w] = struct{}{}
+			words = append(words, w)
+			if len(words) == maxTitleWords {
+				return words
+			}
+		}
+	}
+	return words
+}
+
+// dropVowels keeps the first letter and every consonant or digit after it
+func dropVowels(s string) string {
+	if len(s) <= minAbbreviation {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte(s[0])
+	for _, r := range s[1:] {
+		if !strings.ContainsRune("aeiouAEIOU", r) {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() < minAbbreviation || b.Len() == len(s) {
+		return ""
+	}
+	return b.String()
+}
+
+func isASCIIAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
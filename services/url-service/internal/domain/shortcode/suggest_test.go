@@ -0,0 +1,98 @@
+package shortcode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func codes(suggestions []Suggestion) []string {
+	out := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		out = append(out, s.Code)
+	}
+	return out
+}
+
+func TestSuggest_RanksKinds(t *testing.T) {
+	got := Suggest("SummerSale", "https://shop.example.com/deals/black-friday")
+	require.NotEmpty(t, got)
+
+	assert.Equal(t, "Summer-Sale", got[0].Code)
+	assert.Equal(t, SuggestHyphenated, got[0].Kind)
+	assert.Contains(t, codes(got), "SummerSale2")
+	assert.Contains(t, codes(got), "Summer-Sale-deals")
+	assert.Contains(t, codes(got), "SS")
+	assert.Contains(t, codes(got), "SmmrSl")
+
+	for i := 1; i < len(got); i++ {
+		assert.GreaterOrEqual(t, got[i-1].Score, got[i].Score)
+	}
+}
+
+func TestSuggest_SkipsOriginalAndDuplicates(t *testing.T) {
+	got := codes(Suggest("promo", ""))
+
+	assert.NotContains(t, got, "promo")
+	seen := make(map[string]bool)
+	for _, c := range got {
+		assert.False(t, seen[c], "duplicate %q", c)
+		seen[c] = true
+	}
+}
+
+func TestTitleWords(t *testing.T) {
+	assert.Equal(t, []string{"blog", "launch", "example"},
+		titleWords("https://www.example.com/blog/2024/launch.html"))
+	assert.Empty(t, titleWords(""))
+}
+
+func TestBlocklist_IsProfane(t *testing.T) {
+	b := NewBlocklist(nil, DefaultProfanity)
+
+	assert.True(t, b.IsProfane("sh1t-happens"))
+	assert.True(t, b.IsProfane("F_U_C_K"))
+	assert.False(t, b.IsProfane("classic-cocktails"))
+}
+
+func TestBlocklist_IsProfane_LeetSpeak(t *testing.T) {
+	b := NewBlocklist(nil, []string{"ass", " Shit "})
+
+	for _, code := range []string{"4ss", "a55", "BadAss", "s-h-1-t", "x_4_s_s"} {
+		assert.True(t, b.IsProfane(code), code)
+	}
+	for _, code := range []string{"as", "a_s", "shirt", "abc123"} {
+		assert.False(t, b.IsProfane(code), code)
+	}
+}
+
+func TestBlocklist_Allows(t *testing.T) {
+	b := NewBlocklist(DefaultReserved, DefaultProfanity)
+
+	assert.False(t, b.Allows("API"))
+	assert.False(t, b.Allows("robots.txt"))
+	assert.False(t, b.Allows("xFuCkx"))
+	assert.True(t, b.Allows("apis"))
+}
+
+func TestBloomFilter(t *testing.T) {
+	f := NewBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.Add(fmt.Sprintf("code%d", i))
+	}
+
+	for i := 0; i < 1000; i++ {
+		require.True(t, f.MayContain(fmt.Sprintf("code%d", i)))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if f.MayContain(fmt.Sprintf("free%d", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300, "false positive rate far above 1%%")
+	assert.False(t, f.Saturated())
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Domain errors returned by repositories
+var (
+	ErrURLNotFound = errors.New("url not found")
+	ErrCodeTaken   = errors.New("short code already taken")
+
+	// ErrClickLimitReached is returned by IncrementClick once a URL has used up its clicks
+	ErrClickLimitReached = errors.New("click limit reached")
+	// ErrURLModified is returned by Update when the URL changed since it was loaded
+	ErrURLModified = errors.New("url was modified concurrently")
+)
+
+// URL is a shortened link
+type URL struct {
+	ID           string // Short code, qualified by hostname on custom domains, see QualifiedID
+	OriginalURL  string
+	UserID       string // Empty if anonymous
+	Title        string
+	Description  string
+	PasswordHash string // Empty if not password protected
+	Tags         []string
+
+	IsActive      bool
+	IsCustom      bool
+	BlockedReason string     // Why screening deactivated the URL, empty unless flagged
+	ScannedAt     *time.Time // Last destination screening, nil if never screened
+
+	ClickCount   int64
+	LastAccessed *time.Time
+	MaxClicks    int64 // Clicks allowed before the URL stops resolving, 0 means unlimited
+
+	Rules    []RedirectRule // Evaluated in order before falling back to OriginalURL
+	Variants []Variant      // Split visitors no rule matches, instead of OriginalURL
+
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ActivatesAt *time.Time // Nil if the URL resolves from creation
+	ExpiresAt   *time.Time
+}
+
+// IsExpired reports whether the URL has passed its expiration time
+func (u *URL) IsExpired(now time.Time) bool {
+	return u.ExpiresAt != nil && !now.Before(*u.ExpiresAt)
+}
+
+// IsPending reports whether the URL is scheduled and not yet activated
+func (u *URL) IsPending(now time.Time) bool {
+	return u.ActivatesAt != nil && now.Before(*u.ActivatesAt)
+}
+
+// IsExhausted reports whether the URL has used up its click limit
+func (u *URL) IsExhausted() bool {
+	return u.MaxClicks > 0 && u.ClickCount >= u.MaxClicks
+}
+
+// IsBlocked reports whether screening flagged the destination as unsafe
+func (u *URL) IsBlocked() bool {
+	return u.BlockedReason != ""
+}
+
+// Destinations returns every URL the link may redirect to
+func (u *URL) Destinations() []string {
+	dests := make([]string, 0, 1+len(u.Variants)+len(u.Rules))
+	dests = append(dests, u.OriginalURL)
+	for _, v := range u.Variants {
+		dests = append(dests, v.DestinationURL)
+	}
+	for _, r := range u.Rules {
+		dests = append(dests, r.DestinationURL)
+	}
+	return dests
+}
+
+// IsPasswordProtected reports whether a password is required to resolve the URL
+func (u *URL) IsPasswordProtected() bool {
+	return u.PasswordHash != ""
+}
+
+// Code returns the short code without any custom domain
+func (u *URL) Code() string {
+	_, code := SplitID(u.ID)
+	return code
+}
+
+// Domain returns the custom domain of the URL, empty for the default domain
+func (u *URL) Domain() string {
+	host, _ := SplitID(u.ID)
+	return host
+}
+
+// IsOwnedBy reports whether the URL belongs to the given user
+func (u *URL) IsOwnedBy(userID string) bool {
+	return u.UserID != "" && u.UserID == userID
+}
+
+// Fields URLs can be sorted by
+const (
+	SortCreatedAt    = "created_at"
+	SortClickCount   = "click_count"
+	SortLastAccessed = "last_accessed"
+	SortTitle        = "title"
+	SortRelevance    = "relevance" // Search rank, only meaningful with a search query
+)
+
+// ListFilter holds criteria for listing URLs
+type ListFilter struct {
+	UserID        string
+	ActiveOnly    bool
+	Search        string     // Full-text query over title, tags, description and destination
+	Tags          []string   // Normalized tags to filter by
+	MatchAllTags  bool       // Require every tag instead of any
+	ActivatesFrom *time.Time // Only URLs activating at or after this time
+	ActivatesTo   *time.Time // Only URLs activating at or before this time
+	SortBy        string     // One of the Sort constants
+	Desc          bool
+	After         *ListKey // Keyset position; Offset is ignored when set
+	SkipCount     bool     // The caller already knows the total
+	Offset        int
+	Limit         int
+}
+
+// ListKey is a keyset position: the sort value of the last URL seen and its ID
+type ListKey struct {
+	Value *string // Nil when the URL has no value for the sort field, e.g. never accessed
+	ID    string
+}
+
+// BulkWriter inserts URLs inside a transaction opened by URLRepository.Bulk
+type BulkWriter interface {
+	// InsertMany inserts urls, skipping any whose ID is in use, and returns the
+	// indexes of the skipped ones
+	InsertMany(ctx context.Context, urls []*URL) ([]int, error)
+}
+
+// URLRepository persists URLs
+type URLRepository interface {
+	// Create stores a new URL, returns ErrCodeTaken if the ID is in use
+	Create(ctx context.Context, url *URL) error
+	// GetByID returns the URL or ErrURLNotFound
+	GetByID(ctx context.Context, id string) (*URL, error)
+	// Update stores mutable fields of an existing URL if it was not written since
+	// it was loaded, that is its stored UpdatedAt still equals loadedAt; returns
+	// ErrURLModified otherwise and ErrURLNotFound if it is gone. A non-nil rev is
+	// recorded in the same transaction, after which revisions of the URL beyond
+	// the newest keep are pruned.
+	Update(ctx context.Context, url *URL, loadedAt time.Time, rev *URLRevision, keep int) error
+	// Delete removes a URL, returns ErrURLNotFound if missing
+	Delete(ctx context.Context, id string) error
+	// List returns a page of URLs and the total number of matches
+	List(ctx context.Context, filter ListFilter) ([]*URL, int64, error)
+	// Exists reports whether a short code is in use
+	Exists(ctx context.Context, id string) (bool, error)
+	// ForEachID streams every short code in use to fn, stopping at its first error
+	ForEachID(ctx context.Context, fn func(id string) error) error
+	// Bulk runs fn in a transaction, committing if it returns nil
+	Bulk(ctx context.Context, fn func(w BulkWriter) error) error
+	// ListRevisions returns the recorded revisions of a URL, newest first
+	ListRevisions(ctx context.Context, id string) ([]*URLRevision, error)
+	// ReplacePasswordHash swaps the password hash if it still equals oldHash and
+	// stamps the URL updated at, returns ErrURLNotFound if the URL is gone or
+	// its password changed
+	ReplacePasswordHash(ctx context.Context, id, oldHash, newHash string, at time.Time) error
+
+	// UpdateRules replaces the redirect rules of a URL with those fn returns for
+	// the current ones and stamps the URL updated at. The URL is locked while fn
+	// runs, so concurrent edits are not lost. Returns ErrURLNotFound if missing.
+	UpdateRules(ctx context.Context, id string, at time.Time, fn func(rules []RedirectRule) ([]RedirectRule, error)) ([]RedirectRule, error)
+
+	// IncrementClick bumps the click counter and returns the new count. It fails
+	// with ErrClickLimitReached, without counting, once MaxClicks is used up.
+	IncrementClick(ctx context.Context, id string, at time.Time) (int64, error)
+	// Ping checks repository connectivity
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,9 @@
+package domain
+
+// UserContext identifies the authenticated caller, as vouched for by the gateway
+type UserContext struct {
+	UserID    string
+	Email     string
+	Roles     []string
+	IsPremium bool
+}
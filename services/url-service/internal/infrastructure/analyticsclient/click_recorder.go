@@ -0,0 +1,37 @@
+package analyticsclient
+
+import (
+	"context"
+	"time"
+
+	analyticspb "github.com/url-shortener-microservices/proto/gen/analytics"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ClickRecorder implements domain.ClickRecorder with the analytics service
+type ClickRecorder struct {
+	client  analyticspb.AnalyticsServiceClient
+	timeout time.Duration
+}
+
+// NewClickRecorder creates a click recorder backed by the analytics service
+func NewClickRecorder(client analyticspb.AnalyticsServiceClient, timeout time.Duration) *ClickRecorder {
+	return &ClickRecorder{client: client, timeout: timeout}
+}
+
+// RecordClick implements domain.ClickRecorder
+func (r *ClickRecorder) RecordClick(ctx context.Context, click domain.Click) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.client.RecordClick(ctx, &analyticspb.RecordClickRequest{
+		UrlId:     click.URLID,
+		SessionId: click.SessionID,
+		IpAddress: click.ClientIP,
+		UserAgent: click.UserAgent,
+		Referrer:  click.Referrer,
+		Variant:   click.Variant,
+		Source:    click.Source,
+	})
+	return err
+}
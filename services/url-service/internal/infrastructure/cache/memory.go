@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-process Store, used when Redis is unavailable and in tests.
+// Deletes only reach this process, so with several replicas the others keep
+// serving an updated or deleted URL until its entry expires.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	entries    map[string]memoryEntry
+	maxEntries int
+	now        func() time.Time
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates an in-memory store holding at most maxEntries keys (0 = unbounded)
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		entries:    make(map[string]memoryEntry),
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+}
+
+// Get implements Store
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.RLock()
+	e, ok := s.entries[key]
+	s.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrMiss
+	}
+	if !e.expiresAt.IsZero() && !s.now().Before(e.expiresAt) {
+		s.mu.Lock()
+		if cur, ok := s.entries[key]; ok && cur.expiresAt.Equal(e.expiresAt) {
+			delete(s.entries, key)
+		}
+		s.mu.Unlock()
+		return nil, ErrMiss
+	}
+
+	out := make([]byte, len(e.value))
+	copy(out, e.value)
+	return out, nil
+}
+
+// Set implements Store
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	e := memoryEntry{value: make([]byte, len(value))}
+	copy(e.value, value)
+	if ttl > 0 {
+		e.expiresAt = s.now().Add(ttl)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.entries[key]; !exists && s.maxEntries > 0 && len(s.entries) >= s.maxEntries {
+		s.evictLocked()
+	}
+	s.entries[key] = e
+	return nil
+}
+
+// Delete implements Store
+func (s *MemoryStore) Delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+// Len returns the number of stored entries, including expired ones not yet evicted
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.entries)
+}
+
+// evictLocked drops expired entries, or an arbitrary one if none have expired
+func (s *MemoryStore) evictLocked() {
+	now := s.now()
+	evicted := false
+	for key, e := range s.entries {
+		if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+			delete(s.entries, key)
+			evicted = true
+		}
+	}
+	if evicted {
+		return
+	}
+	for key := range s.entries {
+		delete(s.entries, key)
+		return
+	}
+}
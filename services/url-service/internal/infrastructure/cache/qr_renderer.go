@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// QRRenderer caches rendered QR codes in front of a domain.QRRenderer. Images
+// depend only on their content and options, so entries are never invalidated.
+type QRRenderer struct {
+	domain.QRRenderer
+
+	store     Store
+	keyPrefix string
+	ttl       time.Duration
+	log       *zap.Logger
+	group     singleflight.Group
+}
+
+// NewQRRenderer wraps renderer with a cache keeping images for ttl
+func NewQRRenderer(renderer domain.QRRenderer, store Store, keyPrefix string, ttl time.Duration, log *zap.Logger) *QRRenderer {
+	if keyPrefix == "" {
+		keyPrefix = "qr:"
+	}
+	return &QRRenderer{
+		QRRenderer: renderer,
+		store:      store,
+		keyPrefix:  keyPrefix,
+		ttl:        ttl,
+		log:        log,
+	}
+}
+
+// Render returns a cached image, rendering it once per key on a miss
+func (r *QRRenderer) Render(ctx context.Context, content string, opts domain.QROptions) ([]byte, error) {
+	key := r.key(content, opts)
+
+	data, err := r.store.Get(ctx, key)
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, ErrMiss) {
+		r.log.Warn("cache get failed", zap.String("key", key), zap.Error(err))
+	}
+
+	v, err, _ := r.group.Do(key, func() (interface{}, error) {
+		data, err := r.QRRenderer.Render(ctx, content, opts)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.store.Set(context.WithoutCancel(ctx), key, data, r.ttl); err != nil {
+			r.log.Warn("cache set failed", zap.String("key", key), zap.Error(err))
+		}
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// key hashes content and options, content may be long and contain any byte
+func (r *QRRenderer) key(content string, opts domain.QROptions) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%d\x00%s\x00%x\x00%x\x00%t",
+		content, opts.Format, opts.Size, opts.Level, opts.Foreground, opts.Background, opts.Logo)
+	return r.keyPrefix + hex.EncodeToString(h.Sum(nil))
+}
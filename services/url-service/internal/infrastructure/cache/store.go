@@ -0,0 +1,18 @@
+// Package cache provides key-value stores and a read-through URL repository
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrMiss is returned by Store.Get when the key is absent or expired
+var ErrMiss = errors.New("cache miss")
+
+// Store is a byte-oriented key-value cache with per-key TTL
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
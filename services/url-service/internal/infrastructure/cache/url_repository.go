@@ -0,0 +1,278 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hash/maphash"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// loadTimeout bounds a shared database load, independent of the first caller's deadline
+const loadTimeout = 5 * time.Second
+
+// generationStripes is the number of invalidation counters keys are spread over
+const generationStripes = 256
+
+// Options configures the cached repository
+type Options struct {
+	KeyPrefix   string
+	TTL         time.Duration // Lifetime of cached URLs
+	NegativeTTL time.Duration // Lifetime of "not found" markers
+}
+
+// URLRepository is a read-through cache in front of a domain.URLRepository.
+// Cache failures are logged and fall through to the underlying repository.
+// Cached click counts may lag by up to TTL since clicks do not invalidate.
+//
+// A load that read a row before a write invalidated it must not cache that
+// row afterwards. Every invalidation bumps a generation counter for its key,
+// and loads only keep what they cached while the counter stayed the same.
+// Keys share counters by hash so memory stays fixed; a shared bump only
+// costs an extra miss.
+type URLRepository struct {
+	domain.URLRepository
+
+	store Store
+	opts  Options
+	log   *zap.Logger
+	group singleflight.Group
+	seed  maphash.Seed
+	gens  [generationStripes]atomic.Uint64
+}
+
+// cachedURL is the cache encoding; Missing marks a negative entry
+type cachedURL struct {
+	Missing bool        `json:"missing,omitempty"`
+	URL     *domain.URL `json:"url,omitempty"`
+}
+
+// NewURLRepository wraps repo with a cache
+func NewURLRepository(repo domain.URLRepository, store Store, opts Options, log *zap.Logger) *URLRepository {
+	if opts.KeyPrefix == "" {
+		opts.KeyPrefix = "url:"
+	}
+	return &URLRepository{
+		URLRepository: repo,
+		store:         store,
+		opts:          opts,
+		log:           log,
+		seed:          maphash.MakeSeed(),
+	}
+}
+
+// GetByID returns the URL from cache, loading it once per key on a miss
+func (r *URLRepository) GetByID(ctx context.Context, id string) (*domain.URL, error) {
+	key := r.key(id)
+
+	if entry, ok := r.get(ctx, key); ok {
+		if entry.Missing {
+			return nil, domain.ErrURLNotFound
+		}
+		return entry.URL, nil
+	}
+
+	// Concurrent misses for the same code share one database load
+	ch := r.group.DoChan(key, func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		gen := r.generation(key)
+		u, err := r.URLRepository.GetByID(loadCtx, id)
+		switch {
+		case errors.Is(err, domain.ErrURLNotFound):
+			r.fill(loadCtx, key, gen, cachedURL{Missing: true}, r.opts.NegativeTTL)
+		case err == nil:
+			r.fill(loadCtx, key, gen, cachedURL{URL: u}, r.opts.TTL)
+		}
+		return u, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		// Callers may mutate the result, so each gets its own copy
+		return copyURL(res.Val.(*domain.URL)), nil
+	}
+}
+
+// Create stores a URL and drops any negative entry for its code
+func (r *URLRepository) Create(ctx context.Context, u *domain.URL) error {
+	if err := r.URLRepository.Create(ctx, u); err != nil {
+		return err
+	}
+	r.invalidate(ctx, u.ID)
+	return nil
+}
+
+// Update stores a URL and invalidates its cache entry, also when the URL was
+// modified concurrently so the caller reloads it fresh
+func (r *URLRepository) Update(ctx context.Context, u *domain.URL, loadedAt time.Time, rev *domain.URLRevision, keep int) error {
+	err := r.URLRepository.Update(ctx, u, loadedAt, rev, keep)
+	r.invalidate(ctx, u.ID)
+	return err
+}
+
+// Delete removes a URL and invalidates its cache entry
+func (r *URLRepository) Delete(ctx context.Context, id string) error {
+	err := r.URLRepository.Delete(ctx, id)
+	r.invalidate(ctx, id)
+	return err
+}
+
+// ReplacePasswordHash swaps the password hash and invalidates the cache entry
+func (r *URLRepository) ReplacePasswordHash(ctx context.Context, id, oldHash, newHash string, at time.Time) error {
+	err := r.URLRepository.ReplacePasswordHash(ctx, id, oldHash, newHash, at)
+	r.invalidate(ctx, id)
+	return err
+}
+
+// UpdateRules replaces a URL's redirect rules and invalidates its cache entry
+func (r *URLRepository) UpdateRules(ctx context.Context, id string, at time.Time,
+	fn func(rules []domain.RedirectRule) ([]domain.RedirectRule, error)) ([]domain.RedirectRule, error) {
+	rules, err := r.URLRepository.UpdateRules(ctx, id, at, fn)
+	r.invalidate(ctx, id)
+	return rules, err
+}
+
+// IncrementClick counts a click and, once a limited URL is used up, drops its
+// cache entry so readers stop seeing it as resolvable
+func (r *URLRepository) IncrementClick(ctx context.Context, id string, at time.Time) (int64, error) {
+	count, err := r.URLRepository.IncrementClick(ctx, id, at)
+	if errors.Is(err, domain.ErrClickLimitReached) {
+		r.invalidate(ctx, id)
+	}
+	return count, err
+}
+
+// Bulk runs fn in a transaction and, once it commits, drops negative entries for
+// the inserted codes
+func (r *URLRepository) Bulk(ctx context.Context, fn func(w domain.BulkWriter) error) error {
+	var inserted []string
+	err := r.URLRepository.Bulk(ctx, func(w domain.BulkWriter) error {
+		inserted = inserted[:0]
+		return fn(&trackingWriter{BulkWriter: w, inserted: &inserted})
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range inserted {
+		r.invalidate(ctx, id)
+	}
+	return nil
+}
+
+// trackingWriter records the codes a domain.BulkWriter inserted
+type trackingWriter struct {
+	domain.BulkWriter
+	inserted *[]string
+}
+
+func (w *trackingWriter) InsertMany(ctx context.Context, urls []*domain.URL) ([]int, error) {
+	skipped, err := w.BulkWriter.InsertMany(ctx, urls)
+	if err != nil {
+		return nil, err
+	}
+	next := 0
+	for i, u := range urls {
+		if next < len(skipped) && skipped[next] == i {
+			next++
+			continue
+		}
+		*w.inserted = append(*w.inserted, u.ID)
+	}
+	return skipped, nil
+}
+
+// Invalidate drops the cache entry for a code
+func (r *URLRepository) Invalidate(ctx context.Context, id string) {
+	r.invalidate(ctx, id)
+}
+
+func (r *URLRepository) key(id string) string {
+	return r.opts.KeyPrefix + id
+}
+
+func (r *URLRepository) get(ctx context.Context, key string) (cachedURL, bool) {
+	var entry cachedURL
+
+	data, err := r.store.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, ErrMiss) {
+			r.log.Warn("cache get failed", zap.String("key", key), zap.Error(err))
+		}
+		return entry, false
+	}
+	if err := json.Unmarshal(data, &entry); err != nil || (!entry.Missing && entry.URL == nil) {
+		r.log.Warn("dropping corrupt cache entry", zap.String("key", key), zap.Error(err))
+		r.store.Delete(ctx, key)
+		return entry, false
+	}
+	return entry, true
+}
+
+func (r *URLRepository) set(ctx context.Context, key string, entry cachedURL, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		r.log.Warn("cache encode failed", zap.String("key", key), zap.Error(err))
+		return
+	}
+	if err := r.store.Set(ctx, key, data, ttl); err != nil {
+		r.log.Warn("cache set failed", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// fill caches a loaded entry unless key was invalidated since generation gen
+// was read. An invalidation racing with the write is caught by the second
+// check, which drops the entry again.
+func (r *URLRepository) fill(ctx context.Context, key string, gen uint64, entry cachedURL, ttl time.Duration) {
+	if r.generation(key) != gen {
+		return
+	}
+	r.set(ctx, key, entry, ttl)
+	if r.generation(key) != gen {
+		if err := r.store.Delete(ctx, key); err != nil {
+			r.log.Warn("cache invalidate failed", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+func (r *URLRepository) counter(key string) *atomic.Uint64 {
+	return &r.gens[maphash.String(r.seed, key)%generationStripes]
+}
+
+func (r *URLRepository) generation(key string) uint64 {
+	return r.counter(key).Load()
+}
+
+func (r *URLRepository) invalidate(ctx context.Context, id string) {
+	key := r.key(id)
+	// Bump the generation before deleting so in-flight loads cannot refill the
+	// entry, and forget them so later readers start a fresh one
+	r.counter(key).Add(1)
+	r.group.Forget(key)
+	if err := r.store.Delete(context.WithoutCancel(ctx), key); err != nil {
+		r.log.Warn("cache invalidate failed", zap.String("key", key), zap.Error(err))
+	}
+}
+
+func copyURL(u *domain.URL) *domain.URL {
+	c := *u
+	c.Tags = append([]string(nil), u.Tags...)
+	c.Rules = append([]domain.RedirectRule(nil), u.Rules...)
+	c.Variants = append([]domain.Variant(nil), u.Variants...)
+	return &c
+}
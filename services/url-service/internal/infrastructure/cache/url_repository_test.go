@@ -0,0 +1,188 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// countingRepo is a minimal domain.URLRepository that counts GetByID calls
+type countingRepo struct {
+	domain.URLRepository
+
+	mu    sync.Mutex
+	urls  map[string]*domain.URL
+	gets  atomic.Int32
+	delay time.Duration
+	// read, when set, runs after GetByID has read the row
+	read func()
+}
+
+func newCountingRepo() *countingRepo {
+	return &countingRepo{urls: make(map[string]*domain.URL)}
+}
+
+func (r *countingRepo) GetByID(_ context.Context, id string) (*domain.URL, error) {
+	r.gets.Add(1)
+	time.Sleep(r.delay)
+
+	r.mu.Lock()
+	u, ok := r.urls[id]
+	var c domain.URL
+	if ok {
+		c = *u
+	}
+	read := r.read
+	r.mu.Unlock()
+
+	if read != nil {
+		read()
+	}
+	if !ok {
+		return nil, domain.ErrURLNotFound
+	}
+	return &c, nil
+}
+
+func (r *countingRepo) Create(_ context.Context, u *domain.URL) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.urls[u.ID] = u
+	return nil
+}
+
+func (r *countingRepo) Update(ctx context.Context, u *domain.URL, _ time.Time, _ *domain.URLRevision, _ int) error {
+	return r.Create(ctx, u)
+}
+
+func newTestRepo(inner domain.URLRepository) *URLRepository {
+	return NewURLRepository(inner, NewMemoryStore(0), Options{TTL: time.Minute, NegativeTTL: time.Minute}, zap.NewNop())
+}
+
+func TestURLRepository_ReadThrough(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingRepo()
+	require.NoError(t, inner.Create(ctx, &domain.URL{ID: "abc", OriginalURL: "https://example.com"}))
+	repo := newTestRepo(inner)
+
+	for i := 0; i < 3; i++ {
+		u, err := repo.GetByID(ctx, "abc")
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com", u.OriginalURL)
+	}
+	assert.Equal(t, int32(1), inner.gets.Load())
+}
+
+func TestURLRepository_NegativeCache(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingRepo()
+	repo := newTestRepo(inner)
+
+	for i := 0; i < 3; i++ {
+		_, err := repo.GetByID(ctx, "missing")
+		assert.ErrorIs(t, err, domain.ErrURLNotFound)
+	}
+	assert.Equal(t, int32(1), inner.gets.Load())
+
+	// Creating the code must clear the negative entry
+	require.NoError(t, repo.Create(ctx, &domain.URL{ID: "missing", OriginalURL: "https://example.com"}))
+	u, err := repo.GetByID(ctx, "missing")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com", u.OriginalURL)
+}
+
+func TestURLRepository_UpdateInvalidates(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingRepo()
+	require.NoError(t, inner.Create(ctx, &domain.URL{ID: "abc", Title: "old"}))
+	repo := newTestRepo(inner)
+
+	_, err := repo.GetByID(ctx, "abc")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Update(ctx, &domain.URL{ID: "abc", Title: "new"}, time.Time{}, nil, 0))
+	u, err := repo.GetByID(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "new", u.Title)
+	assert.Equal(t, int32(2), inner.gets.Load())
+}
+
+func TestURLRepository_SlowLoadDoesNotOutliveUpdate(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingRepo()
+	require.NoError(t, inner.Create(ctx, &domain.URL{ID: "abc", OriginalURL: "https://old.example.com"}))
+	repo := newTestRepo(inner)
+
+	// The first load reads the old row, then stalls until the update is done
+	loaded := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	inner.read = func() {
+		once.Do(func() {
+			close(loaded)
+			<-release
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		u, err := repo.GetByID(ctx, "abc")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://old.example.com", u.OriginalURL)
+	}()
+
+	<-loaded
+	require.NoError(t, repo.Update(ctx, &domain.URL{ID: "abc", OriginalURL: "https://new.example.com"}, time.Time{}, nil, 0))
+	close(release)
+	<-done
+
+	u, err := repo.GetByID(ctx, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "https://new.example.com", u.OriginalURL, "the stale load must not be cached")
+}
+
+func TestURLRepository_SingleFlight(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingRepo()
+	inner.delay = 50 * time.Millisecond
+	require.NoError(t, inner.Create(ctx, &domain.URL{ID: "hot"}))
+	repo := newTestRepo(inner)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.GetByID(ctx, "hot")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), inner.gets.Load())
+}
+
+func TestMemoryStore_Expiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(0)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Set(ctx, "k", []byte("v"), time.Second))
+	v, err := store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v"), v)
+
+	now = now.Add(2 * time.Second)
+	_, err = store.Get(ctx, "k")
+	assert.ErrorIs(t, err, ErrMiss)
+}
@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// LogPublisher implements domain.EventPublisher by logging events, for setups
+// without Redis where nothing consumes them
+type LogPublisher struct {
+	log *zap.Logger
+}
+
+// NewLogPublisher creates a publisher that logs events
+func NewLogPublisher(log *zap.Logger) *LogPublisher {
+	return &LogPublisher{log: log}
+}
+
+// Publish implements domain.EventPublisher
+func (p *LogPublisher) Publish(_ context.Context, event domain.Event) error {
+	p.log.Info("domain event",
+		zap.Int64("event_id", event.ID),
+		zap.String("type", event.Type),
+		zap.String("aggregate_id", event.AggregateID),
+		zap.Time("occurred_at", event.OccurredAt),
+		zap.ByteString("payload", event.Payload),
+	)
+	return nil
+}
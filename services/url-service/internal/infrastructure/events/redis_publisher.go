@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// RedisPublisher implements domain.EventPublisher by appending events to a Redis
+// stream, which consumers read with consumer groups
+type RedisPublisher struct {
+	client redis.UniversalClient
+	stream string
+	maxLen int64
+}
+
+// NewRedisPublisher creates a publisher for stream, trimmed to about maxLen
+// entries; 0 keeps every entry
+func NewRedisPublisher(client redis.UniversalClient, stream string, maxLen int64) *RedisPublisher {
+	return &RedisPublisher{client: client, stream: stream, maxLen: maxLen}
+}
+
+// Publish implements domain.EventPublisher. Delivery is at least once, so the
+// event ID is sent along for consumers to drop duplicates.
+func (p *RedisPublisher) Publish(ctx context.Context, event domain.Event) error {
+	err := p.client.XAdd(ctx, p.args(event)).Err()
+	if err != nil {
+		return fmt.Errorf("publish event %d: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (p *RedisPublisher) args(event domain.Event) *redis.XAddArgs {
+	return &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: p.maxLen > 0,
+		Values: []any{
+			"event_id", strconv.FormatInt(event.ID, 10),
+			"type", event.Type,
+			"aggregate_id", event.AggregateID,
+			"occurred_at", event.OccurredAt.UTC().Format(time.RFC3339Nano),
+			"payload", string(event.Payload),
+		},
+	}
+}
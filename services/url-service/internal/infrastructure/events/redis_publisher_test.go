@@ -0,0 +1,41 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestRedisPublisher_Args(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+	event := domain.Event{ID: 42, Type: domain.EventURLExpired, AggregateID: "abc", Payload: []byte(`{"url_id":"abc"}`), OccurredAt: at}
+
+	args := NewRedisPublisher(nil, "url-events", 1000).args(event)
+	assert.Equal(t, "url-events", args.Stream)
+	assert.EqualValues(t, 1000, args.MaxLen)
+	assert.True(t, args.Approx)
+	assert.Equal(t, []any{
+		"event_id", "42",
+		"type", "url.expired",
+		"aggregate_id", "abc",
+		"occurred_at", "2026-03-01T11:00:00Z",
+		"payload", `{"url_id":"abc"}`,
+	}, args.Values)
+
+	args = NewRedisPublisher(nil, "url-events", 0).args(event)
+	assert.Zero(t, args.MaxLen)
+	assert.False(t, args.Approx)
+}
+
+func TestRedisPublisher_Unavailable(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	defer client.Close()
+
+	err := NewRedisPublisher(client, "url-events", 0).Publish(context.Background(), domain.Event{ID: 7})
+	assert.ErrorContains(t, err, "publish event 7")
+}
@@ -0,0 +1,255 @@
+// Package fetcher fetches destination URLs for validation and metadata scraping
+// without letting callers reach private networks (SSRF)
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// Defaults
+const (
+	DefaultTimeout      = 5 * time.Second
+	DefaultMaxRedirects = 5
+	DefaultMaxBodySize  = 512 << 10
+	DefaultUserAgent    = "url-shortener-bot/1.0 (+link preview)"
+)
+
+// Resolver looks up host addresses, *net.Resolver satisfies it
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// Options configures a Fetcher
+type Options struct {
+	Timeout      time.Duration  // Whole fetch including redirects and body
+	MaxRedirects int            // Hops followed before giving up
+	MaxBodySize  int64          // Bytes of HTML read for metadata
+	UserAgent    string         // Sent with every request
+	Allowed      []netip.Prefix // Exceptions to the blocked ranges, e.g. for local development
+	Resolver     Resolver       // Defaults to net.DefaultResolver
+}
+
+// Fetcher implements domain.PageInspector. Every connection is made to an address
+// that was checked after DNS resolution, so a hostname cannot smuggle a request to
+// a private, loopback or link-local address, including via redirects or rebinding.
+type Fetcher struct {
+	client   *http.Client
+	opts     Options
+	resolver Resolver
+}
+
+// New creates a fetcher
+func New(opts Options) *Fetcher {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = DefaultMaxRedirects
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = DefaultMaxBodySize
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = DefaultUserAgent
+	}
+
+	f := &Fetcher{opts: opts, resolver: opts.Resolver}
+	if f.resolver == nil {
+		f.resolver = net.DefaultResolver
+	}
+
+	dialer := &net.Dialer{Timeout: opts.Timeout, KeepAlive: -1}
+	transport := &http.Transport{
+		Proxy:                 nil, // A proxy would connect on our behalf, bypassing the address checks
+		DialContext:           f.dialContext(dialer),
+		TLSHandshakeTimeout:   opts.Timeout,
+		ResponseHeaderTimeout: opts.Timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+	f.client = &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > opts.MaxRedirects {
+				return domain.ErrTooManyRedirects
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+// Inspect implements domain.PageInspector
+func (f *Fetcher) Inspect(ctx context.Context, rawURL string) (*domain.PageInfo, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.5")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		// Unwrap *url.Error so callers can match the domain errors
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) && (errors.Is(urlErr.Err, domain.ErrDestinationBlocked) ||
+			errors.Is(urlErr.Err, domain.ErrTooManyRedirects)) {
+			return nil, urlErr.Err
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	info := &domain.PageInfo{
+		FinalURL:   resp.Request.URL.String(),
+		Redirects:  redirectCount(resp),
+		StatusCode: resp.StatusCode,
+	}
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		info.ContentType = mediaType
+	}
+
+	if info.Accessible() && isHTML(info.ContentType) {
+		if err := scrape(io.LimitReader(resp.Body, f.opts.MaxBodySize), resp.Request.URL, info); err != nil {
+			return nil, fmt.Errorf("read body: %w", err)
+		}
+	}
+	return info, nil
+}
+
+// checkURL rejects non-HTTP schemes and hosts that are blocked IP literals
+func (f *Fetcher) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: scheme %q", domain.ErrDestinationBlocked, u.Scheme)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: credentials in url", domain.ErrDestinationBlocked)
+	}
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !f.allowed(addr) {
+		return fmt.Errorf("%w: %s", domain.ErrDestinationBlocked, addr)
+	}
+	return nil
+}
+
+// dialContext resolves the host itself and connects only to an allowed address
+func (f *Fetcher) dialContext(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		var addrs []netip.Addr
+		if ip, err := netip.ParseAddr(host); err == nil {
+			addrs = []netip.Addr{ip}
+		} else if addrs, err = f.resolver.LookupNetIP(ctx, "ip", host); err != nil {
+			return nil, err
+		}
+
+		// A host with any blocked address is refused outright, so round-robin
+		// records cannot be used to reach an internal address on a later try
+		for _, ip := range addrs {
+			if !f.allowed(ip) {
+				return nil, fmt.Errorf("%w: %s resolves to %s", domain.ErrDestinationBlocked, host, ip)
+			}
+		}
+
+		var lastErr error = fmt.Errorf("no addresses for %s", host)
+		for _, ip := range addrs {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+		return nil, lastErr
+	}
+}
+
+// allowed reports whether connecting to ip is permitted
+func (f *Fetcher) allowed(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	for _, prefix := range f.opts.Allowed {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return !isBlocked(ip)
+}
+
+// blockedPrefixes are special-purpose ranges not covered by the netip predicates
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "This" network
+	netip.MustParsePrefix("100.64.0.0/10"),   // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // Documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   // Benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // Documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // Documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // Reserved, includes broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, can embed any IPv4 address
+	netip.MustParsePrefix("2001:db8::/32"),   // Documentation
+}
+
+func isBlocked(ip netip.Addr) bool {
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// redirectCount counts the requests that led to resp
+func redirectCount(resp *http.Response) int {
+	n := 0
+	for r := resp.Request.Response; r != nil; r = r.Request.Response {
+		n++
+	}
+	return n
+}
+
+func isHTML(contentType string) bool {
+	return contentType == "text/html" || contentType == "application/xhtml+xml"
+}
+
+// ParseAllowed parses CIDR prefixes or single addresses
+func ParseAllowed(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		if prefix, err := netip.ParsePrefix(v); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q", v)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
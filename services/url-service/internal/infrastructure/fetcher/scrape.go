@@ -0,0 +1,143 @@
+package fetcher
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// Scraped values are trimmed to these lengths
+const (
+	maxTitleLength       = 300
+	maxDescriptionLength = 1000
+)
+
+// scrape reads <title>, meta description and OpenGraph tags from the document head
+// into info. Parsing stops at <body>, so only the head counts against the body cap.
+func scrape(r io.Reader, base *url.URL, info *domain.PageInfo) error {
+	var (
+		title, description     string
+		ogTitle, ogDesc, ogImg string
+		inTitle                bool
+	)
+
+	z := html.NewTokenizer(r)
+loop:
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); !errors.Is(err, io.EOF) {
+				return err
+			}
+			break loop
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = string(z.Text())
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = true
+			case atom.Body:
+				break loop
+			case atom.Meta:
+				if !hasAttr {
+					continue
+				}
+				key, content := metaAttrs(z)
+				switch key {
+				case "og:title":
+					ogTitle = content
+				case "og:description":
+					ogDesc = content
+				case "og:image":
+					ogImg = content
+				case "og:site_name":
+					info.SiteName = clean(content, maxTitleLength)
+				case "description":
+					description = content
+				}
+			}
+		}
+	}
+
+	info.Title = clean(firstNonEmpty(ogTitle, title), maxTitleLength)
+	info.Description = clean(firstNonEmpty(ogDesc, description), maxDescriptionLength)
+	if ogImg != "" {
+		info.Image = resolveImage(base, ogImg)
+	}
+	return nil
+}
+
+// metaAttrs returns the property or name of a meta tag, lowercased, and its content
+func metaAttrs(z *html.Tokenizer) (string, string) {
+	var key, content string
+	for {
+		name, val, more := z.TagAttr()
+		switch string(name) {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(string(val)))
+			}
+		case "content":
+			content = string(val)
+		}
+		if !more {
+			return key, content
+		}
+	}
+}
+
+// resolveImage makes a relative og:image absolute, dropping non-HTTP URLs
+func resolveImage(base *url.URL, raw string) string {
+	ref, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+	abs := base.ResolveReference(ref)
+	if abs.Scheme != "http" && abs.Scheme != "https" {
+		return ""
+	}
+	return abs.String()
+}
+
+// clean collapses whitespace and trims s to max bytes on a rune boundary
+func clean(s string, max int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if len(s) <= max {
+		return s
+	}
+	s = s[:max]
+	for len(s) > 0 {
+		if r, size := utf8.DecodeLastRuneInString(s); r != utf8.RuneError || size > 1 {
+			break
+		}
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,166 @@
+// Package geo resolves visitor countries from IP addresses
+package geo
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Table is an in-memory IP range to country table implementing domain.GeoLocator.
+// Lookups are a binary search, so it is safe for concurrent use once loaded.
+type Table struct {
+	v4 []ipRange
+	v6 []ipRange
+}
+
+type ipRange struct {
+	first, last netip.Addr
+	country     string
+}
+
+// LoadTable reads a table from a CSV file, see ParseTable
+func LoadTable(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open geo table: %w", err)
+	}
+	defer f.Close()
+	return ParseTable(f)
+}
+
+// ParseTable reads CSV rows of either "network,country" with a CIDR network or
+// "first,last,country" with an inclusive address range. Countries are ISO 3166-1
+// alpha-2 codes; rows with "-" or no country are skipped, as is a header row.
+// Ranges must not overlap.
+func ParseTable(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	t := &Table{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read geo table: %w", err)
+		}
+
+		rng, err := parseRow(record)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("geo table line %d: %w", line, err)
+		}
+		if rng.country == "" {
+			continue
+		}
+		if rng.first.Is4() {
+			t.v4 = append(t.v4, rng)
+		} else {
+			t.v6 = append(t.v6, rng)
+		}
+	}
+
+	for _, ranges := range [][]ipRange{t.v4, t.v6} {
+		sort.Slice(ranges, func(i, j int) bool { return ranges[i].first.Less(ranges[j].first) })
+		for i := 1; i < len(ranges); i++ {
+			if !ranges[i-1].last.Less(ranges[i].first) {
+				return nil, fmt.Errorf("geo table ranges starting at %s and %s overlap", ranges[i-1].first, ranges[i].first)
+			}
+		}
+	}
+	return t, nil
+}
+
+// Len returns the number of ranges in the table
+func (t *Table) Len() int {
+	return len(t.v4) + len(t.v6)
+}
+
+// Country implements domain.GeoLocator
+func (t *Table) Country(addr netip.Addr) string {
+	addr = addr.Unmap()
+	ranges := t.v6
+	if addr.Is4() {
+		ranges = t.v4
+	}
+
+	// The last range starting at or before addr is the only one that can hold it
+	i := sort.Search(len(ranges), func(i int) bool { return addr.Less(ranges[i].first) }) - 1
+	if i < 0 || ranges[i].last.Less(addr) {
+		return ""
+	}
+	return ranges[i].country
+}
+
+func parseRow(record []string) (ipRange, error) {
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	var rng ipRange
+	switch len(record) {
+	case 2:
+		prefix, err := netip.ParsePrefix(record[0])
+		if err != nil {
+			return rng, err
+		}
+		prefix = prefix.Masked()
+		rng.first, rng.last = prefix.Addr().Unmap(), lastAddr(prefix)
+		rng.country = record[1]
+	case 3:
+		first, err := netip.ParseAddr(record[0])
+		if err != nil {
+			return rng, err
+		}
+		last, err := netip.ParseAddr(record[1])
+		if err != nil {
+			return rng, err
+		}
+		rng.first, rng.last = first.Unmap(), last.Unmap()
+		if rng.first.Is4() != rng.last.Is4() || rng.last.Less(rng.first) {
+			return rng, fmt.Errorf("invalid range %s-%s", first, last)
+		}
+		rng.country = record[2]
+	default:
+		return rng, fmt.Errorf("expected 2 or 3 fields, got %d", len(record))
+	}
+
+	rng.country = strings.ToUpper(rng.country)
+	if rng.country == "-" {
+		rng.country = ""
+	}
+	if rng.country != "" && !validCountry(rng.country) {
+		return rng, fmt.Errorf("invalid country %q", rng.country)
+	}
+	return rng, nil
+}
+
+// lastAddr returns the highest address in a masked prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Addr().Unmap()
+	bits := prefix.Bits()
+	if prefix.Addr().Is4In6() {
+		bits -= 96
+	}
+
+	b := addr.AsSlice()
+	for i := bits; i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - uint(i%8))
+	}
+	last, _ := netip.AddrFromSlice(b)
+	return last
+}
+
+func validCountry(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
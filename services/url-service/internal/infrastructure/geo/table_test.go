@@ -0,0 +1,43 @@
+package geo
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_Country(t *testing.T) {
+	table, err := ParseTable(strings.NewReader(`network,country
+# comment
+10.0.0.0/8,de
+192.168.1.0,192.168.1.255,FR
+192.168.2.0/24,-
+2001:db8::/32,NL
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 3, table.Len())
+
+	cases := map[string]string{
+		"10.1.2.3":           "DE",
+		"10.255.255.255":     "DE",
+		"11.0.0.0":           "",
+		"192.168.1.7":        "FR",
+		"192.168.2.7":        "",
+		"::ffff:10.0.0.1":    "DE",
+		"2001:db8::1":        "NL",
+		"2001:db9::1":        "",
+		"0.0.0.1":            "",
+		"ffff:ffff::ffff:ff": "",
+	}
+	for ip, want := range cases {
+		assert.Equal(t, want, table.Country(netip.MustParseAddr(ip)), ip)
+	}
+}
+
+func TestParseTable_RejectsOverlap(t *testing.T) {
+	_, err := ParseTable(strings.NewReader("10.0.0.0/8,DE\n10.1.0.0/16,FR\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// urlColumnCount is the number of columns in urlColumns
+const urlColumnCount = 20
+
+// maxInsertRows keeps a multi-row insert under PostgreSQL's 65535 parameter limit
+const maxInsertRows = 65535 / urlColumnCount
+
+// Bulk implements domain.URLRepository
+func (r *URLRepository) Bulk(ctx context.Context, fn func(w domain.BulkWriter) error) error {
+	return database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		return fn(&bulkWriter{tx: tx})
+	})
+}
+
+// bulkWriter implements domain.BulkWriter within a transaction
+type bulkWriter struct {
+	tx *sql.Tx
+}
+
+// InsertMany implements domain.BulkWriter. ON CONFLICT DO NOTHING keeps the
+// transaction usable when a code is taken, so callers can retry those rows.
+func (w *bulkWriter) InsertMany(ctx context.Context, urls []*domain.URL) ([]int, error) {
+	// RETURNING yields an ID once however many rows carry it, so only the first
+	// row with an ID is sent and later ones are reported as taken
+	first := make(map[string]int, len(urls))
+	unique := make([]*domain.URL, 0, len(urls))
+	for i, u := range urls {
+		if _, dup := first[u.ID]; !dup {
+			first[u.ID] = i
+			unique = append(unique, u)
+		}
+	}
+
+	inserted := make(map[string]struct{}, len(unique))
+	for start := 0; start < len(unique); start += maxInsertRows {
+		if err := w.insert(ctx, unique[start:min(start+maxInsertRows, len(unique))], inserted); err != nil {
+			return nil, err
+		}
+	}
+
+	var skipped []int
+	for i, u := range urls {
+		if _, ok := inserted[u.ID]; !ok || first[u.ID] != i {
+			skipped = append(skipped, i)
+		}
+	}
+	return skipped, nil
+}
+
+// insert writes one multi-row statement of rows with distinct IDs, adding the
+// IDs it inserted to inserted
+func (w *bulkWriter) insert(ctx context.Context, urls []*domain.URL, inserted map[string]struct{}) error {
+	var query strings.Builder
+	query.WriteString(`INSERT INTO urls (` + urlColumns + `) VALUES `)
+
+	args := make([]interface{}, 0, len(urls)*urlColumnCount)
+	for i, u := range urls {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteByte('(')
+		for c := 0; c < urlColumnCount; c++ {
+			if c > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString("$" + strconv.Itoa(i*urlColumnCount+c+1))
+		}
+		query.WriteByte(')')
+
+		args = append(args, urlValues(u)...)
+	}
+	query.WriteString(` ON CONFLICT (id) DO NOTHING RETURNING id`)
+
+	rows, err := w.tx.QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return fmt.Errorf("insert urls: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("scan inserted id: %w", err)
+		}
+		inserted[id] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate inserted ids: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// insertingDB answers multi-row URL inserts as ON CONFLICT (id) DO NOTHING
+// RETURNING id does: each new ID is returned once, taken ones not at all
+func insertingDB(t *testing.T, taken ...string) (*URLRepository, *scriptDB) {
+	stored := make(map[string]bool)
+	for _, id := range taken {
+		stored[id] = true
+	}
+	db, script := newScriptDB(t, func(query string, args []driver.Value) (*result, error) {
+		if !strings.HasPrefix(query, "INSERT INTO urls") {
+			return nil, nil
+		}
+		res := &result{columns: []string{"id"}}
+		for i := 0; i < len(args); i += urlColumnCount {
+			id := args[i].(string)
+			if !stored[id] {
+				stored[id] = true
+				res.rows = append(res.rows, []driver.Value{id})
+			}
+		}
+		return res, nil
+	})
+	return NewURLRepository(db), script
+}
+
+func insertMany(t *testing.T, repo *URLRepository, ids ...string) []int {
+	urls := make([]*domain.URL, len(ids))
+	for i, id := range ids {
+		urls[i] = &domain.URL{ID: id, OriginalURL: "https://example.com/" + id}
+	}
+	var skipped []int
+	err := repo.Bulk(context.Background(), func(w domain.BulkWriter) error {
+		var err error
+		skipped, err = w.InsertMany(context.Background(), urls)
+		return err
+	})
+	require.NoError(t, err)
+	return skipped
+}
+
+func TestBulkWriter_InsertMany(t *testing.T) {
+	repo, script := insertingDB(t, "taken")
+
+	skipped := insertMany(t, repo, "a", "taken", "b")
+	assert.Equal(t, []int{1}, skipped)
+	inserts := script.statements("INSERT INTO urls")
+	require.Len(t, inserts, 1)
+	assert.Contains(t, inserts[0].query, "ON CONFLICT (id) DO NOTHING RETURNING id")
+	assert.Len(t, inserts[0].args, 3*urlColumnCount)
+	assert.Equal(t, "BEGIN", script.queries()[0])
+	assert.Equal(t, "COMMIT", script.queries()[2])
+}
+
+func TestBulkWriter_InsertMany_DuplicateIDs(t *testing.T) {
+	repo, script := insertingDB(t, "taken")
+
+	// Only the first row with an ID can be the one inserted
+	skipped := insertMany(t, repo, "a", "b", "a", "taken", "taken", "a")
+	assert.Equal(t, []int{2, 3, 4, 5}, skipped)
+
+	inserts := script.statements("INSERT INTO urls")
+	require.Len(t, inserts, 1)
+	var sent []string
+	for i := 0; i < len(inserts[0].args); i += urlColumnCount {
+		sent = append(sent, inserts[0].args[i].(string))
+	}
+	assert.Equal(t, []string{"a", "b", "taken"}, sent)
+}
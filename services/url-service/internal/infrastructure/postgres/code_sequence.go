@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// CodeSequence implements shortcode.Sequence with a PostgreSQL sequence
+type CodeSequence struct {
+	db *sql.DB
+}
+
+// NewCodeSequence creates a sequence backed by short_code_seq
+func NewCodeSequence(db *sql.DB) *CodeSequence {
+	return &CodeSequence{db: db}
+}
+
+// Next returns the next sequence value
+func (s *CodeSequence) Next(ctx context.Context) (uint64, error) {
+	var n int64
+	if err := s.db.QueryRowContext(ctx, `SELECT nextval('short_code_seq')`).Scan(&n); err != nil {
+		return 0, fmt.Errorf("next short code: %w", err)
+	}
+	return uint64(n), nil
+}
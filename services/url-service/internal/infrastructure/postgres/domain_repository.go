@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+const domainColumns = `hostname, user_id, verification_token, created_at, verified_at, checked_at`
+
+// DomainRepository implements domain.DomainRepository on PostgreSQL
+type DomainRepository struct {
+	db *sql.DB
+}
+
+// NewDomainRepository creates a new PostgreSQL domain repository
+func NewDomainRepository(db *sql.DB) *DomainRepository {
+	return &DomainRepository{db: db}
+}
+
+// Create implements domain.DomainRepository
+func (r *DomainRepository) Create(ctx context.Context, d *domain.CustomDomain) error {
+	query := `INSERT INTO domains (` + domainColumns + `)
+		SELECT $1, $2, $3, $4, $5, $6
+		WHERE NOT EXISTS (SELECT 1 FROM domains WHERE hostname = $1 AND verified_at IS NOT NULL)`
+
+	res, err := r.db.ExecContext(ctx, query,
+		d.Hostname, d.UserID, d.VerificationToken, d.CreatedAt, d.VerifiedAt, d.CheckedAt)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return domain.ErrDomainTaken
+		}
+		return fmt.Errorf("insert domain: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	} else if n == 0 {
+		return domain.ErrDomainTaken
+	}
+	return nil
+}
+
+// Get implements domain.DomainRepository
+func (r *DomainRepository) Get(ctx context.Context, hostname, userID string) (*domain.CustomDomain, error) {
+	query := `SELECT ` + domainColumns + ` FROM domains WHERE hostname = $1 AND user_id = $2`
+	return r.get(ctx, query, hostname, userID)
+}
+
+// GetVerified implements domain.DomainRepository
+func (r *DomainRepository) GetVerified(ctx context.Context, hostname string) (*domain.CustomDomain, error) {
+	query := `SELECT ` + domainColumns + ` FROM domains WHERE hostname = $1 AND verified_at IS NOT NULL`
+	return r.get(ctx, query, hostname)
+}
+
+func (r *DomainRepository) get(ctx context.Context, query string, args ...interface{}) (*domain.CustomDomain, error) {
+	d, err := scanDomain(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDomainNotFound
+		}
+		return nil, fmt.Errorf("select domain: %w", err)
+	}
+	return d, nil
+}
+
+// ListByUser implements domain.DomainRepository
+func (r *DomainRepository) ListByUser(ctx context.Context, userID string) ([]*domain.CustomDomain, error) {
+	query := `SELECT ` + domainColumns + ` FROM domains WHERE user_id = $1 ORDER BY created_at DESC, hostname`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list domains: %w", err)
+	}
+	defer rows.Close()
+
+	var domains []*domain.CustomDomain
+	for rows.Next() {
+		d, err := scanDomain(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan domain: %w", err)
+		}
+		domains = append(domains, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate domains: %w", err)
+	}
+	return domains, nil
+}
+
+// MarkChecked implements domain.DomainRepository. The partial unique index on
+// verified hostnames settles races between users verifying the same hostname.
+func (r *DomainRepository) MarkChecked(ctx context.Context, hostname, userID string, at time.Time, verified bool) error {
+	query := `UPDATE domains SET checked_at = $3 WHERE hostname = $1 AND user_id = $2`
+	if verified {
+		query = `UPDATE domains SET checked_at = $3, verified_at = COALESCE(verified_at, $3)
+			WHERE hostname = $1 AND user_id = $2`
+	}
+
+	res, err := r.db.ExecContext(ctx, query, hostname, userID, at)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return domain.ErrDomainTaken
+		}
+		return fmt.Errorf("update domain: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrDomainNotFound
+	}
+	return nil
+}
+
+func scanDomain(row rowScanner) (*domain.CustomDomain, error) {
+	var (
+		d          domain.CustomDomain
+		verifiedAt sql.NullTime
+		checkedAt  sql.NullTime
+	)
+	err := row.Scan(&d.Hostname, &d.UserID, &d.VerificationToken, &d.CreatedAt, &verifiedAt, &checkedAt)
+	if err != nil {
+		return nil, err
+	}
+	if verifiedAt.Valid {
+		d.VerifiedAt = &verifiedAt.Time
+	}
+	if checkedAt.Valid {
+		d.CheckedAt = &checkedAt.Time
+	}
+	return &d, nil
+}
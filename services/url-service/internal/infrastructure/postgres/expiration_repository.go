@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ExpireBatch implements domain.ExpirationRepository. SKIP LOCKED lets concurrent
+// sweepers split the backlog instead of waiting on each other's rows.
+func (r *URLRepository) ExpireBatch(ctx context.Context, now time.Time, limit int) ([]*domain.URL, error) {
+	query := `UPDATE urls SET is_active = FALSE, updated_at = $1
+		WHERE id IN (
+			SELECT id FROM urls
+			WHERE is_active AND expires_at <= $1
+			ORDER BY expires_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		) AND is_active
+		RETURNING ` + urlColumns
+
+	var expired []*domain.URL
+	err := database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, now, limit)
+		if err != nil {
+			return fmt.Errorf("expire urls: %w", err)
+		}
+		if expired, err = scanURLs(rows, limit); err != nil {
+			return err
+		}
+
+		events := make([]domain.Event, 0, len(expired))
+		for _, u := range expired {
+			event, err := domain.NewEvent(domain.EventURLExpired, u.ID, domain.URLExpiredPayload{
+				URLID:       u.ID,
+				UserID:      u.UserID,
+				OriginalURL: u.OriginalURL,
+				ExpiredAt:   *u.ExpiresAt,
+			}, now)
+			if err != nil {
+				return fmt.Errorf("encode event: %w", err)
+			}
+			events = append(events, event)
+		}
+		return insertEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+// ClaimExpiring implements domain.ExpirationRepository. Rows are claimed by
+// stamping expiry_notified_at in a short transaction, so notify runs without
+// holding row locks or a connection; SKIP LOCKED keeps concurrent sweepers
+// apart while claiming. A failed notify or event insert releases the claim.
+// Notices are best effort: a crash after the claim drops them for that batch.
+func (r *URLRepository) ClaimExpiring(ctx context.Context, now, deadline time.Time, limit int,
+	notify func(ctx context.Context, urls []*domain.URL) ([]domain.Event, error)) (int, error) {
+
+	query := `UPDATE urls SET expiry_notified_at = $1
+		WHERE id IN (
+			SELECT id FROM urls
+			WHERE is_active AND user_id <> '' AND expiry_notified_at IS NULL
+				AND expires_at > $1 AND expires_at <= $2
+			ORDER BY expires_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		) AND expiry_notified_at IS NULL
+		RETURNING ` + urlColumns
+
+	var urls []*domain.URL
+	err := database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, now, deadline, limit)
+		if err != nil {
+			return fmt.Errorf("claim expiring urls: %w", err)
+		}
+		urls, err = scanURLs(rows, limit)
+		return err
+	})
+	if err != nil || len(urls) == 0 {
+		return 0, err
+	}
+
+	events, err := notify(ctx, urls)
+	if err == nil {
+		err = database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+			return insertEvents(ctx, tx, events)
+		})
+	}
+	if err != nil {
+		if rerr := r.releaseClaim(ctx, urls, now); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+		return 0, err
+	}
+	return len(urls), nil
+}
+
+// releaseClaim clears the notified stamp ClaimExpiring set at now so the next
+// sweep picks the URLs up again; stamps changed meanwhile are left alone
+func (r *URLRepository) releaseClaim(ctx context.Context, urls []*domain.URL, now time.Time) error {
+	ids := make([]string, len(urls))
+	for i, u := range urls {
+		ids[i] = u.ID
+	}
+	// Released even when the caller's context is done, or the URLs would never be notified
+	if _, err := r.db.ExecContext(context.WithoutCancel(ctx),
+		`UPDATE urls SET expiry_notified_at = NULL WHERE id = ANY($1) AND expiry_notified_at = $2`,
+		pq.Array(ids), now); err != nil {
+		return fmt.Errorf("release expiry claim: %w", err)
+	}
+	return nil
+}
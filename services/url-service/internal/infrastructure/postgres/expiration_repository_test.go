@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestURLRepository_ExpireBatch(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+	// The database side: abc is active and expired until an UPDATE deactivates it
+	active := map[string]bool{"abc": true}
+
+	db, script := newScriptDB(t, func(query string, args []driver.Value) (*result, error) {
+		if !strings.HasPrefix(query, "UPDATE urls SET is_active = FALSE") {
+			return nil, nil
+		}
+		res := &result{columns: urlColumnNames}
+		for id := range active {
+			res.rows = append(res.rows, urlRow(id, "user-1", &expired))
+			delete(active, id)
+		}
+		return res, nil
+	})
+	repo := NewURLRepository(db)
+	ctx := context.Background()
+
+	urls, err := repo.ExpireBatch(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+	assert.Equal(t, "abc", urls[0].ID)
+
+	updates := script.statements("UPDATE urls SET is_active = FALSE")
+	require.Len(t, updates, 1)
+	// Concurrent sweepers skip each other's rows, and rows deactivated meanwhile are left alone
+	assert.Contains(t, updates[0].query, "FOR UPDATE SKIP LOCKED")
+	assert.Contains(t, updates[0].query, "WHERE is_active AND expires_at <= $1")
+	assert.Contains(t, updates[0].query, ") AND is_active")
+	assert.Equal(t, []driver.Value{now, int64(10)}, updates[0].args)
+
+	inserts := script.statements("INSERT INTO url_events")
+	require.Len(t, inserts, 1)
+	assert.Equal(t, domain.EventURLExpired, inserts[0].args[0])
+	assert.Equal(t, "abc", inserts[0].args[1])
+	var payload domain.URLExpiredPayload
+	require.NoError(t, json.Unmarshal([]byte(inserts[0].args[2].(string)), &payload))
+	assert.Equal(t, domain.URLExpiredPayload{URLID: "abc", UserID: "user-1", OriginalURL: "https://example.com/abc", ExpiredAt: expired}, payload)
+	assert.Equal(t, []string{"BEGIN", updates[0].query, inserts[0].query, "COMMIT"}, script.queries())
+
+	// A second sweep finds nothing left to expire and records no event
+	urls, err = repo.ExpireBatch(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, urls)
+	assert.Len(t, script.statements("INSERT INTO url_events"), 1)
+}
+
+func TestURLRepository_ClaimExpiring(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(24 * time.Hour)
+	db, script := newScriptDB(t, func(query string, _ []driver.Value) (*result, error) {
+		if strings.HasPrefix(query, "UPDATE urls SET expiry_notified_at = $1") {
+			return &result{columns: urlColumnNames, rows: [][]driver.Value{urlRow("abc", "user-1", &expires)}}, nil
+		}
+		return nil, nil
+	})
+	repo := NewURLRepository(db)
+
+	notice, err := domain.NewEvent(domain.EventURLExpiring, "user-1", domain.URLExpiringPayload{UserID: "user-1"}, now)
+	require.NoError(t, err)
+	n, err := repo.ClaimExpiring(context.Background(), now, now.Add(72*time.Hour), 10,
+		func(_ context.Context, urls []*domain.URL) ([]domain.Event, error) {
+			require.Len(t, urls, 1)
+			// The claim is committed before the owners are looked up
+			assert.Equal(t, "COMMIT", script.queries()[len(script.queries())-1])
+			return []domain.Event{notice}, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	claims := script.statements("SET expiry_notified_at = $1")
+	require.Len(t, claims, 1)
+	assert.Contains(t, claims[0].query, "FOR UPDATE SKIP LOCKED")
+	assert.Contains(t, claims[0].query, "expiry_notified_at IS NULL")
+	inserts := script.statements("INSERT INTO url_events")
+	require.Len(t, inserts, 1)
+	assert.Equal(t, []string{"BEGIN", claims[0].query, "COMMIT", "BEGIN", inserts[0].query, "COMMIT"}, script.queries())
+}
+
+func TestURLRepository_ClaimExpiring_NotifyFails(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(24 * time.Hour)
+	db, script := newScriptDB(t, func(query string, _ []driver.Value) (*result, error) {
+		if strings.HasPrefix(query, "UPDATE urls SET expiry_notified_at = $1") {
+			return &result{columns: urlColumnNames, rows: [][]driver.Value{urlRow("abc", "user-1", &expires)}}, nil
+		}
+		return nil, nil
+	})
+	repo := NewURLRepository(db)
+
+	_, err := repo.ClaimExpiring(context.Background(), now, now.Add(72*time.Hour), 10,
+		func(context.Context, []*domain.URL) ([]domain.Event, error) { return nil, assert.AnError })
+	assert.ErrorIs(t, err, assert.AnError)
+	// The claim is released, so the URLs are claimed again on the next sweep
+	releases := script.statements("SET expiry_notified_at = NULL")
+	require.Len(t, releases, 1)
+	assert.Equal(t, []driver.Value{"{\"abc\"}", now}, releases[0].args)
+	assert.Empty(t, script.statements("INSERT INTO url_events"))
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// Outbox implements domain.Outbox on the url_events table
+type Outbox struct {
+	db *sql.DB
+}
+
+// NewOutbox creates a new PostgreSQL outbox
+func NewOutbox(db *sql.DB) *Outbox {
+	return &Outbox{db: db}
+}
+
+// Relay implements domain.Outbox. Delivery is at least once: an event is
+// published again if the transaction fails after publish succeeded.
+func (o *Outbox) Relay(ctx context.Context, limit int, publish func(context.Context, domain.Event) error) (int, error) {
+	query := `SELECT id, type, aggregate_id, payload, occurred_at FROM url_events
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`
+
+	var (
+		published  int
+		publishErr error
+	)
+	err := database.WithTx(ctx, o.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, limit)
+		if err != nil {
+			return fmt.Errorf("select pending events: %w", err)
+		}
+		events, err := scanEvents(rows, limit)
+		if err != nil {
+			return err
+		}
+
+		// Stop at the first failure to keep per-aggregate order
+		ids := make([]int64, 0, len(events))
+		for _, event := range events {
+			if publishErr = publish(ctx, event); publishErr != nil {
+				break
+			}
+			ids = append(ids, event.ID)
+		}
+
+		if len(ids) > 0 {
+			if _, err := tx.ExecContext(ctx, `UPDATE url_events SET published_at = NOW() WHERE id = ANY($1)`,
+				pq.Array(ids)); err != nil {
+				return fmt.Errorf("mark events published: %w", err)
+			}
+		}
+		published = len(ids)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	// Progress made before a publish failure is committed, the failure is still reported
+	return published, publishErr
+}
+
+// insertEvents appends events to the outbox within tx
+func insertEvents(ctx context.Context, tx *sql.Tx, events []domain.Event) error {
+	for _, event := range events {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO url_events (type, aggregate_id, payload, occurred_at) VALUES ($1, $2, $3, $4)`,
+			event.Type, event.AggregateID, string(event.Payload), event.OccurredAt,
+		); err != nil {
+			return fmt.Errorf("insert event: %w", err)
+		}
+	}
+	return nil
+}
+
+func scanEvents(rows *sql.Rows, capacity int) ([]domain.Event, error) {
+	defer rows.Close()
+
+	events := make([]domain.Event, 0, capacity)
+	for rows.Next() {
+		var (
+			event   domain.Event
+			payload []byte
+		)
+		if err := rows.Scan(&event.ID, &event.Type, &event.AggregateID, &payload, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("scan event: %w", err)
+		}
+		event.Payload = payload
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestOutbox_Relay(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	db, script := newScriptDB(t, func(query string, _ []driver.Value) (*result, error) {
+		if !strings.HasPrefix(query, "SELECT") {
+			return nil, nil
+		}
+		res := &result{columns: []string{"id", "type", "aggregate_id", "payload", "occurred_at"}}
+		for _, id := range []int64{1, 2, 3} {
+			res.rows = append(res.rows, []driver.Value{id, domain.EventURLExpired, "abc", []byte(`{}`), at})
+		}
+		return res, nil
+	})
+	outbox := NewOutbox(db)
+
+	var published []int64
+	unavailable := errors.New("broker unavailable")
+	n, err := outbox.Relay(context.Background(), 10, func(_ context.Context, event domain.Event) error {
+		if event.ID == 3 {
+			return unavailable
+		}
+		published = append(published, event.ID)
+		return nil
+	})
+	// Events published before the failure are still marked
+	assert.ErrorIs(t, err, unavailable)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []int64{1, 2}, published)
+
+	selects := script.statements("SELECT")
+	require.Len(t, selects, 1)
+	assert.Contains(t, selects[0].query, "WHERE published_at IS NULL")
+	assert.Contains(t, selects[0].query, "ORDER BY id")
+	assert.Contains(t, selects[0].query, "FOR UPDATE SKIP LOCKED")
+	marks := script.statements("UPDATE url_events SET published_at")
+	require.Len(t, marks, 1)
+	assert.Equal(t, "{1,2}", marks[0].args[0])
+	assert.Equal(t, "COMMIT", script.queries()[len(script.queries())-1])
+}
+
+func TestOutbox_Relay_Empty(t *testing.T) {
+	db, script := newScriptDB(t, func(string, []driver.Value) (*result, error) {
+		return &result{columns: []string{"id", "type", "aggregate_id", "payload", "occurred_at"}}, nil
+	})
+
+	n, err := NewOutbox(db).Relay(context.Background(), 10, func(context.Context, domain.Event) error {
+		t.Fatal("nothing to publish")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Empty(t, script.statements("UPDATE url_events"))
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ListRevisions implements domain.URLRepository
+func (r *URLRepository) ListRevisions(ctx context.Context, id string) ([]*domain.URLRevision, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, url_id, user_id, changes, restored_from, created_at
+		FROM url_revisions WHERE url_id = $1 ORDER BY id DESC`, id)
+	if err != nil {
+		return nil, fmt.Errorf("select revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.URLRevision
+	for rows.Next() {
+		var (
+			rev          domain.URLRevision
+			changes      []byte
+			restoredFrom sql.NullInt64
+		)
+		if err := rows.Scan(&rev.ID, &rev.URLID, &rev.UserID, &changes, &restoredFrom, &rev.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan revision: %w", err)
+		}
+		if err := json.Unmarshal(changes, &rev.Changes); err != nil {
+			return nil, fmt.Errorf("decode revision changes: %w", err)
+		}
+		rev.RestoredFrom = restoredFrom.Int64
+		revisions = append(revisions, &rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// insertRevision records rev, sets its ID and prunes the URL's revisions beyond
+// the newest keep
+func insertRevision(ctx context.Context, tx *sql.Tx, rev *domain.URLRevision, keep int) error {
+	changes, err := json.Marshal(rev.Changes)
+	if err != nil {
+		return fmt.Errorf("encode revision changes: %w", err)
+	}
+	restoredFrom := sql.NullInt64{Int64: rev.RestoredFrom, Valid: rev.RestoredFrom != 0}
+
+	err = tx.QueryRowContext(ctx, `INSERT INTO url_revisions (url_id, user_id, changes, restored_from, created_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		rev.URLID, rev.UserID, string(changes), restoredFrom, rev.CreatedAt,
+	).Scan(&rev.ID)
+	if err != nil {
+		return fmt.Errorf("insert revision: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM url_revisions WHERE url_id = $1 AND id NOT IN (
+			SELECT id FROM url_revisions WHERE url_id = $1 ORDER BY id DESC LIMIT $2
+		)`, rev.URLID, keep)
+	if err != nil {
+		return fmt.Errorf("prune revisions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// ScanBatch implements domain.ScreeningRepository. Rows stay locked while check
+// runs, SKIP LOCKED lets concurrent scanners split the backlog.
+func (r *URLRepository) ScanBatch(ctx context.Context, cutoff, at time.Time, limit int,
+	check func(u *domain.URL) *domain.Threat) (int, []*domain.URL, error) {
+
+	query := `SELECT ` + urlColumns + ` FROM urls
+		WHERE is_active AND (scanned_at IS NULL OR scanned_at < $1)
+		ORDER BY scanned_at NULLS FIRST
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+
+	var (
+		scanned int
+		blocked []*domain.URL
+	)
+	err := database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query, cutoff, limit)
+		if err != nil {
+			return fmt.Errorf("select urls to scan: %w", err)
+		}
+		urls, err := scanURLs(rows, limit)
+		if err != nil || len(urls) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(urls))
+		var events []domain.Event
+		for i, u := range urls {
+			ids[i] = u.ID
+			threat := check(u)
+			if threat == nil {
+				continue
+			}
+
+			u.IsActive, u.BlockedReason, u.UpdatedAt = false, threat.String(), at
+			if _, err := tx.ExecContext(ctx, `UPDATE urls SET is_active = FALSE, blocked_reason = $2, updated_at = $3
+				WHERE id = $1`, u.ID, u.BlockedReason, at); err != nil {
+				return fmt.Errorf("block url: %w", err)
+			}
+			event, err := domain.NewEvent(domain.EventURLBlocked, u.ID, domain.URLBlockedPayload{
+				URLID:       u.ID,
+				UserID:      u.UserID,
+				OriginalURL: u.OriginalURL,
+				Reason:      u.BlockedReason,
+				BlockedAt:   at,
+			}, at)
+			if err != nil {
+				return fmt.Errorf("encode event: %w", err)
+			}
+			events = append(events, event)
+			blocked = append(blocked, u)
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE urls SET scanned_at = $1 WHERE id = ANY($2)`,
+			at, pq.Array(ids)); err != nil {
+			return fmt.Errorf("mark urls scanned: %w", err)
+		}
+		if err := insertEvents(ctx, tx, events); err != nil {
+			return err
+		}
+
+		scanned = len(urls)
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return scanned, blocked, nil
+}
@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// statement is one query or command received by a scriptDB
+type statement struct {
+	query string
+	args  []driver.Value
+}
+
+// result is the scripted answer to a statement: rows for queries, affected for commands
+type result struct {
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+}
+
+// scriptDB is a database/sql driver that answers statements from a handler and
+// records them, so repositories can be checked without a PostgreSQL server.
+// BEGIN, COMMIT and ROLLBACK are recorded as statements too.
+type scriptDB struct {
+	mu     sync.Mutex
+	log    []statement
+	handle func(query string, args []driver.Value) (*result, error)
+}
+
+func newScriptDB(t *testing.T, handle func(query string, args []driver.Value) (*result, error)) (*sql.DB, *scriptDB) {
+	s := &scriptDB{handle: handle}
+	db := sql.OpenDB(s)
+	t.Cleanup(func() { db.Close() })
+	return db, s
+}
+
+// statements returns the recorded statements containing substr
+func (s *scriptDB) statements(substr string) []statement {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []statement
+	for _, st := range s.log {
+		if strings.Contains(st.query, substr) {
+			out = append(out, st)
+		}
+	}
+	return out
+}
+
+// queries returns every recorded statement
+func (s *scriptDB) queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]string, len(s.log))
+	for i, st := range s.log {
+		out[i] = st.query
+	}
+	return out
+}
+
+func (s *scriptDB) run(query string, named []driver.NamedValue) (*result, error) {
+	args := make([]driver.Value, len(named))
+	for i, v := range named {
+		args[i] = v.Value
+	}
+	s.mu.Lock()
+	s.log = append(s.log, statement{query: query, args: args})
+	s.mu.Unlock()
+
+	if s.handle == nil {
+		return &result{}, nil
+	}
+	res, err := s.handle(query, args)
+	if res == nil && err == nil {
+		res = &result{}
+	}
+	return res, err
+}
+
+// Connect implements driver.Connector
+func (s *scriptDB) Connect(context.Context) (driver.Conn, error) {
+	return &scriptConn{db: s}, nil
+}
+
+// Driver implements driver.Connector
+func (s *scriptDB) Driver() driver.Driver {
+	return nil
+}
+
+type scriptConn struct {
+	db *scriptDB
+}
+
+func (c *scriptConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *scriptConn) Close() error {
+	return nil
+}
+
+func (c *scriptConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *scriptConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if _, err := c.db.run("BEGIN", nil); err != nil {
+		return nil, err
+	}
+	return &scriptTx{db: c.db}, nil
+}
+
+func (c *scriptConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res, err := c.db.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &scriptRows{columns: res.columns, rows: res.rows}, nil
+}
+
+func (c *scriptConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res, err := c.db.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(res.affected), nil
+}
+
+type scriptTx struct {
+	db *scriptDB
+}
+
+func (tx *scriptTx) Commit() error {
+	_, err := tx.db.run("COMMIT", nil)
+	return err
+}
+
+func (tx *scriptTx) Rollback() error {
+	_, err := tx.db.run("ROLLBACK", nil)
+	return err
+}
+
+type scriptRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *scriptRows) Columns() []string {
+	return r.columns
+}
+
+func (r *scriptRows) Close() error {
+	return nil
+}
+
+func (r *scriptRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// urlRow returns the urlColumns of an active URL as a driver row
+func urlRow(id, userID string, expiresAt *time.Time) []driver.Value {
+	var expires driver.Value
+	if expiresAt != nil {
+		expires = *expiresAt
+	}
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []driver.Value{
+		id, "https://example.com/" + id, userID, "", "", "", "{}",
+		true, false, int64(0), nil, created, created, expires, int64(0),
+		nil, []byte("[]"), []byte("[]"), "", nil,
+	}
+}
+
+// urlColumnNames lists urlColumns for scripted rows
+var urlColumnNames = strings.Split(strings.NewReplacer("\n", "", "\t", "", " ", "").Replace(urlColumns), ",")
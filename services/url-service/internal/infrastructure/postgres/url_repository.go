@@ -0,0 +1,480 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+const urlColumns = `id, original_url, user_id, title, description, password_hash, tags,
+	is_active, is_custom, click_count, last_accessed, created_at, updated_at, expires_at, max_clicks,
+	activates_at, redirect_rules, variants, blocked_reason, scanned_at`
+
+// URLRepository implements domain.URLRepository on PostgreSQL
+type URLRepository struct {
+	db *sql.DB
+}
+
+// NewURLRepository creates a new PostgreSQL URL repository
+func NewURLRepository(db *sql.DB) *URLRepository {
+	return &URLRepository{db: db}
+}
+
+// Create stores a new URL
+func (r *URLRepository) Create(ctx context.Context, u *domain.URL) error {
+	query := `INSERT INTO urls (` + urlColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
+
+	_, err := r.db.ExecContext(ctx, query, urlValues(u)...)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return domain.ErrCodeTaken
+		}
+		return fmt.Errorf("insert url: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the URL with the given short code
+func (r *URLRepository) GetByID(ctx context.Context, id string) (*domain.URL, error) {
+	query := `SELECT ` + urlColumns + ` FROM urls WHERE id = $1`
+
+	u, err := scanURL(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrURLNotFound
+		}
+		return nil, fmt.Errorf("select url: %w", err)
+	}
+	return u, nil
+}
+
+// Update stores mutable fields of an existing URL and records rev with them.
+// Every write of a URL stamps updated_at, so matching loadedAt means nothing,
+// such as screening blocking it, happened since the caller read it.
+func (r *URLRepository) Update(ctx context.Context, u *domain.URL, loadedAt time.Time, rev *domain.URLRevision, keep int) error {
+	// A new expiration date earns a new expiry notice
+	query := `UPDATE urls SET
+		title = $2, description = $3, password_hash = $4, tags = $5,
+		is_active = $6, expires_at = $7, updated_at = $8, max_clicks = $9, activates_at = $10, variants = $11,
+		original_url = $12,
+		expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $7 THEN NULL ELSE expiry_notified_at END
+		WHERE id = $1 AND updated_at = $13`
+
+	return database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, query,
+			u.ID, u.Title, u.Description, u.PasswordHash, tagsValue(u.Tags),
+			u.IsActive, u.ExpiresAt, u.UpdatedAt, u.MaxClicks, u.ActivatesAt, jsonArray(u.Variants),
+			u.OriginalURL, loadedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("update url: %w", err)
+		}
+		if err := expectAffected(res); err != nil {
+			if !errors.Is(err, domain.ErrURLNotFound) {
+				return err
+			}
+			var exists bool
+			if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM urls WHERE id = $1)`, u.ID).Scan(&exists); err != nil {
+				return fmt.Errorf("check url: %w", err)
+			}
+			if exists {
+				return domain.ErrURLModified
+			}
+			return domain.ErrURLNotFound
+		}
+		if rev == nil {
+			return nil
+		}
+		return insertRevision(ctx, tx, rev, keep)
+	})
+}
+
+// Delete removes a URL
+func (r *URLRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM urls WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete url: %w", err)
+	}
+	return expectAffected(res)
+}
+
+// List returns a page of URLs matching the filter and the total count. The total
+// comes from a window function over the same scan, so large accounts are not
+// counted twice. Keyset pages (filter.After) and filter.SkipCount return no total,
+// since a window over the remaining rows would undercount.
+func (r *URLRepository) List(ctx context.Context, filter domain.ListFilter) ([]*domain.URL, int64, error) {
+	args := []interface{}{filter.UserID}
+	where := `WHERE user_id = $1`
+	if filter.ActiveOnly {
+		where += ` AND is_active`
+	}
+
+	var query string
+	if filter.Search != "" {
+		args = append(args, filter.Search)
+		query = fmt.Sprintf(`websearch_to_tsquery('english', $%d)`, len(args))
+		where += ` AND search_vector @@ ` + query
+	}
+	if len(filter.Tags) > 0 {
+		args = append(args, pq.Array(filter.Tags))
+		op := `&&`
+		if filter.MatchAllTags {
+			op = `@>`
+		}
+		where += fmt.Sprintf(` AND tags %s $%d`, op, len(args))
+	}
+	if filter.ActivatesFrom != nil {
+		args = append(args, *filter.ActivatesFrom)
+		where += fmt.Sprintf(` AND activates_at >= $%d`, len(args))
+	}
+	if filter.ActivatesTo != nil {
+		args = append(args, *filter.ActivatesTo)
+		where += fmt.Sprintf(` AND activates_at <= $%d`, len(args))
+	}
+	countArgs := len(args)
+
+	offset := filter.Offset
+	if filter.After != nil {
+		clause, err := afterKey(filter, &args)
+		if err != nil {
+			return nil, 0, err
+		}
+		where += ` AND ` + clause
+		offset = 0
+	}
+
+	count := !filter.SkipCount && filter.After == nil
+	columns := urlColumns
+	if count {
+		columns += `, COUNT(*) OVER ()`
+	}
+
+	args = append(args, filter.Limit, offset)
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+columns+` FROM urls `+where+
+			` ORDER BY `+orderBy(filter, query)+
+			fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list urls: %w", err)
+	}
+	defer rows.Close()
+
+	var total int64
+	var extra []interface{}
+	if count {
+		extra = append(extra, &total)
+	}
+	urls := make([]*domain.URL, 0, filter.Limit)
+	for rows.Next() {
+		u, err := scanURL(rows, extra...)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scan url: %w", err)
+		}
+		urls = append(urls, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate urls: %w", err)
+	}
+
+	// A page past the end has no rows to carry the total
+	if count && len(urls) == 0 && offset > 0 {
+		if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM urls `+where, args[:countArgs]...).Scan(&total); err != nil {
+			return nil, 0, fmt.Errorf("count urls: %w", err)
+		}
+	}
+	return urls, total, nil
+}
+
+// orderBy builds the ORDER BY clause; query is the tsquery expression when searching
+func orderBy(filter domain.ListFilter, query string) string {
+	dir := ` ASC`
+	if filter.Desc {
+		dir = ` DESC`
+	}
+
+	switch filter.SortBy {
+	case domain.SortRelevance:
+		if query != "" {
+			return `ts_rank_cd(search_vector, ` + query + `) DESC, created_at DESC, id DESC`
+		}
+	case domain.SortClickCount:
+		return `click_count` + dir + `, id` + dir
+	case domain.SortLastAccessed:
+		return `last_accessed` + dir + ` NULLS LAST, id` + dir
+	case domain.SortTitle:
+		return `lower(title)` + dir + `, id` + dir
+	case domain.SortCreatedAt:
+		return `created_at` + dir + `, id` + dir
+	}
+	return `created_at DESC, id DESC`
+}
+
+// afterKey builds the keyset condition selecting rows after filter.After in
+// the order produced by orderBy, appending its parameters to args
+func afterKey(filter domain.ListFilter, args *[]interface{}) (string, error) {
+	key := filter.After
+	cmp := `>`
+	if filter.Desc {
+		cmp = `<`
+	}
+
+	// Never accessed URLs sort last in both directions
+	if filter.SortBy == domain.SortLastAccessed {
+		*args = append(*args, key.ID)
+		idParam := len(*args)
+		if key.Value == nil {
+			return fmt.Sprintf(`(last_accessed IS NULL AND id %s $%d)`, cmp, idParam), nil
+		}
+		*args = append(*args, *key.Value)
+		return fmt.Sprintf(`(last_accessed IS NULL OR (last_accessed, id) %s ($%d::timestamptz, $%d))`,
+			cmp, len(*args), idParam), nil
+	}
+
+	var expr, param string
+	switch filter.SortBy {
+	case domain.SortClickCount:
+		expr, param = `click_count`, `$%d::bigint`
+	case domain.SortTitle:
+		expr, param = `lower(title)`, `lower($%d)`
+	case domain.SortCreatedAt, "":
+		expr, param = `created_at`, `$%d::timestamptz`
+	default:
+		return "", fmt.Errorf("keyset pagination is not supported for sort %q", filter.SortBy)
+	}
+	if key.Value == nil {
+		return "", fmt.Errorf("keyset value for sort %q is missing", filter.SortBy)
+	}
+
+	*args = append(*args, *key.Value, key.ID)
+	return fmt.Sprintf(`(%s, id) %s (`+param+`, $%d)`, expr, cmp, len(*args)-1, len(*args)), nil
+}
+
+// Exists reports whether a short code is in use
+func (r *URLRepository) Exists(ctx context.Context, id string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM urls WHERE id = $1)`, id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check url exists: %w", err)
+	}
+	return exists, nil
+}
+
+// ForEachID implements domain.URLRepository
+func (r *URLRepository) ForEachID(ctx context.Context, fn func(id string) error) error {
+	rows, err := r.db.QueryContext(ctx, `SELECT id FROM urls`)
+	if err != nil {
+		return fmt.Errorf("select url ids: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("scan url id: %w", err)
+		}
+		if err := fn(id); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate url ids: %w", err)
+	}
+	return nil
+}
+
+// ReplacePasswordHash implements domain.URLRepository
+func (r *URLRepository) ReplacePasswordHash(ctx context.Context, id, oldHash, newHash string, at time.Time) error {
+	query := `UPDATE urls SET password_hash = $3, updated_at = $4 WHERE id = $1 AND password_hash = $2`
+
+	res, err := r.db.ExecContext(ctx, query, id, oldHash, newHash, at)
+	if err != nil {
+		return fmt.Errorf("replace password hash: %w", err)
+	}
+	return expectAffected(res)
+}
+
+// IncrementClick bumps the click counter and returns the new count. The limit
+// check and the increment are one statement, so the row lock serializes
+// concurrent clicks from every replica and a limited URL never overshoots.
+func (r *URLRepository) IncrementClick(ctx context.Context, id string, at time.Time) (int64, error) {
+	query := `UPDATE urls SET click_count = click_count + 1, last_accessed = $2
+		WHERE id = $1 AND (max_clicks = 0 OR click_count < max_clicks)
+		RETURNING click_count`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, id, at).Scan(&count); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("increment click: %w", err)
+		}
+		exists, err := r.Exists(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if exists {
+			return 0, domain.ErrClickLimitReached
+		}
+		return 0, domain.ErrURLNotFound
+	}
+	return count, nil
+}
+
+// UpdateRules implements domain.URLRepository. SELECT ... FOR UPDATE holds the
+// row until the new rules are written, so concurrent editors take turns.
+func (r *URLRepository) UpdateRules(ctx context.Context, id string, at time.Time,
+	fn func(rules []domain.RedirectRule) ([]domain.RedirectRule, error)) ([]domain.RedirectRule, error) {
+	var updated []domain.RedirectRule
+	err := database.WithTx(ctx, r.db, func(tx *sql.Tx) error {
+		var data []byte
+		err := tx.QueryRowContext(ctx, `SELECT redirect_rules FROM urls WHERE id = $1 FOR UPDATE`, id).Scan(&data)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrURLNotFound
+			}
+			return fmt.Errorf("select redirect rules: %w", err)
+		}
+
+		var rules []domain.RedirectRule
+		if err := json.Unmarshal(data, &rules); err != nil {
+			return fmt.Errorf("decode redirect rules: %w", err)
+		}
+		if updated, err = fn(rules); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE urls SET redirect_rules = $2, updated_at = $3 WHERE id = $1`,
+			id, jsonArray(updated), at)
+		if err != nil {
+			return fmt.Errorf("update redirect rules: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Ping checks database connectivity
+func (r *URLRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// urlValues returns the values of urlColumns for u
+func urlValues(u *domain.URL) []interface{} {
+	return []interface{}{
+		u.ID, u.OriginalURL, u.UserID, u.Title, u.Description, u.PasswordHash, tagsValue(u.Tags),
+		u.IsActive, u.IsCustom, u.ClickCount, u.LastAccessed, u.CreatedAt, u.UpdatedAt, u.ExpiresAt, u.MaxClicks,
+		u.ActivatesAt, jsonArray(u.Rules), jsonArray(u.Variants), u.BlockedReason, u.ScannedAt,
+	}
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanURL reads urlColumns followed by any extra columns into extra
+func scanURL(row rowScanner, extra ...interface{}) (*domain.URL, error) {
+	var (
+		u            domain.URL
+		lastAccessed sql.NullTime
+		expiresAt    sql.NullTime
+		activatesAt  sql.NullTime
+		scannedAt    sql.NullTime
+		rules        []byte
+		variants     []byte
+	)
+
+	dest := []interface{}{
+		&u.ID, &u.OriginalURL, &u.UserID, &u.Title, &u.Description, &u.PasswordHash, pq.Array(&u.Tags),
+		&u.IsActive, &u.IsCustom, &u.ClickCount, &lastAccessed, &u.CreatedAt, &u.UpdatedAt, &expiresAt, &u.MaxClicks,
+		&activatesAt, &rules, &variants, &u.BlockedReason, &scannedAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastAccessed.Valid {
+		u.LastAccessed = &lastAccessed.Time
+	}
+	if expiresAt.Valid {
+		u.ExpiresAt = &expiresAt.Time
+	}
+	if activatesAt.Valid {
+		u.ActivatesAt = &activatesAt.Time
+	}
+	if scannedAt.Valid {
+		u.ScannedAt = &scannedAt.Time
+	}
+	if err := json.Unmarshal(rules, &u.Rules); err != nil {
+		return nil, fmt.Errorf("decode redirect rules: %w", err)
+	}
+	if err := json.Unmarshal(variants, &u.Variants); err != nil {
+		return nil, fmt.Errorf("decode variants: %w", err)
+	}
+	if len(u.Rules) == 0 {
+		u.Rules = nil
+	}
+	if len(u.Variants) == 0 {
+		u.Variants = nil
+	}
+	return &u, nil
+}
+
+// scanURLs reads and closes rows
+func scanURLs(rows *sql.Rows, capacity int) ([]*domain.URL, error) {
+	defer rows.Close()
+
+	urls := make([]*domain.URL, 0, capacity)
+	for rows.Next() {
+		u, err := scanURL(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan url: %w", err)
+		}
+		urls = append(urls, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate urls: %w", err)
+	}
+	return urls, nil
+}
+
+// tagsValue encodes tags as a non-NULL text array
+func tagsValue(tags []string) interface{} {
+	if tags == nil {
+		tags = []string{}
+	}
+	return pq.Array(tags)
+}
+
+// jsonArray encodes a JSONB array column, never null
+func jsonArray[T any](items []T) interface{} {
+	if items == nil {
+		items = []T{}
+	}
+	// Rules and variants hold only strings, numbers and times, which always encode
+	data, _ := json.Marshal(items)
+	return string(data)
+}
+
+func expectAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrURLNotFound
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+func TestURLRepository_Update_ModifiedSinceLoad(t *testing.T) {
+	stored := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		exists   bool
+		loadedAt time.Time
+		want     error
+	}{
+		{name: "unchanged", exists: true, loadedAt: stored},
+		{name: "written since", exists: true, loadedAt: stored.Add(-time.Minute), want: domain.ErrURLModified},
+		{name: "deleted", loadedAt: stored, want: domain.ErrURLNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, script := newScriptDB(t, func(query string, args []driver.Value) (*result, error) {
+				switch {
+				case strings.HasPrefix(query, "UPDATE urls"):
+					if tc.exists && args[12] == stored {
+						return &result{affected: 1}, nil
+					}
+					return &result{}, nil
+				case strings.Contains(query, "SELECT EXISTS"):
+					return &result{columns: []string{"exists"}, rows: [][]driver.Value{{tc.exists}}}, nil
+				}
+				return nil, nil
+			})
+
+			u := &domain.URL{ID: "abc", IsActive: true, UpdatedAt: stored.Add(time.Hour)}
+			err := NewURLRepository(db).Update(context.Background(), u, tc.loadedAt, nil, 0)
+			if tc.want == nil {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.want)
+			}
+
+			updates := script.statements("UPDATE urls")
+			require.Len(t, updates, 1)
+			assert.Contains(t, updates[0].query, "WHERE id = $1 AND updated_at = $13")
+			assert.Equal(t, tc.loadedAt, updates[0].args[12])
+			assert.Equal(t, u.UpdatedAt, updates[0].args[7])
+		})
+	}
+}
+
+func TestURLRepository_ReplacePasswordHash(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	db, script := newScriptDB(t, func(query string, args []driver.Value) (*result, error) {
+		if strings.HasPrefix(query, "UPDATE urls") && args[1] == "old" {
+			return &result{affected: 1}, nil
+		}
+		return &result{}, nil
+	})
+	repo := NewURLRepository(db)
+
+	require.NoError(t, repo.ReplacePasswordHash(context.Background(), "abc", "old", "new", at))
+	assert.ErrorIs(t, repo.ReplacePasswordHash(context.Background(), "abc", "changed", "new", at), domain.ErrURLNotFound)
+
+	// The upgrade counts as a write, so Update calls loaded before it conflict
+	updates := script.statements("UPDATE urls")
+	require.Len(t, updates, 2)
+	assert.Contains(t, updates[0].query, "updated_at = $4")
+	assert.Equal(t, []driver.Value{"abc", "old", "new", at}, updates[0].args)
+}
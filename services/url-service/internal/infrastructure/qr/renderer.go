@@ -0,0 +1,205 @@
+// Package qr renders short URLs as QR code images
+package qr
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/jpeg" // Logos may be JPEG
+	"image/png"
+	"os"
+	"strconv"
+
+	qrcode "github.com/skip2/go-qrcode"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// logoScale is the share of the code's width the logo box may cover. With
+// H error correction this leaves ample margin for scanning.
+const logoScale = 0.22
+
+var levels = map[string]qrcode.RecoveryLevel{
+	domain.QRLevelLow:      qrcode.Low,
+	domain.QRLevelMedium:   qrcode.Medium,
+	domain.QRLevelQuartile: qrcode.High,
+	domain.QRLevelHigh:     qrcode.Highest,
+}
+
+// Renderer implements domain.QRRenderer. It is safe for concurrent use.
+type Renderer struct {
+	logo    image.Image // Nil when no logo is configured
+	logoURI string      // Logo as a PNG data URI, for SVG output
+}
+
+// NewRenderer creates a renderer; logo may be nil
+func NewRenderer(logo image.Image) (*Renderer, error) {
+	r := &Renderer{logo: logo}
+	if logo != nil {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, logo); err != nil {
+			return nil, fmt.Errorf("encode logo: %w", err)
+		}
+		r.logoURI = "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+	return r, nil
+}
+
+// LoadLogo reads a PNG or JPEG logo
+func LoadLogo(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open logo: %w", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode logo: %w", err)
+	}
+	return img, nil
+}
+
+// HasLogo reports whether codes can be rendered with a logo
+func (r *Renderer) HasLogo() bool {
+	return r.logo != nil
+}
+
+// Render implements domain.QRRenderer
+func (r *Renderer) Render(_ context.Context, content string, opts domain.QROptions) ([]byte, error) {
+	level, ok := levels[opts.Level]
+	if !ok {
+		return nil, fmt.Errorf("unknown error correction level %q", opts.Level)
+	}
+	code, err := qrcode.New(content, level)
+	if err != nil {
+		return nil, fmt.Errorf("encode qr code: %w", err)
+	}
+	// Includes the four module quiet zone scanners need
+	bitmap := code.Bitmap()
+	logo := opts.Logo && r.logo != nil
+
+	switch opts.Format {
+	case domain.QRFormatPNG:
+		return r.png(bitmap, opts, logo)
+	case domain.QRFormatSVG:
+		return r.svg(bitmap, opts, logo), nil
+	default:
+		return nil, fmt.Errorf("unknown qr code format %q", opts.Format)
+	}
+}
+
+// png draws whole-pixel modules centred on a canvas of at least opts.Size, so
+// modules stay sharp; the leftover pixels widen the quiet zone
+func (r *Renderer) png(bitmap [][]bool, opts domain.QROptions, logo bool) ([]byte, error) {
+	modules := len(bitmap)
+	scale := max(opts.Size/modules, 1)
+	size := max(opts.Size, modules*scale)
+	offset := (size - modules*scale) / 2
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(opts.Background), image.Point{}, draw.Src)
+	fg := image.NewUniform(opts.Foreground)
+	for y, row := range bitmap {
+		for x, set := range row {
+			if set {
+				module := image.Rect(offset+x*scale, offset+y*scale, offset+(x+1)*scale, offset+(y+1)*scale)
+				draw.Draw(img, module, fg, image.Point{}, draw.Src)
+			}
+		}
+	}
+
+	if logo {
+		box := logoBox(modules*scale, offset, scale)
+		draw.Draw(img, box, image.NewUniform(opts.Background), image.Point{}, draw.Src)
+		inner := box.Inset(scale)
+		scaled := scaleToFit(r.logo, inner.Dx(), inner.Dy())
+		at := inner.Min.Add(image.Pt((inner.Dx()-scaled.Bounds().Dx())/2, (inner.Dy()-scaled.Bounds().Dy())/2))
+		draw.Draw(img, scaled.Bounds().Add(at), scaled, image.Point{}, draw.Over)
+	}
+
+	var buf bytes.Buffer
+	enc := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := enc.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// svg draws the code in module units, one path with a run per row of set modules
+func (r *Renderer) svg(bitmap [][]bool, opts domain.QROptions, logo bool) []byte {
+	modules := len(bitmap)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		opts.Size, opts.Size, modules, modules)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="%s"/>`, modules, modules, hexColor(opts.Background))
+
+	buf.WriteString(`<path fill="` + hexColor(opts.Foreground) + `" d="`)
+	for y, row := range bitmap {
+		for x := 0; x < len(row); {
+			if !row[x] {
+				x++
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			buf.WriteString("M" + strconv.Itoa(start) + " " + strconv.Itoa(y) + "h" + strconv.Itoa(x-start) + "v1h-" + strconv.Itoa(x-start) + "z")
+		}
+	}
+	buf.WriteString(`"/>`)
+
+	if logo {
+		box := logoBox(modules, 0, 1)
+		inner := box.Inset(1)
+		fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`,
+			box.Min.X, box.Min.Y, box.Dx(), box.Dy(), hexColor(opts.Background))
+		fmt.Fprintf(&buf, `<image x="%d" y="%d" width="%d" height="%d" href="%s"/>`,
+			inner.Min.X, inner.Min.Y, inner.Dx(), inner.Dy(), r.logoURI)
+	}
+	buf.WriteString(`</svg>`)
+	return buf.Bytes()
+}
+
+// logoBox returns the centred square covered by the logo and its margin, aligned
+// to whole modules of size scale in a code of side pixels starting at offset
+func logoBox(side, offset, scale int) image.Rectangle {
+	modules := side / scale
+	n := max(int(float64(modules)*logoScale), 3)
+	// Keep the box symmetric around the centre module
+	if n%2 != modules%2 {
+		n++
+	}
+	start := offset + (modules-n)/2*scale
+	return image.Rect(start, start, start+n*scale, start+n*scale)
+}
+
+// scaleToFit resizes img with nearest-neighbour sampling to fit w x h, keeping its aspect ratio
+func scaleToFit(img image.Image, w, h int) *image.RGBA {
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 || w <= 0 || h <= 0 {
+		return image.NewRGBA(image.Rectangle{})
+	}
+	if b.Dx()*h > b.Dy()*w {
+		h = max(b.Dy()*w/b.Dx(), 1)
+	} else {
+		w = max(b.Dx()*h/b.Dy(), 1)
+	}
+
+	out := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			out.Set(x, y, img.At(b.Min.X+x*b.Dx()/w, b.Min.Y+y*b.Dy()/h))
+		}
+	}
+	return out
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -0,0 +1,92 @@
+package qr
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	qrcode "github.com/skip2/go-qrcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+var (
+	black = color.RGBA{A: 0xff}
+	white = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	red   = color.RGBA{R: 0xff, A: 0xff}
+)
+
+func TestRenderer_PNG(t *testing.T) {
+	r, err := NewRenderer(nil)
+	require.NoError(t, err)
+
+	data, err := r.Render(context.Background(), "https://sho.rt/abc123?src=qr", domain.QROptions{
+		Format: domain.QRFormatPNG, Size: 300, Level: domain.QRLevelMedium, Foreground: black, Background: white,
+	})
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 300, 300), img.Bounds())
+
+	// Every module is drawn as a whole-pixel square, centred on the canvas
+	code, err := qrcode.New("https://sho.rt/abc123?src=qr", qrcode.Medium)
+	require.NoError(t, err)
+	bitmap := code.Bitmap()
+	scale := 300 / len(bitmap)
+	offset := (300 - len(bitmap)*scale) / 2
+	for y, row := range bitmap {
+		for x, set := range row {
+			want := white
+			if set {
+				want = black
+			}
+			at := img.At(offset+x*scale+scale/2, offset+y*scale+scale/2)
+			require.Equal(t, want, color.RGBAModel.Convert(at), "module %d,%d", x, y)
+		}
+	}
+	assert.Equal(t, white, color.RGBAModel.Convert(img.At(0, 0)))
+}
+
+func TestRenderer_SVG(t *testing.T) {
+	r, err := NewRenderer(nil)
+	require.NoError(t, err)
+
+	data, err := r.Render(context.Background(), "https://sho.rt/abc123?src=qr", domain.QROptions{
+		Format: domain.QRFormatSVG, Size: 512, Level: domain.QRLevelLow, Foreground: red, Background: white,
+	})
+	require.NoError(t, err)
+	svg := string(data)
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="512" height="512"`))
+	assert.Contains(t, svg, `fill="#ff0000"`)
+	assert.NotContains(t, svg, "<image")
+}
+
+func TestRenderer_Logo(t *testing.T) {
+	logo := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 10; x++ {
+			logo.Set(x, y, red)
+		}
+	}
+	r, err := NewRenderer(logo)
+	require.NoError(t, err)
+	opts := domain.QROptions{Size: 400, Level: domain.QRLevelHigh, Foreground: black, Background: white, Logo: true}
+
+	opts.Format = domain.QRFormatPNG
+	data, err := r.Render(context.Background(), "https://sho.rt/abc123?src=qr", opts)
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, red, color.RGBAModel.Convert(img.At(200, 200)))
+
+	opts.Format = domain.QRFormatSVG
+	data, err = r.Render(context.Background(), "https://sho.rt/abc123?src=qr", opts)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `href="data:image/png;base64,`)
+}
@@ -0,0 +1,128 @@
+// Package screening flags unsafe destination URLs using a local blocklist and
+// heuristics, without fetching them
+package screening
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// patternPrefix marks blocklist lines holding a regular expression
+const patternPrefix = "re:"
+
+// Blocklist is a parsed blocklist. It is immutable and safe for concurrent use.
+type Blocklist struct {
+	domains  map[string]struct{} // ASCII names, each also blocks its subdomains
+	networks []netip.Prefix      // Single addresses are stored as full-length prefixes
+	patterns []*regexp.Regexp
+}
+
+// LoadBlocklist reads a blocklist file, see ParseBlocklist
+func LoadBlocklist(path string) (*Blocklist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open blocklist: %w", err)
+	}
+	defer f.Close()
+	return ParseBlocklist(f)
+}
+
+// ParseBlocklist reads one entry per line: a domain name, which also blocks its
+// subdomains, an IP address, a CIDR network, or "re:" followed by a regular
+// expression matched against the whole URL. Blank lines and lines starting
+// with # are skipped. Internationalized names may be given in either form.
+func ParseBlocklist(r io.Reader) (*Blocklist, error) {
+	b := &Blocklist{domains: make(map[string]struct{})}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		if err := b.add(entry); err != nil {
+			return nil, fmt.Errorf("blocklist line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read blocklist: %w", err)
+	}
+	return b, nil
+}
+
+func (b *Blocklist) add(entry string) error {
+	if expr, ok := strings.CutPrefix(entry, patternPrefix); ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+		b.patterns = append(b.patterns, re)
+		return nil
+	}
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return fmt.Errorf("invalid network %q: %w", entry, err)
+		}
+		b.networks = append(b.networks, prefix.Masked())
+		return nil
+	}
+	if addr, err := netip.ParseAddr(strings.Trim(entry, "[]")); err == nil {
+		addr = addr.Unmap()
+		b.networks = append(b.networks, netip.PrefixFrom(addr, addr.BitLen()))
+		return nil
+	}
+
+	name, err := asciiHost(entry)
+	if err != nil {
+		return fmt.Errorf("invalid domain %q: %w", entry, err)
+	}
+	b.domains[name] = struct{}{}
+	return nil
+}
+
+// Len returns the number of entries
+func (b *Blocklist) Len() int {
+	return len(b.domains) + len(b.networks) + len(b.patterns)
+}
+
+// match returns why the URL raw with the ASCII host is listed, or "" if it is not
+func (b *Blocklist) match(raw, host string) string {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addr = addr.Unmap()
+		for _, network := range b.networks {
+			if network.Contains(addr) {
+				return fmt.Sprintf("address %s is blocklisted", addr)
+			}
+		}
+	} else {
+		// Walk up the name so listing a domain covers its subdomains
+		for name := host; name != ""; {
+			if _, ok := b.domains[name]; ok {
+				return fmt.Sprintf("domain %s is blocklisted", name)
+			}
+			_, name, _ = strings.Cut(name, ".")
+		}
+	}
+
+	for _, re := range b.patterns {
+		if re.MatchString(raw) {
+			return fmt.Sprintf("url matches blocklist pattern %q", re.String())
+		}
+	}
+	return ""
+}
+
+// asciiHost lower-cases a hostname, drops a trailing dot and converts
+// internationalized labels to punycode. The lenient profile accepts the
+// underscores and other oddities browsers resolve anyway.
+func asciiHost(host string) (string, error) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	return idna.Punycode.ToASCII(host)
+}
@@ -0,0 +1,79 @@
+package screening
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// confusables are Cyrillic and Greek letters rendered like Latin ones in most fonts
+const confusables = "аеорсухіјѕԁһӏԛԝοινκρυα"
+
+// ipHost reports whether the ASCII host addresses a machine by number: an IP
+// literal, or the decimal, octal and hex IPv4 forms browsers also resolve,
+// e.g. 3232235777 or 0xc0.0xa8.0.1
+func ipHost(host string) bool {
+	if _, err := netip.ParseAddr(strings.Trim(host, "[]")); err == nil {
+		return true
+	}
+	// As for browsers, a numeric last label makes the whole host an IPv4 address
+	labels := strings.Split(host, ".")
+	return numericLabel(labels[len(labels)-1])
+}
+
+func numericLabel(label string) bool {
+	digits := "0123456789"
+	if rest, ok := strings.CutPrefix(label, "0x"); ok {
+		label, digits = rest, "0123456789abcdef"
+	}
+	return label != "" && strings.Trim(label, digits) == ""
+}
+
+// homograph returns why a label of the ASCII host looks like it spoofs Latin
+// letters, or "" if none does. Labels mixing Latin with Cyrillic or Greek
+// letters are flagged, as are labels made only of Latin look-alikes.
+func homograph(host string) string {
+	for _, label := range strings.Split(host, ".") {
+		if !strings.HasPrefix(label, "xn--") {
+			continue
+		}
+		decoded, err := idna.Punycode.ToUnicode(label)
+		if err != nil {
+			return fmt.Sprintf("host label %s is not valid punycode", label)
+		}
+
+		var latin, cyrillic, greek, lookalikes, letters int
+		for _, r := range decoded {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+			letters++
+			switch {
+			case unicode.Is(unicode.Latin, r):
+				latin++
+			case unicode.Is(unicode.Cyrillic, r):
+				cyrillic++
+			case unicode.Is(unicode.Greek, r):
+				greek++
+			}
+			if strings.ContainsRune(confusables, r) {
+				lookalikes++
+			}
+		}
+
+		switch {
+		case latin > 0 && cyrillic > 0:
+			return fmt.Sprintf("host label %q mixes Latin and Cyrillic letters", decoded)
+		case latin > 0 && greek > 0:
+			return fmt.Sprintf("host label %q mixes Latin and Greek letters", decoded)
+		case cyrillic > 0 && greek > 0:
+			return fmt.Sprintf("host label %q mixes Cyrillic and Greek letters", decoded)
+		case letters > 0 && lookalikes == letters:
+			return fmt.Sprintf("host label %q imitates Latin letters", decoded)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+package screening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// errNoBlocklist is returned by Reload without a blocklist file
+var errNoBlocklist = errors.New("no blocklist file configured")
+
+// Options configures a Screener
+type Options struct {
+	BlocklistFile  string        // Empty screens by heuristics only
+	ReloadInterval time.Duration // How often the file is checked for changes, 0 disables reloading
+	BlockIPHosts   bool          // Flag destinations addressed by IP instead of name
+}
+
+// Screener implements domain.DestinationScreener. Reloads swap the blocklist
+// atomically, so screening never waits for one.
+type Screener struct {
+	opts Options
+	list atomic.Pointer[Blocklist]
+	log  *zap.Logger
+
+	mu    sync.Mutex // Serializes reloads
+	stamp fileStamp  // Version of the loaded file
+}
+
+// fileStamp tells file versions apart without reading them
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// New creates a screener, loading the blocklist file if one is configured
+func New(opts Options, log *zap.Logger) (*Screener, error) {
+	s := &Screener{opts: opts, log: log}
+	if opts.BlocklistFile == "" {
+		s.list.Store(&Blocklist{})
+		return s, nil
+	}
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Run reloads the blocklist every ReloadInterval until ctx is cancelled. A
+// file that fails to load is logged and the previous blocklist kept.
+func (s *Screener) Run(ctx context.Context) error {
+	if s.opts.BlocklistFile == "" || s.opts.ReloadInterval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(s.opts.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if _, err := s.Reload(); err != nil {
+			s.log.Error("failed to reload blocklist", zap.String("file", s.opts.BlocklistFile), zap.Error(err))
+		}
+	}
+}
+
+// Reload loads the blocklist file if it changed since the last load and
+// reports whether it did
+func (s *Screener) Reload() (bool, error) {
+	if s.opts.BlocklistFile == "" {
+		return false, errNoBlocklist
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.opts.BlocklistFile)
+	if err != nil {
+		return false, fmt.Errorf("stat blocklist: %w", err)
+	}
+	stamp := fileStamp{modTime: info.ModTime(), size: info.Size()}
+	if s.list.Load() != nil && stamp == s.stamp {
+		return false, nil
+	}
+
+	list, err := LoadBlocklist(s.opts.BlocklistFile)
+	if err != nil {
+		return false, err
+	}
+	s.list.Store(list)
+	s.stamp = stamp
+	s.log.Info("loaded blocklist", zap.String("file", s.opts.BlocklistFile), zap.Int("entries", list.Len()))
+	return true, nil
+}
+
+// Screen implements domain.DestinationScreener. URLs that do not parse are
+// left to validation.
+func (s *Screener) Screen(rawURL string) *domain.Threat {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	host, err := asciiHost(u.Hostname())
+	if err != nil {
+		return &domain.Threat{Source: domain.ThreatHeuristic, Reason: "host is not a valid domain name"}
+	}
+
+	if reason := s.list.Load().match(rawURL, host); reason != "" {
+		return &domain.Threat{Source: domain.ThreatBlocklist, Reason: reason}
+	}
+	if s.opts.BlockIPHosts && ipHost(host) {
+		return &domain.Threat{Source: domain.ThreatHeuristic, Reason: fmt.Sprintf("host %s is an IP address", host)}
+	}
+	if reason := homograph(host); reason != "" {
+		return &domain.Threat{Source: domain.ThreatHeuristic, Reason: reason}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package screening
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+const testBlocklist = `
+# Phishing kits
+evil.example
+ПРИМЕР.example.
+203.0.113.7
+198.51.100.0/24
+re:^https?://[^/]+/wp-content/.+\.exe$
+`
+
+func TestParseBlocklist_Rejects(t *testing.T) {
+	for _, entry := range []string{"re:(", "10.0.0.0/33"} {
+		_, err := ParseBlocklist(strings.NewReader(entry))
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestScreener_Screen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	require.NoError(t, os.WriteFile(path, []byte(testBlocklist), 0o600))
+	s, err := New(Options{BlocklistFile: path, BlockIPHosts: true}, zap.NewNop())
+	require.NoError(t, err)
+
+	cases := map[string]string{
+		"https://example.com/page":                   "",
+		"https://notevil.example/":                   "",
+		"https://evil.example/login":                 domain.ThreatBlocklist,
+		"https://Login.EVIL.example./":               domain.ThreatBlocklist,
+		"https://xn--e1afmkfd.example/":              domain.ThreatBlocklist,
+		"http://203.0.113.7/":                        domain.ThreatBlocklist,
+		"http://198.51.100.200:8080/":                domain.ThreatBlocklist,
+		"https://cdn.example.com/wp-content/a.exe":   domain.ThreatBlocklist,
+		"http://192.0.2.1/":                          domain.ThreatHeuristic,
+		"http://[2001:db8::1]/":                      domain.ThreatHeuristic,
+		"http://3232235777/":                         domain.ThreatHeuristic,
+		"http://0xc0.0xa8.0.1/":                      domain.ThreatHeuristic,
+		"https://xn--pple-43d.com/":                  domain.ThreatHeuristic, // Cyrillic а in apple
+		"https://xn--80ak6aa92e.com/":                domain.ThreatHeuristic, // All Cyrillic look-alikes
+		"https://xn--mnchen-3ya.de/":                 "",                     // münchen
+		"https://xn--e1afmkfd.xn--p1ai/":             "",                     // Cyrillic name and TLD
+		"https://www.xn--bcher-kva.example/b%C3%BCc": "",
+	}
+	for raw, want := range cases {
+		threat := s.Screen(raw)
+		if want == "" {
+			assert.Nil(t, threat, raw)
+			continue
+		}
+		if assert.NotNil(t, threat, raw) {
+			assert.Equal(t, want, threat.Source, raw)
+		}
+	}
+}
+
+func TestScreener_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	require.NoError(t, os.WriteFile(path, []byte("evil.example\n"), 0o600))
+	s, err := New(Options{BlocklistFile: path}, zap.NewNop())
+	require.NoError(t, err)
+
+	changed, err := s.Reload()
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Nil(t, s.Screen("http://192.0.2.1/"), "ip hosts are allowed unless configured")
+
+	require.NoError(t, os.WriteFile(path, []byte("bad.example\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	changed, err = s.Reload()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, s.Screen("https://evil.example/"))
+	assert.NotNil(t, s.Screen("https://bad.example/"))
+
+	// A broken file keeps the last good list
+	require.NoError(t, os.WriteFile(path, []byte("re:(\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	_, err = s.Reload()
+	assert.Error(t, err)
+	assert.NotNil(t, s.Screen("https://bad.example/"))
+}
@@ -0,0 +1,54 @@
+package userclient
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/url-service/internal/domain"
+)
+
+// OwnerDirectory implements domain.OwnerDirectory with the user service
+type OwnerDirectory struct {
+	client  userpb.UserServiceClient
+	timeout time.Duration
+}
+
+// NewOwnerDirectory creates an owner directory backed by the user service
+func NewOwnerDirectory(client userpb.UserServiceClient, timeout time.Duration) *OwnerDirectory {
+	return &OwnerDirectory{client: client, timeout: timeout}
+}
+
+// Owner implements domain.OwnerDirectory
+func (d *OwnerDirectory) Owner(ctx context.Context, userID string) (*domain.Owner, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	resp, err := d.client.GetUser(ctx, &userpb.GetUserRequest{UserId: userID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, domain.ErrOwnerNotFound
+		}
+		return nil, err
+	}
+
+	user := resp.GetUser()
+	if user == nil {
+		return nil, domain.ErrOwnerNotFound
+	}
+
+	name := user.GetFullName()
+	if name == "" {
+		name = user.GetUsername()
+	}
+	return &domain.Owner{
+		ID:                 user.GetId(),
+		Email:              user.GetEmail(),
+		Name:               name,
+		Active:             user.GetIsActive(),
+		EmailNotifications: user.GetSettings().GetEmailNotifications(),
+	}, nil
+}
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS urls (
+    id            VARCHAR(64)   PRIMARY KEY,
+    original_url  TEXT          NOT NULL,
+    user_id       VARCHAR(64)   NOT NULL DEFAULT '',
+    title         TEXT          NOT NULL DEFAULT '',
+    description   TEXT          NOT NULL DEFAULT '',
+    password_hash TEXT          NOT NULL DEFAULT '',
+    tags          TEXT[]        NOT NULL DEFAULT '{}',
+    is_active     BOOLEAN       NOT NULL DEFAULT TRUE,
+    is_custom     BOOLEAN       NOT NULL DEFAULT FALSE,
+    click_count   BIGINT        NOT NULL DEFAULT 0,
+    last_accessed TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    expires_at    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_urls_user_id_created_at ON urls (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_urls_expires_at ON urls (expires_at) WHERE expires_at IS NOT NULL;
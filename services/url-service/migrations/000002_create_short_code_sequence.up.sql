@@ -0,0 +1,2 @@
+-- Backs the "counter" short code strategy
+CREATE SEQUENCE IF NOT EXISTS short_code_seq START WITH 1 INCREMENT BY 1;
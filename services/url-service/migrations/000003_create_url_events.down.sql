@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_urls_active_expires_at;
+DROP TABLE IF EXISTS url_events;
+ALTER TABLE urls DROP COLUMN IF EXISTS expiry_notified_at;
@@ -0,0 +1,14 @@
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS expiry_notified_at TIMESTAMPTZ;
+
+-- Transactional outbox: events are written with the state change and relayed afterwards
+CREATE TABLE IF NOT EXISTS url_events (
+    id            BIGSERIAL     PRIMARY KEY,
+    type          VARCHAR(64)   NOT NULL,
+    aggregate_id  VARCHAR(64)   NOT NULL,
+    payload       JSONB         NOT NULL,
+    occurred_at   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    published_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_url_events_pending ON url_events (id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_urls_active_expires_at ON urls (expires_at) WHERE is_active AND expires_at IS NOT NULL;
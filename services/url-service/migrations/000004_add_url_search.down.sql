@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_urls_user_id_last_accessed;
+DROP INDEX IF EXISTS idx_urls_user_id_click_count;
+DROP INDEX IF EXISTS idx_urls_tags;
+DROP INDEX IF EXISTS idx_urls_search_vector;
+DROP TRIGGER IF EXISTS trg_urls_search_vector ON urls;
+DROP FUNCTION IF EXISTS urls_search_vector();
+ALTER TABLE urls DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,27 @@
+-- Full-text search over title, tags, description and the words of the destination.
+-- Maintained by a trigger because array_to_string is not immutable enough for a generated column.
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS search_vector TSVECTOR NOT NULL DEFAULT ''::tsvector;
+
+CREATE OR REPLACE FUNCTION urls_search_vector() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', coalesce(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', array_to_string(NEW.tags, ' ')), 'B') ||
+        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'C') ||
+        setweight(to_tsvector('english', regexp_replace(NEW.original_url, '[^[:alnum:]]+', ' ', 'g')), 'D');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_urls_search_vector ON urls;
+CREATE TRIGGER trg_urls_search_vector
+    BEFORE INSERT OR UPDATE OF title, tags, description, original_url ON urls
+    FOR EACH ROW EXECUTE FUNCTION urls_search_vector();
+
+-- Backfill existing rows through the trigger
+UPDATE urls SET title = title;
+
+CREATE INDEX IF NOT EXISTS idx_urls_search_vector ON urls USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_urls_tags ON urls USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_urls_user_id_click_count ON urls (user_id, click_count DESC);
+CREATE INDEX IF NOT EXISTS idx_urls_user_id_last_accessed ON urls (user_id, last_accessed DESC NULLS LAST);
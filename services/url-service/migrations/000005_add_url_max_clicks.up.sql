@@ -0,0 +1,3 @@
+-- Clicks allowed before the link stops resolving, 0 means unlimited
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS max_clicks BIGINT NOT NULL DEFAULT 0
+    CONSTRAINT urls_max_clicks_non_negative CHECK (max_clicks >= 0);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_urls_user_id_activates_at;
+ALTER TABLE urls DROP CONSTRAINT IF EXISTS urls_activation_window;
+ALTER TABLE urls DROP COLUMN IF EXISTS activates_at;
@@ -0,0 +1,6 @@
+-- Links may be created ahead of time and only start resolving at activates_at
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS activates_at TIMESTAMPTZ;
+ALTER TABLE urls ADD CONSTRAINT urls_activation_window
+    CHECK (activates_at IS NULL OR expires_at IS NULL OR activates_at < expires_at);
+
+CREATE INDEX IF NOT EXISTS idx_urls_user_id_activates_at ON urls (user_id, activates_at) WHERE activates_at IS NOT NULL;
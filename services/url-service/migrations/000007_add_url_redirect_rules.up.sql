@@ -0,0 +1,3 @@
+-- Ordered conditional redirect rules, evaluated before falling back to original_url
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS redirect_rules JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE urls ADD CONSTRAINT urls_redirect_rules_array CHECK (jsonb_typeof(redirect_rules) = 'array');
@@ -0,0 +1,2 @@
+ALTER TABLE urls DROP CONSTRAINT IF EXISTS urls_variants_array;
+ALTER TABLE urls DROP COLUMN IF EXISTS variants;
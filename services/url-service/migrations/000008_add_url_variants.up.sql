@@ -0,0 +1,3 @@
+-- Weighted A/B split destinations for visitors no redirect rule matches
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS variants JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE urls ADD CONSTRAINT urls_variants_array CHECK (jsonb_typeof(variants) = 'array');
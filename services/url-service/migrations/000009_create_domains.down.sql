@@ -0,0 +1,5 @@
+DELETE FROM urls WHERE id LIKE '%/%';
+ALTER TABLE url_events ALTER COLUMN aggregate_id TYPE VARCHAR(64);
+ALTER TABLE urls ALTER COLUMN id TYPE VARCHAR(64);
+
+DROP TABLE IF EXISTS domains;
@@ -0,0 +1,18 @@
+-- Hostnames users claim for branded links. Several users may claim a hostname,
+-- but only the one proving DNS control gets it verified.
+CREATE TABLE IF NOT EXISTS domains (
+    hostname           VARCHAR(253)  NOT NULL,
+    user_id            VARCHAR(64)   NOT NULL,
+    verification_token VARCHAR(64)   NOT NULL,
+    created_at         TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    verified_at        TIMESTAMPTZ,
+    checked_at         TIMESTAMPTZ,
+    PRIMARY KEY (hostname, user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_domains_verified_hostname ON domains (hostname) WHERE verified_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_domains_user_id_created_at ON domains (user_id, created_at DESC);
+
+-- Links on custom domains are stored as "<hostname>/<code>"
+ALTER TABLE urls ALTER COLUMN id TYPE VARCHAR(320);
+ALTER TABLE url_events ALTER COLUMN aggregate_id TYPE VARCHAR(320);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_urls_active_scanned_at;
+ALTER TABLE urls DROP COLUMN IF EXISTS scanned_at;
+ALTER TABLE urls DROP COLUMN IF EXISTS blocked_reason;
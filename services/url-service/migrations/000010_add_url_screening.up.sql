@@ -0,0 +1,6 @@
+-- Why destination screening deactivated a link, empty unless it was flagged
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS blocked_reason TEXT NOT NULL DEFAULT '';
+-- Last screening of the destinations, NULL until the first re-scan
+ALTER TABLE urls ADD COLUMN IF NOT EXISTS scanned_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_urls_active_scanned_at ON urls (scanned_at NULLS FIRST) WHERE is_active;
@@ -0,0 +1,11 @@
+-- Immutable edit history of links, pruned to the newest revisions per link
+CREATE TABLE IF NOT EXISTS url_revisions (
+    id             BIGSERIAL     PRIMARY KEY,
+    url_id         VARCHAR(320)  NOT NULL REFERENCES urls (id) ON DELETE CASCADE,
+    user_id        VARCHAR(64)   NOT NULL,
+    changes        JSONB         NOT NULL,
+    restored_from  BIGINT,
+    created_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_url_revisions_url_id ON url_revisions (url_id, id DESC);
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // Timezone settings are checked against the embedded zone database
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/pkg/grpcserver"
+	"github.com/url-shortener-microservices/pkg/httpserver"
+	"github.com/url-shortener-microservices/pkg/jwtauth"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/pkg/ratelimit"
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/application"
+	"github.com/url-shortener-microservices/services/user-service/internal/config"
+	"github.com/url-shortener-microservices/services/user-service/internal/delivery/grpchandler"
+	"github.com/url-shortener-microservices/services/user-service/internal/delivery/httphandler"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+	"github.com/url-shortener-microservices/services/user-service/internal/infrastructure/mailer"
+	"github.com/url-shortener-microservices/services/user-service/internal/infrastructure/postgres"
+	"github.com/url-shortener-microservices/services/user-service/internal/infrastructure/secretbox"
+	"github.com/url-shortener-microservices/services/user-service/internal/infrastructure/token"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to config directory")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		logger.Fatal("failed to load config", zap.Error(err))
+	}
+
+	log, err := logger.NewLogger(logger.Config{
+		Level:       cfg.Log.Level,
+		Format:      cfg.Log.Format,
+		Output:      cfg.Log.Output,
+		FilePath:    cfg.Log.FilePath,
+		MaxSize:     cfg.Log.MaxSize,
+		MaxBackups:  cfg.Log.MaxBackups,
+		MaxAge:      cfg.Log.MaxAge,
+		Compress:    cfg.Log.Compress,
+		ServiceName: config.ServiceName,
+	})
+	if err != nil {
+		logger.Fatal("failed to create logger", zap.Error(err))
+	}
+	logger.SetGlobalLogger(log)
+	defer log.Sync()
+
+	if err := run(cfg, log); err != nil {
+		log.Fatal("server stopped with error", zap.Error(err))
+	}
+}
+
+func run(cfg config.Config, log *logger.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	db, err := database.NewPostgres(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// Redis only backs two-factor attempt counters so far
+	var rdb *redis.Client
+	if cfg.TwoFactor.AttemptStore == "redis" {
+		if rdb, err = database.NewRedis(ctx, cfg.Redis); err != nil {
+			return err
+		}
+		defer rdb.Close()
+	}
+
+	keys, err := newKeyRing(cfg, time.Now())
+	if err != nil {
+		return err
+	}
+	guard, err := newTwoFactorGuard(cfg.TwoFactor, rdb)
+	if err != nil {
+		return err
+	}
+
+	emails, err := newAccountEmails(cfg.Email, postgres.NewAccountTokenRepository(db))
+	if err != nil {
+		return err
+	}
+
+	cursorSecret, err := secretOrRandom(cfg.List.CursorSecret, "list.cursor_secret", "pagination cursors", log)
+	if err != nil {
+		return err
+	}
+	cursors, err := pagination.NewCodec(cursorSecret)
+	if err != nil {
+		return err
+	}
+
+	service := application.NewUserService(postgres.NewUserRepository(db), postgres.NewSessionRepository(db),
+		postgres.NewTwoFactorRepository(db), postgres.NewAPIKeyRepository(db), newHasher(cfg.Password), guard, emails,
+		newTokenIssuer(cfg, keys), cursors, userOptions(cfg), log)
+
+	// The service signs access tokens itself, so it can always check them
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcserver.UnaryInterceptor(log),
+		grpchandler.AuthInterceptor(service, grpchandler.AccountMethods...),
+	))
+	userpb.RegisterUserServiceServer(grpcServer, grpchandler.NewServer(service))
+
+	var jwks jwtauth.JWKS
+	if keys != nil {
+		jwks = keys.JWKS()
+	}
+	router, err := httphandler.NewRouter(jwks)
+	if err != nil {
+		return err
+	}
+	httpServer, err := httpserver.NewServer(cfg.Server, router)
+	if err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", cfg.GRPC.GetGRPCAddr())
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		log.Info("HTTP server listening", zap.String("addr", httpServer.Addr()))
+		return httpServer.Run(ctx)
+	})
+	g.Go(func() error {
+		log.Info("gRPC server listening", zap.String("addr", lis.Addr().String()))
+		return grpcserver.Serve(ctx, grpcServer, lis, cfg.Server.ShutdownTimeout, log)
+	})
+
+	err = g.Wait()
+	log.Info("servers stopped")
+	return err
+}
+
+// newHasher builds the argon2id password hasher
+func newHasher(cfg config.PasswordConfig) *password.Hasher {
+	params := password.DefaultParams
+	params.Memory = uint32(cfg.Memory)
+	params.Iterations = uint32(cfg.Iterations)
+	params.Parallelism = uint8(cfg.Parallelism)
+	return password.NewHasher(params)
+}
+
+// newTwoFactorGuard builds the TOTP secret cipher and the configured attempt limiter
+func newTwoFactorGuard(cfg config.TwoFactorConfig, rdb *redis.Client) (*application.TwoFactorGuard, error) {
+	// The key and window were checked by config validation
+	key, _ := base64.StdEncoding.DecodeString(cfg.EncryptionKey)
+	window, _ := time.ParseDuration(cfg.AttemptWindow)
+
+	cipher, err := secretbox.NewAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var attempts ratelimit.AttemptLimiter
+	switch cfg.AttemptStore {
+	case "redis":
+		attempts = ratelimit.NewRedisLimiter(rdb, cfg.MaxAttempts, window, cfg.KeyPrefix)
+	default:
+		attempts = ratelimit.NewMemoryLimiter(cfg.MaxAttempts, window)
+	}
+	return application.NewTwoFactorGuard(cipher, attempts, cfg.Issuer), nil
+}
+
+// newAccountEmails builds the configured mail transport and the account email sender
+func newAccountEmails(cfg config.EmailConfig, tokens domain.AccountTokenRepository) (*application.AccountEmails, error) {
+	var (
+		transport domain.Mailer
+		err       error
+	)
+	switch cfg.Transport {
+	case "smtp":
+		transport, err = mailer.NewSMTP(mailer.SMTPOptions{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.From,
+		})
+	default:
+		transport, err = mailer.NewOutbox(cfg.OutboxDir, cfg.From)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Durations were checked by config validation
+	verificationTTL, _ := time.ParseDuration(cfg.VerificationTTL)
+	resetTTL, _ := time.ParseDuration(cfg.ResetTTL)
+	sendWindow, _ := time.ParseDuration(cfg.SendWindow)
+
+	return application.NewAccountEmails(tokens, transport, application.EmailOptions{
+		ProductName:     cfg.ProductName,
+		VerifyURL:       cfg.VerifyURL,
+		ResetURL:        cfg.ResetURL,
+		VerificationTTL: verificationTTL,
+		ResetTTL:        resetTTL,
+		MaxSends:        cfg.MaxSends,
+		SendWindow:      sendWindow,
+	}), nil
+}
+
+// newKeyRing loads the asymmetric access token keys, nil when none are configured
+func newKeyRing(cfg config.Config, now time.Time) (*jwtauth.KeyRing, error) {
+	if len(cfg.JWT.SigningKeys) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]jwtauth.SigningKey, 0, len(cfg.JWT.SigningKeys))
+	for _, c := range cfg.JWT.SigningKeys {
+		data, err := os.ReadFile(c.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", c.ID, err)
+		}
+		signer, err := jwtauth.ParsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", c.ID, err)
+		}
+		k := jwtauth.SigningKey{ID: c.ID, Key: signer}
+		if c.ActiveFrom != "" {
+			// Times were checked by config validation
+			k.ActiveFrom, _ = time.Parse(time.RFC3339, c.ActiveFrom)
+		}
+		keys = append(keys, k)
+	}
+
+	ring, err := jwtauth.NewKeyRing(keys)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ring.Current(now); err != nil {
+		return nil, errors.New("jwt.signing_keys: no key is active yet, set an active_from in the past")
+	}
+	return ring, nil
+}
+
+// newTokenIssuer builds the JWT issuer
+func newTokenIssuer(cfg config.Config, keys *jwtauth.KeyRing) *token.JWT {
+	return token.NewJWT(token.Options{
+		AccessSecret:  []byte(cfg.JWT.AccessTokenSecret),
+		RefreshSecret: []byte(cfg.JWT.RefreshTokenSecret),
+		SigningKeys:   keys,
+		Issuer:        cfg.JWT.Issuer,
+		Audience:      cfg.JWT.Audience,
+	})
+}
+
+// userOptions returns the account and session policy
+func userOptions(cfg config.Config) application.UserOptions {
+	// Durations were checked by config validation
+	accessTTL, _ := time.ParseDuration(cfg.JWT.AccessTokenExpiry)
+	rememberTTL, _ := time.ParseDuration(cfg.JWT.RefreshTokenExpiry)
+	sessionTTL, _ := time.ParseDuration(cfg.Session.TTL)
+
+	return application.UserOptions{
+		MinPasswordLength: cfg.Password.MinLength,
+		AccessTTL:         accessTTL,
+		SessionTTL:        sessionTTL,
+		RememberTTL:       rememberTTL,
+		RequireVerified:   cfg.Email.RequireVerified,
+		DefaultListLimit:  cfg.List.DefaultLimit,
+		MaxListLimit:      cfg.List.MaxLimit,
+	}
+}
+
+// secretOrRandom returns the configured secret, or a random one with a warning
+// that whatever it signs will not survive restarts or work across replicas
+func secretOrRandom(value, key, signs string, log *logger.Logger) ([]byte, error) {
+	if value != "" {
+		return []byte(value), nil
+	}
+
+	log.Warn(key + " is not set, " + signs + " will not survive restarts or work across replicas")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,367 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	texttemplate "text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Each email has a text and an HTML template named after its purpose
+var (
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html"))
+)
+
+var emailSubjects = map[string]string{
+	domain.PurposeVerifyEmail:   "Confirm your email address",
+	domain.PurposeResetPassword: "Reset your password",
+}
+
+// EmailOptions configures account emails
+type EmailOptions struct {
+	ProductName     string        // Names the service in emails
+	VerifyURL       string        // Page completing verification, the token is added as a query parameter
+	ResetURL        string        // Page completing password resets, the token is added as a query parameter
+	VerificationTTL time.Duration // Lifetime of verification links
+	ResetTTL        time.Duration // Lifetime of password reset links
+	MaxSends        int           // Emails per user and purpose within SendWindow
+	SendWindow      time.Duration
+}
+
+// AccountEmails issues single-use account links and mails them
+type AccountEmails struct {
+	tokens domain.AccountTokenRepository
+	mailer domain.Mailer
+	opts   EmailOptions
+}
+
+// NewAccountEmails creates the account email sender
+func NewAccountEmails(tokens domain.AccountTokenRepository, mailer domain.Mailer, opts EmailOptions) *AccountEmails {
+	return &AccountEmails{tokens: tokens, mailer: mailer, opts: opts}
+}
+
+// emailData is the data rendered by the email templates
+type emailData struct {
+	Product   string
+	Name      string
+	Email     string
+	Link      string
+	ExpiresIn string
+}
+
+// VerifyEmail marks the address a verification link was sent to as verified
+func (s *UserService) VerifyEmail(ctx context.Context, token string) (*domain.User, error) {
+	now := s.now()
+	t, err := s.consumeToken(ctx, domain.PurposeVerifyEmail, token, now)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.tokenUser(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.EmailVerified {
+		if err := s.repo.MarkEmailVerified(ctx, u.ID, now); err != nil {
+			return nil, s.internal(err, "failed to verify email")
+		}
+		u.EmailVerified, u.UpdatedAt = true, now
+		s.logger.Info("email verified", zap.String("user_id", u.ID))
+	}
+	s.revokeTokens(ctx, u.ID, domain.PurposeVerifyEmail, now)
+	return u, nil
+}
+
+// ResendVerification mails a new verification link. It succeeds for unknown
+// and already verified addresses too, so it does not reveal which exist.
+func (s *UserService) ResendVerification(ctx context.Context, email string) error {
+	u, err := s.accountByEmail(ctx, email)
+	if err != nil || u == nil {
+		return err
+	}
+	if u.IsActive && !u.EmailVerified {
+		s.mailToken(ctx, u, domain.PurposeVerifyEmail)
+	}
+	return nil
+}
+
+// ForgotPassword mails a password reset link. Like ResendVerification it
+// succeeds whether or not the address is registered.
+func (s *UserService) ForgotPassword(ctx context.Context, email string) error {
+	u, err := s.accountByEmail(ctx, email)
+	if err != nil || u == nil {
+		return err
+	}
+	if u.IsActive {
+		s.mailToken(ctx, u, domain.PurposeResetPassword)
+	}
+	return nil
+}
+
+// ResetPassword sets a new password with a reset link and signs the user out
+// of every session. The link proves the address is read, so it also verifies it.
+func (s *UserService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	// Checked first so a weak password does not spend the link
+	if err := ValidatePassword(newPassword, s.opts.MinPasswordLength); err != nil {
+		return err.WithField("new_password")
+	}
+
+	now := s.now()
+	t, err := s.consumeToken(ctx, domain.PurposeResetPassword, token, now)
+	if err != nil {
+		return err
+	}
+	u, err := s.tokenUser(ctx, t)
+	if err != nil {
+		return err
+	}
+	if !u.IsActive {
+		return apperrors.Forbidden("account is disabled")
+	}
+
+	hash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return s.internal(err, "failed to hash password")
+	}
+	// The password changes before sessions end: a login that checked the old
+	// password either starts its session in time to be revoked here or sees
+	// the change and drops it. Sessions a failure in between leaves alive
+	// cannot be refreshed, they started before the change.
+	if err := s.repo.UpdatePasswordHash(ctx, u.ID, hash, now); err != nil {
+		return s.internal(err, "failed to update password")
+	}
+	revoked, err := s.sessions.RevokeAll(ctx, u.ID, "", domain.RevokedOnPasswordReset, now)
+	if err != nil {
+		return s.internal(err, "failed to revoke sessions")
+	}
+	if !u.EmailVerified {
+		if err := s.repo.MarkEmailVerified(ctx, u.ID, now); err != nil {
+			return s.internal(err, "failed to verify email")
+		}
+	}
+	s.revokeTokens(ctx, u.ID, domain.PurposeResetPassword, now)
+
+	s.logger.Info("password reset", zap.String("user_id", u.ID), zap.Int("sessions_revoked", revoked))
+	return nil
+}
+
+// requireVerifiedEmail rejects users who have not verified their address. It
+// guards logins when RequireVerified is set, two-factor setup, and making a
+// profile public. Access tokens carry the email_verified claim so other
+// services can apply the same rule; the url service requires it for custom
+// domains and bulk creation.
+func requireVerifiedEmail(u *domain.User) error {
+	if !u.EmailVerified {
+		return apperrors.New(apperrors.CodeEmailNotVerified, "email address is not verified").WithField("email")
+	}
+	return nil
+}
+
+// accountByEmail looks up the account an email is requested for, nil if none
+func (s *UserService) accountByEmail(ctx context.Context, email string) (*domain.User, error) {
+	email = NormalizeEmail(email)
+	if err := ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	u, err := s.repo.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load user")
+	}
+	return u, nil
+}
+
+// mailToken issues a link for purpose and mails it to u, at most MaxSends
+// times per SendWindow. Failures are logged only: callers answer the same
+// whether or not mail went out, and the user can ask for another link.
+func (s *UserService) mailToken(ctx context.Context, u *domain.User, purpose string) {
+	log := s.logger.With(zap.String("user_id", u.ID), zap.String("purpose", purpose))
+	if err := s.emails.send(ctx, u, purpose, s.now()); err != nil {
+		if errors.Is(err, errSendLimit) {
+			log.Warn("account email limit reached")
+			return
+		}
+		log.Error("failed to send account email", zap.Error(err))
+	}
+}
+
+// errSendLimit is returned by send when the user was mailed MaxSends times within SendWindow
+var errSendLimit = errors.New("account email limit reached")
+
+// send issues a link for purpose and mails it to u
+func (e *AccountEmails) send(ctx context.Context, u *domain.User, purpose string, now time.Time) error {
+	n, err := e.tokens.CountSince(ctx, u.ID, purpose, now.Add(-e.opts.SendWindow))
+	if err != nil {
+		return err
+	}
+	if n >= e.opts.MaxSends {
+		return errSendLimit
+	}
+
+	token, hash, err := newAccountToken()
+	if err != nil {
+		return fmt.Errorf("generate token: %w", err)
+	}
+	base, ttl := e.opts.VerifyURL, e.opts.VerificationTTL
+	if purpose == domain.PurposeResetPassword {
+		base, ttl = e.opts.ResetURL, e.opts.ResetTTL
+	}
+	link, err := tokenLink(base, token)
+	if err != nil {
+		return err
+	}
+	err = e.tokens.Create(ctx, &domain.AccountToken{
+		Hash:      hash,
+		UserID:    u.ID,
+		Purpose:   purpose,
+		Email:     u.Email,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	msg, err := e.render(purpose, u.Email, emailData{
+		Product:   e.opts.ProductName,
+		Name:      displayName(u),
+		Email:     u.Email,
+		Link:      link,
+		ExpiresIn: humanDuration(ttl),
+	})
+	if err != nil {
+		return err
+	}
+	return e.mailer.Send(ctx, msg)
+}
+
+// render executes the text and HTML templates of purpose
+func (e *AccountEmails) render(purpose, to string, data emailData) (*domain.Message, error) {
+	var text, html bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, purpose+".txt", data); err != nil {
+		return nil, fmt.Errorf("render %s email: %w", purpose, err)
+	}
+	if err := htmlTemplates.ExecuteTemplate(&html, purpose+".html", data); err != nil {
+		return nil, fmt.Errorf("render %s email: %w", purpose, err)
+	}
+	return &domain.Message{
+		To:      to,
+		Subject: emailSubjects[purpose] + " - " + e.opts.ProductName,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// consumeToken spends a link token of purpose, rejecting unknown, used and expired ones
+func (s *UserService) consumeToken(ctx context.Context, purpose, token string, now time.Time) (*domain.AccountToken, error) {
+	if token == "" {
+		return nil, apperrors.Validation("token is required").WithField("token")
+	}
+	t, err := s.emails.tokens.Consume(ctx, purpose, hashAccountToken(token), now)
+	if errors.Is(err, domain.ErrAccountTokenNotFound) {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "link is invalid or was already used").WithField("token")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to check link")
+	}
+	if !now.Before(t.ExpiresAt) {
+		return nil, apperrors.New(apperrors.CodeExpiredToken, "link has expired").WithField("token")
+	}
+	return t, nil
+}
+
+// tokenUser loads the user a token was issued to. Links sent to an address the
+// account no longer has are invalid.
+func (s *UserService) tokenUser(ctx context.Context, t *domain.AccountToken) (*domain.User, error) {
+	u, err := s.repo.GetByID(ctx, t.UserID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "link is invalid or was already used").WithField("token")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load user")
+	}
+	if u.Email != t.Email {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "link was sent to a previous email address").WithField("token")
+	}
+	return u, nil
+}
+
+// revokeTokens spends the other outstanding links of a completed flow. Failures
+// are logged only, the links still expire.
+func (s *UserService) revokeTokens(ctx context.Context, userID, purpose string, now time.Time) {
+	if err := s.emails.tokens.RevokeAll(ctx, userID, purpose, now); err != nil {
+		s.logger.Warn("failed to revoke account links", zap.String("user_id", userID), zap.Error(err))
+	}
+}
+
+// newAccountToken returns a link token and the hash to store
+func newAccountToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, hashAccountToken(token), nil
+}
+
+// hashAccountToken hashes a link token. Tokens carry 256 random bits, so a
+// fast hash does not make them guessable.
+func hashAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenLink adds token to the query of base
+func tokenLink(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("link url: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// displayName is how emails greet a user
+func displayName(u *domain.User) string {
+	switch {
+	case u.FullName != "":
+		return u.FullName
+	case u.Username != "":
+		return u.Username
+	}
+	return "there"
+}
+
+// humanDuration formats link lifetimes, which are whole hours or minutes
+func humanDuration(d time.Duration) string {
+	n, unit := int(d/time.Minute), "minute"
+	if d >= time.Hour && d%time.Hour == 0 {
+		n, unit = int(d/time.Hour), "hour"
+	}
+	if n != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s", n, unit)
+}
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const adaEmail = "ada@example.com"
+
+type emailFixture struct {
+	svc    *UserService
+	users  *memoryUsers
+	mailer *memoryMailer
+	now    time.Time
+	userID string
+}
+
+func newEmailFixture(t *testing.T, opts UserOptions) *emailFixture {
+	f := &emailFixture{
+		users:  newMemoryUsers(),
+		mailer: &memoryMailer{},
+		now:    time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.svc = newClockedService(f.users, &f.now)
+	f.svc.emails = newTestEmails(f.mailer)
+	f.svc.opts = opts
+
+	u, err := f.svc.Register(context.Background(), RegisterInput{Email: adaEmail, Password: "correct horse", FullName: "Ada"})
+	require.NoError(t, err)
+	f.userID = u.ID
+	return f
+}
+
+func (f *emailFixture) login(password string) (*LoginResult, error) {
+	return f.svc.Login(context.Background(), LoginInput{Email: adaEmail, Password: password})
+}
+
+func TestUserService_VerifyEmail(t *testing.T) {
+	f := newEmailFixture(t, testOptions)
+	ctx := context.Background()
+
+	require.Equal(t, 1, f.mailer.count(adaEmail))
+	msg := f.mailer.sent[0]
+	assert.Equal(t, "Confirm your email address - URL Shortener", msg.Subject)
+	assert.Contains(t, msg.Text, "Hi Ada,")
+	assert.Contains(t, msg.Text, "expires in 48 hours")
+	assert.Contains(t, msg.HTML, `href="https://app.example.com/verify-email?token=`)
+	token := f.mailer.lastToken(adaEmail)
+	require.NotEmpty(t, token)
+
+	_, err := f.svc.VerifyEmail(ctx, "forged")
+	assertCode(t, err, apperrors.CodeInvalidToken, "token")
+	u, err := f.svc.VerifyEmail(ctx, token)
+	require.NoError(t, err)
+	assert.True(t, u.EmailVerified)
+	stored, err := f.users.GetByID(ctx, f.userID)
+	require.NoError(t, err)
+	assert.True(t, stored.EmailVerified)
+
+	_, err = f.svc.VerifyEmail(ctx, token)
+	assertCode(t, err, apperrors.CodeInvalidToken, "token")
+
+	// Verified and unknown addresses are answered alike, without mail
+	require.NoError(t, f.svc.ResendVerification(ctx, "ADA@example.com"))
+	require.NoError(t, f.svc.ResendVerification(ctx, "bob@example.com"))
+	assert.Len(t, f.mailer.sent, 1)
+	assertCode(t, f.svc.ResendVerification(ctx, "not an email"), apperrors.CodeValidation, "email")
+}
+
+func TestUserService_VerifyEmail_Expired(t *testing.T) {
+	f := newEmailFixture(t, testOptions)
+	token := f.mailer.lastToken(adaEmail)
+
+	f.now = f.now.Add(48 * time.Hour)
+	_, err := f.svc.VerifyEmail(context.Background(), token)
+	assertCode(t, err, apperrors.CodeExpiredToken, "token")
+}
+
+func TestUserService_ResendVerification_Limit(t *testing.T) {
+	f := newEmailFixture(t, testOptions)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, f.svc.ResendVerification(ctx, adaEmail))
+	}
+	assert.Equal(t, 3, f.mailer.count(adaEmail))
+
+	f.now = f.now.Add(time.Hour)
+	require.NoError(t, f.svc.ResendVerification(ctx, adaEmail))
+	assert.Equal(t, 4, f.mailer.count(adaEmail))
+
+	// Every link sent stays valid until one is used
+	_, err := f.svc.VerifyEmail(ctx, f.mailer.lastToken(adaEmail))
+	require.NoError(t, err)
+}
+
+func TestUserService_ResetPassword(t *testing.T) {
+	f := newEmailFixture(t, testOptions)
+	ctx := context.Background()
+
+	first, err := f.login("correct horse")
+	require.NoError(t, err)
+	require.NoError(t, f.svc.ForgotPassword(ctx, "bob@example.com"))
+	require.NoError(t, f.svc.ForgotPassword(ctx, adaEmail))
+	earlier := f.mailer.lastToken(adaEmail)
+	require.NoError(t, f.svc.ForgotPassword(ctx, adaEmail))
+	require.Equal(t, 3, f.mailer.count(adaEmail))
+	msg := f.mailer.sent[len(f.mailer.sent)-1]
+	assert.Equal(t, "Reset your password - URL Shortener", msg.Subject)
+	assert.Contains(t, msg.Text, "https://app.example.com/reset-password?lang=en&token=")
+	assert.Contains(t, msg.Text, "expires in 1 hour.")
+	token := f.mailer.lastToken(adaEmail)
+
+	// A weak password does not spend the link
+	err = f.svc.ResetPassword(ctx, token, "short")
+	assertCode(t, err, apperrors.CodeValidation, "new_password")
+	// Links only complete the flow they were sent for
+	_, err = f.svc.VerifyEmail(ctx, token)
+	assertCode(t, err, apperrors.CodeInvalidToken, "token")
+
+	require.NoError(t, f.svc.ResetPassword(ctx, token, "battery staple"))
+	_, err = f.login("correct horse")
+	assertCode(t, err, apperrors.CodeInvalidCredentials, "")
+	_, err = f.login("battery staple")
+	require.NoError(t, err)
+
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: first.Tokens.RefreshToken})
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+	stored, err := f.users.GetByID(ctx, f.userID)
+	require.NoError(t, err)
+	assert.True(t, stored.EmailVerified)
+
+	for _, used := range []string{token, earlier} {
+		err = f.svc.ResetPassword(ctx, used, "another password")
+		assertCode(t, err, apperrors.CodeInvalidToken, "token")
+	}
+}
+
+func TestUserService_Login_RequireVerified(t *testing.T) {
+	opts := testOptions
+	opts.RequireVerified = true
+	f := newEmailFixture(t, opts)
+
+	_, err := f.login("wrong password")
+	assertCode(t, err, apperrors.CodeInvalidCredentials, "")
+	_, err = f.login("correct horse")
+	assertCode(t, err, apperrors.CodeEmailNotVerified, "email")
+
+	_, err = f.svc.VerifyEmail(context.Background(), f.mailer.lastToken(adaEmail))
+	require.NoError(t, err)
+	_, err = f.login("correct horse")
+	require.NoError(t, err)
+}
+
+// racingSessions runs beforeCreate once, right before the next session is stored
+type racingSessions struct {
+	*memorySessions
+	beforeCreate func()
+}
+
+func (r *racingSessions) Create(ctx context.Context, s *domain.Session) error {
+	if fn := r.beforeCreate; fn != nil {
+		r.beforeCreate = nil
+		fn()
+	}
+	return r.memorySessions.Create(ctx, s)
+}
+
+func TestUserService_ResetPassword_DuringLogin(t *testing.T) {
+	f := newEmailFixture(t, testOptions)
+	ctx := context.Background()
+	sessions := &racingSessions{memorySessions: newMemorySessions()}
+	f.svc.sessions = sessions
+
+	before, err := f.login("correct horse")
+	require.NoError(t, err)
+	require.NoError(t, f.svc.ForgotPassword(ctx, adaEmail))
+	token := f.mailer.lastToken(adaEmail)
+
+	// The reset completes after the login checked the old password but before
+	// its session is stored, so it cannot revoke that session itself
+	sessions.beforeCreate = func() {
+		f.now = f.now.Add(time.Second)
+		require.NoError(t, f.svc.ResetPassword(ctx, token, "battery staple"))
+	}
+	_, err = f.login("correct horse")
+	assertCode(t, err, apperrors.CodeInvalidCredentials, "")
+	active, err := f.svc.ListSessions(ctx, f.userID)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+
+	// Sessions from before the reset cannot be renewed even if they were missed
+	sessions.sessions[before.Session.ID].RevokedAt = nil
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: before.Tokens.RefreshToken})
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+}
@@ -0,0 +1,247 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const (
+	apiKeyPrefix        = "usk_"
+	apiKeyPrefixLength  = 12 // Characters of the raw key kept for display
+	maxAPIKeyNameLength = 100
+	maxAPIKeyScopes     = 20
+	maxAPIKeyScopeLen   = 100
+)
+
+// CreateAPIKeyInput holds the settings of a new API key
+type CreateAPIKeyInput struct {
+	UserID      string
+	Name        string
+	Permissions []string // Defaults to read only
+	Scopes      []string
+	RateLimit   int64      // Requests per hour, 0 for the account default
+	ExpiresAt   *time.Time // Optional
+}
+
+// CreateAPIKeyResult holds a new key and the raw key, which is not stored
+type CreateAPIKeyResult struct {
+	Key    *domain.APIKey
+	RawKey string
+}
+
+// ListAPIKeysInput holds criteria for listing a user's API keys
+type ListAPIKeysInput struct {
+	UserID string
+	SortBy string // Only created_at, defaults to newest first
+	Desc   bool
+	Page   int
+	Limit  int
+	Cursor string // NextCursor of the previous page, takes precedence over Page
+}
+
+// ListAPIKeysResult holds a page of API keys
+type ListAPIKeysResult struct {
+	Keys       []*domain.APIKey
+	Total      int64
+	Page       int
+	Limit      int
+	NextCursor string // Empty on the last page
+}
+
+// CreateAPIKey issues an API key for a user. The raw key is returned once;
+// only its SHA-256 hash is stored.
+func (s *UserService) CreateAPIKey(ctx context.Context, in CreateAPIKeyInput) (*CreateAPIKeyResult, error) {
+	if in.UserID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, apperrors.Validation("name is required").WithField("name")
+	}
+	if utf8.RuneCountInString(name) > maxAPIKeyNameLength {
+		return nil, apperrors.Validationf("name exceeds %d characters", maxAPIKeyNameLength).WithField("name")
+	}
+	permissions, appErr := normalizePermissions(in.Permissions)
+	if appErr != nil {
+		return nil, appErr
+	}
+	scopes, appErr := normalizeScopes(in.Scopes)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if in.RateLimit < 0 {
+		return nil, apperrors.Validation("rate_limit cannot be negative").WithField("rate_limit")
+	}
+	now := s.now()
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(now) {
+		return nil, apperrors.Validation("expires_at must be in the future").WithField("expires_at")
+	}
+
+	raw, err := newAPIKey()
+	if err != nil {
+		return nil, s.internal(err, "failed to generate api key")
+	}
+	k := &domain.APIKey{
+		UserID:      in.UserID,
+		Name:        name,
+		KeyHash:     hashAccountToken(raw),
+		KeyPrefix:   raw[:apiKeyPrefixLength],
+		Permissions: permissions,
+		Scopes:      scopes,
+		RateLimit:   in.RateLimit,
+		CreatedAt:   now,
+		ExpiresAt:   in.ExpiresAt,
+	}
+	if err := s.apiKeys.Create(ctx, k); err != nil {
+		return nil, s.internal(err, "failed to create api key")
+	}
+	return &CreateAPIKeyResult{Key: k, RawKey: raw}, nil
+}
+
+// ListAPIKeys returns a page of a user's API keys, revoked and expired ones
+// included
+func (s *UserService) ListAPIKeys(ctx context.Context, in ListAPIKeysInput) (*ListAPIKeysResult, error) {
+	if in.UserID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	filter := domain.APIKeyFilter{UserID: in.UserID, Desc: in.Desc}
+	switch in.SortBy {
+	case "":
+		filter.Desc = true
+	case "created_at":
+	default:
+		return nil, apperrors.Validation("sort_by must be created_at").WithField("pagination.sort_by")
+	}
+
+	page, limit := s.normalizePage(in.Page, in.Limit)
+	scope := pagination.Scope("api_keys", filter.UserID, strconv.FormatBool(filter.Desc))
+
+	var cur *pagination.Cursor
+	if in.Cursor != "" {
+		c, err := s.decodeListCursor(in.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, c.Keys[0])
+		if err != nil {
+			return nil, apperrors.Validation("cursor is invalid or does not match the request").WithField("pagination.cursor")
+		}
+		cur, page = c, c.Page
+		filter.After = &domain.APIKeyKey{CreatedAt: createdAt, ID: c.Keys[1]}
+		filter.SkipCount = true
+	} else {
+		filter.Offset = (page - 1) * limit
+	}
+
+	// One extra row tells whether another page follows
+	filter.Limit = limit + 1
+	keys, total, err := s.apiKeys.List(ctx, filter)
+	if err != nil {
+		return nil, s.internal(err, "failed to list api keys")
+	}
+	if cur != nil {
+		total = cur.Total
+	}
+
+	res := &ListAPIKeysResult{Keys: keys, Total: total, Page: page, Limit: limit}
+	if len(keys) > limit {
+		res.Keys = keys[:limit]
+		if res.NextCursor, err = s.nextAPIKeyCursor(scope, res); err != nil {
+			return nil, s.internal(err, "failed to encode cursor")
+		}
+	}
+	return res, nil
+}
+
+// nextAPIKeyCursor encodes the position after the last key of res
+func (s *UserService) nextAPIKeyCursor(scope string, res *ListAPIKeysResult) (string, error) {
+	if s.cursors == nil {
+		return "", nil
+	}
+
+	last := res.Keys[len(res.Keys)-1]
+	return s.cursors.Encode(pagination.Cursor{
+		Scope: scope,
+		Keys:  []string{last.CreatedAt.UTC().Format(time.RFC3339Nano), last.ID},
+		Page:  res.Page + 1,
+		Total: res.Total,
+	})
+}
+
+// RevokeAPIKey revokes one of a user's API keys
+func (s *UserService) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	if userID == "" {
+		return apperrors.Unauthorized("authentication required")
+	}
+	if keyID == "" {
+		return apperrors.Validation("key_id is required").WithField("key_id")
+	}
+	err := s.apiKeys.Revoke(ctx, userID, keyID, s.now())
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		return apperrors.NotFound("api key not found").WithField("key_id")
+	}
+	if err != nil {
+		return s.internal(err, "failed to revoke api key")
+	}
+	return nil
+}
+
+// normalizePermissions checks permissions against the known ones and removes
+// duplicates; none means read only
+func normalizePermissions(permissions []string) ([]string, *apperrors.AppError) {
+	if len(permissions) == 0 {
+		return []string{domain.PermissionRead}, nil
+	}
+	out := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		p = strings.ToLower(strings.TrimSpace(p))
+		switch p {
+		case domain.PermissionRead, domain.PermissionWrite, domain.PermissionDelete:
+		default:
+			return nil, apperrors.Validationf("unknown permission %q", p).WithField("permissions")
+		}
+		if !slices.Contains(out, p) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// normalizeScopes trims scopes and removes empty and duplicate ones
+func normalizeScopes(scopes []string) ([]string, *apperrors.AppError) {
+	out := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope == "" || slices.Contains(out, scope) {
+			continue
+		}
+		if utf8.RuneCountInString(scope) > maxAPIKeyScopeLen {
+			return nil, apperrors.Validationf("scope exceeds %d characters", maxAPIKeyScopeLen).WithField("scopes")
+		}
+		out = append(out, scope)
+	}
+	if len(out) > maxAPIKeyScopes {
+		return nil, apperrors.Validationf("at most %d scopes are allowed", maxAPIKeyScopes).WithField("scopes")
+	}
+	return out, nil
+}
+
+// newAPIKey returns a raw API key carrying 256 random bits
+func newAPIKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,170 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const apiKeyOwner = "00000000-0000-0000-0000-000000000001"
+
+// newAPIKeyService creates five keys for apiKeyOwner, the last two at the
+// same instant, and returns their names oldest first
+func newAPIKeyService(t *testing.T) (*UserService, []string) {
+	svc := newTestService(newMemoryUsers())
+	svc.opts.DefaultListLimit, svc.opts.MaxListLimit = 2, 3
+	var err error
+	svc.cursors, err = pagination.NewCodec([]byte(strings.Repeat("k", pagination.MinSecretLength)))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	names := make([]string, 5)
+	for i := range names {
+		names[i] = fmt.Sprintf("key %d", i)
+		_, err := svc.CreateAPIKey(context.Background(), CreateAPIKeyInput{UserID: apiKeyOwner, Name: names[i]})
+		require.NoError(t, err)
+		if i < 3 {
+			now = now.Add(time.Second)
+		}
+	}
+	return svc, names
+}
+
+func keyNames(keys []*domain.APIKey) []string {
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = k.Name
+	}
+	return out
+}
+
+func TestUserService_CreateAPIKey(t *testing.T) {
+	svc := newTestService(newMemoryUsers())
+	ctx := context.Background()
+
+	res, err := svc.CreateAPIKey(ctx, CreateAPIKeyInput{
+		UserID:      apiKeyOwner,
+		Name:        " deploy ",
+		Permissions: []string{"Write", "read", "write"},
+		Scopes:      []string{"urls", " ", "urls"},
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(res.RawKey, apiKeyPrefix))
+	assert.Equal(t, res.RawKey[:apiKeyPrefixLength], res.Key.KeyPrefix)
+	assert.Equal(t, hashAccountToken(res.RawKey), res.Key.KeyHash)
+	assert.Equal(t, "deploy", res.Key.Name)
+	assert.Equal(t, []string{domain.PermissionWrite, domain.PermissionRead}, res.Key.Permissions)
+	assert.Equal(t, []string{"urls"}, res.Key.Scopes)
+
+	res, err = svc.CreateAPIKey(ctx, CreateAPIKeyInput{UserID: apiKeyOwner, Name: "reader"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{domain.PermissionRead}, res.Key.Permissions)
+
+	past := svc.now().Add(-time.Hour)
+	for field, in := range map[string]CreateAPIKeyInput{
+		"name":        {UserID: apiKeyOwner},
+		"permissions": {UserID: apiKeyOwner, Name: "admin", Permissions: []string{"admin"}},
+		"rate_limit":  {UserID: apiKeyOwner, Name: "negative", RateLimit: -1},
+		"expires_at":  {UserID: apiKeyOwner, Name: "expired", ExpiresAt: &past},
+	} {
+		_, err := svc.CreateAPIKey(ctx, in)
+		assertCode(t, err, apperrors.CodeValidation, field)
+	}
+}
+
+func TestUserService_ListAPIKeys_Cursor(t *testing.T) {
+	svc, names := newAPIKeyService(t)
+	ctx := context.Background()
+
+	var byCursor []string
+	in := ListAPIKeysInput{UserID: apiKeyOwner}
+	for page := 1; ; page++ {
+		res, err := svc.ListAPIKeys(ctx, in)
+		require.NoError(t, err)
+		assert.Equal(t, page, res.Page)
+		assert.Equal(t, int64(5), res.Total)
+		assert.Equal(t, 2, res.Limit)
+
+		// Page numbers keep working and agree with the cursor
+		numbered, err := svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner, Page: page})
+		require.NoError(t, err)
+		assert.Equal(t, keyNames(numbered.Keys), keyNames(res.Keys))
+
+		byCursor = append(byCursor, keyNames(res.Keys)...)
+		if res.NextCursor == "" {
+			break
+		}
+		in.Cursor = res.NextCursor
+	}
+	// Newest first, ties broken by ID
+	assert.Equal(t, []string{names[4], names[3], names[2], names[1], names[0]}, byCursor)
+
+	res, err := svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner, SortBy: "created_at", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, names[:3], keyNames(res.Keys))
+	res, err = svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner, SortBy: "created_at", Cursor: res.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, names[3:], keyNames(res.Keys))
+	assert.Empty(t, res.NextCursor)
+
+	// Other users see none of the keys
+	res, err = svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: "00000000-0000-0000-0000-000000000002"})
+	require.NoError(t, err)
+	assert.Empty(t, res.Keys)
+	assert.Zero(t, res.Total)
+}
+
+func TestUserService_ListAPIKeys_RejectsCursor(t *testing.T) {
+	svc, _ := newAPIKeyService(t)
+	ctx := context.Background()
+
+	first, err := svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextCursor)
+
+	for name, in := range map[string]ListAPIKeysInput{
+		"forged":      {UserID: apiKeyOwner, Cursor: "e30.AAAA"},
+		"other user":  {UserID: "00000000-0000-0000-0000-000000000002", Cursor: first.NextCursor},
+		"other order": {UserID: apiKeyOwner, Cursor: first.NextCursor, SortBy: "created_at"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.ListAPIKeys(ctx, in)
+			assertCode(t, err, apperrors.CodeValidation, "pagination.cursor")
+		})
+	}
+
+	_, err = svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner, SortBy: "key_hash"})
+	assertCode(t, err, apperrors.CodeValidation, "pagination.sort_by")
+}
+
+func TestUserService_RevokeAPIKey(t *testing.T) {
+	svc, _ := newAPIKeyService(t)
+	ctx := context.Background()
+
+	res, err := svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner, Limit: 1})
+	require.NoError(t, err)
+	key := res.Keys[0]
+	assert.True(t, key.IsActive(svc.now()))
+
+	err = svc.RevokeAPIKey(ctx, "00000000-0000-0000-0000-000000000002", key.ID)
+	assertCode(t, err, apperrors.CodeNotFound, "key_id")
+	require.NoError(t, svc.RevokeAPIKey(ctx, apiKeyOwner, key.ID))
+	err = svc.RevokeAPIKey(ctx, apiKeyOwner, key.ID)
+	assertCode(t, err, apperrors.CodeNotFound, "key_id")
+
+	// Revoked keys stay listed as inactive
+	res, err = svc.ListAPIKeys(ctx, ListAPIKeysInput{UserID: apiKeyOwner, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, key.ID, res.Keys[0].ID)
+	assert.False(t, res.Keys[0].IsActive(svc.now()))
+}
@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const maxUserSearchLength = 100
+
+// ListUsersInput holds criteria for listing accounts
+type ListUsersInput struct {
+	Search      string // Matches email, username and full name
+	PremiumOnly bool
+	ActiveOnly  bool
+	SortBy      string // created_at or email, defaults to newest first
+	Desc        bool
+	Page        int
+	Limit       int
+	Cursor      string // NextCursor of the previous page, takes precedence over Page
+}
+
+// ListUsersResult holds a page of accounts
+type ListUsersResult struct {
+	Users      []*domain.User
+	Total      int64
+	Page       int
+	Limit      int
+	NextCursor string // Empty on the last page
+}
+
+// ListUsers returns a page of accounts. It is an admin operation, callers
+// must have checked the admin role.
+func (s *UserService) ListUsers(ctx context.Context, in ListUsersInput) (*ListUsersResult, error) {
+	search := strings.ToLower(strings.TrimSpace(in.Search))
+	if utf8.RuneCountInString(search) > maxUserSearchLength {
+		return nil, apperrors.Validationf("search exceeds %d characters", maxUserSearchLength).WithField("search")
+	}
+
+	filter := domain.UserFilter{
+		Search:      search,
+		PremiumOnly: in.PremiumOnly,
+		ActiveOnly:  in.ActiveOnly,
+		Now:         s.now(),
+		SortBy:      in.SortBy,
+		Desc:        in.Desc,
+	}
+	switch filter.SortBy {
+	case "":
+		filter.SortBy, filter.Desc = domain.UserSortCreatedAt, true
+	case domain.UserSortCreatedAt, domain.UserSortEmail:
+	default:
+		return nil, apperrors.Validation("sort_by must be created_at or email").WithField("pagination.sort_by")
+	}
+
+	page, limit := s.normalizePage(in.Page, in.Limit)
+	scope := userListScope(filter)
+
+	var cur *pagination.Cursor
+	if in.Cursor != "" {
+		c, err := s.decodeListCursor(in.Cursor, scope)
+		if err != nil {
+			return nil, err
+		}
+		cur, page = c, c.Page
+		filter.After = &domain.UserKey{Value: c.Keys[0], ID: c.Keys[1]}
+		filter.SkipCount = true
+	} else {
+		filter.Offset = (page - 1) * limit
+	}
+
+	// One extra row tells whether another page follows
+	filter.Limit = limit + 1
+	users, total, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, s.internal(err, "failed to list users")
+	}
+	if cur != nil {
+		total = cur.Total
+	}
+
+	res := &ListUsersResult{Users: users, Total: total, Page: page, Limit: limit}
+	if len(users) > limit {
+		res.Users = users[:limit]
+		if res.NextCursor, err = s.nextUserCursor(scope, filter, res); err != nil {
+			return nil, s.internal(err, "failed to encode cursor")
+		}
+	}
+	return res, nil
+}
+
+// userListScope fingerprints everything that defines a ListUsers result set,
+// so a cursor cannot be replayed with other filters
+func userListScope(filter domain.UserFilter) string {
+	return pagination.Scope(
+		"users",
+		filter.Search,
+		strconv.FormatBool(filter.PremiumOnly),
+		strconv.FormatBool(filter.ActiveOnly),
+		filter.SortBy,
+		strconv.FormatBool(filter.Desc),
+	)
+}
+
+// decodeListCursor verifies a keyset cursor issued for scope
+func (s *UserService) decodeListCursor(token, scope string) (*pagination.Cursor, error) {
+	invalid := apperrors.Validation("cursor is invalid or does not match the request").WithField("pagination.cursor")
+	if s.cursors == nil {
+		return nil, invalid
+	}
+
+	cur, err := s.cursors.Decode(token, scope)
+	if err != nil || cur.Page < 2 || len(cur.Keys) != 2 {
+		return nil, invalid
+	}
+	return &cur, nil
+}
+
+// nextUserCursor encodes the position after the last user of res
+func (s *UserService) nextUserCursor(scope string, filter domain.UserFilter, res *ListUsersResult) (string, error) {
+	if s.cursors == nil {
+		return "", nil
+	}
+
+	last := res.Users[len(res.Users)-1]
+	value := last.CreatedAt.UTC().Format(time.RFC3339Nano)
+	if filter.SortBy == domain.UserSortEmail {
+		value = last.Email
+	}
+	return s.cursors.Encode(pagination.Cursor{
+		Scope: scope,
+		Keys:  []string{value, last.ID},
+		Page:  res.Page + 1,
+		Total: res.Total,
+	})
+}
+
+// normalizePage applies the default and maximum page size
+func (s *UserService) normalizePage(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = s.opts.DefaultListLimit
+	}
+	if limit > s.opts.MaxListLimit {
+		limit = s.opts.MaxListLimit
+	}
+	return page, limit
+}
@@ -0,0 +1,125 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// newListService registers five users, the last two at the same instant, and
+// returns their emails oldest first
+func newListService(t *testing.T) (*UserService, *memoryUsers, []string) {
+	repo := newMemoryUsers()
+	svc := newTestService(repo)
+	svc.opts.DefaultListLimit, svc.opts.MaxListLimit = 2, 3
+	var err error
+	svc.cursors, err = pagination.NewCodec([]byte(strings.Repeat("k", pagination.MinSecretLength)))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	emails := make([]string, 5)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("user%d@example.com", 5-i)
+		_, err := svc.Register(context.Background(), RegisterInput{Email: emails[i], Password: "correct horse", FullName: fmt.Sprintf("User %d", i)})
+		require.NoError(t, err)
+		if i < 3 {
+			now = now.Add(time.Second)
+		}
+	}
+	return svc, repo, emails
+}
+
+func userEmails(users []*domain.User) []string {
+	out := make([]string, len(users))
+	for i, u := range users {
+		out[i] = u.Email
+	}
+	return out
+}
+
+func TestUserService_ListUsers_Cursor(t *testing.T) {
+	svc, _, emails := newListService(t)
+	ctx := context.Background()
+
+	var byCursor []string
+	in := ListUsersInput{}
+	for page := 1; ; page++ {
+		res, err := svc.ListUsers(ctx, in)
+		require.NoError(t, err)
+		assert.Equal(t, page, res.Page)
+		assert.Equal(t, int64(5), res.Total)
+		assert.Equal(t, 2, res.Limit)
+
+		// Page numbers keep working and agree with the cursor
+		numbered, err := svc.ListUsers(ctx, ListUsersInput{Page: page})
+		require.NoError(t, err)
+		assert.Equal(t, userEmails(numbered.Users), userEmails(res.Users))
+
+		byCursor = append(byCursor, userEmails(res.Users)...)
+		if res.NextCursor == "" {
+			break
+		}
+		in.Cursor = res.NextCursor
+	}
+	// Newest first, ties broken by ID
+	assert.Equal(t, []string{emails[4], emails[3], emails[2], emails[1], emails[0]}, byCursor)
+}
+
+func TestUserService_ListUsers_Filters(t *testing.T) {
+	svc, repo, emails := newListService(t)
+	ctx := context.Background()
+
+	u, err := repo.GetByEmail(ctx, emails[1])
+	require.NoError(t, err)
+	require.NoError(t, repo.update(u.ID, func(u *domain.User) { u.IsPremium = true }))
+	res, err := svc.ListUsers(ctx, ListUsersInput{PremiumOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{emails[1]}, userEmails(res.Users))
+
+	res, err = svc.ListUsers(ctx, ListUsersInput{Search: " USER 3 "})
+	require.NoError(t, err)
+	assert.Equal(t, []string{emails[3]}, userEmails(res.Users))
+
+	res, err = svc.ListUsers(ctx, ListUsersInput{SortBy: domain.UserSortEmail, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []string{emails[4], emails[3], emails[2]}, userEmails(res.Users))
+	assert.Equal(t, 3, res.Limit)
+
+	res, err = svc.ListUsers(ctx, ListUsersInput{SortBy: domain.UserSortEmail, Cursor: res.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{emails[1], emails[0]}, userEmails(res.Users))
+	assert.Empty(t, res.NextCursor)
+}
+
+func TestUserService_ListUsers_RejectsCursor(t *testing.T) {
+	svc, _, _ := newListService(t)
+	ctx := context.Background()
+
+	first, err := svc.ListUsers(ctx, ListUsersInput{})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextCursor)
+
+	for name, in := range map[string]ListUsersInput{
+		"forged":        {Cursor: "e30.AAAA"},
+		"other filters": {Cursor: first.NextCursor, ActiveOnly: true},
+		"other order":   {Cursor: first.NextCursor, SortBy: domain.UserSortEmail},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.ListUsers(ctx, in)
+			assertCode(t, err, apperrors.CodeValidation, "pagination.cursor")
+		})
+	}
+
+	_, err = svc.ListUsers(ctx, ListUsersInput{SortBy: "password_hash"})
+	assertCode(t, err, apperrors.CodeValidation, "pagination.sort_by")
+}
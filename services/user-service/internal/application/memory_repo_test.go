@@ -0,0 +1,611 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// memoryUsers is an in-memory domain.UserRepository
+type memoryUsers struct {
+	mu    sync.Mutex
+	users map[string]*domain.User
+	next  int
+}
+
+func newMemoryUsers() *memoryUsers {
+	return &memoryUsers{users: make(map[string]*domain.User)}
+}
+
+func (r *memoryUsers) Create(_ context.Context, u *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.users {
+		if existing.Email == u.Email {
+			return domain.ErrEmailTaken
+		}
+		if u.Username != "" && existing.Username == u.Username {
+			return domain.ErrUsernameTaken
+		}
+	}
+	r.next++
+	u.ID = fmt.Sprintf("00000000-0000-0000-0000-%012d", r.next)
+	copied := *u
+	r.users[u.ID] = &copied
+	return nil
+}
+
+func (r *memoryUsers) GetByID(_ context.Context, id string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+func (r *memoryUsers) GetByEmail(_ context.Context, email string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Email == email {
+			copied := *u
+			return &copied, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *memoryUsers) UpdatePasswordHash(_ context.Context, id, hash string, at time.Time) error {
+	return r.update(id, func(u *domain.User) {
+		u.PasswordHash = hash
+		u.PasswordChangedAt = &at
+		u.UpdatedAt = at
+	})
+}
+
+func (r *memoryUsers) ReplacePasswordHash(_ context.Context, id, oldHash, newHash string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok || u.PasswordHash != oldHash {
+		return domain.ErrUserNotFound
+	}
+	u.PasswordHash, u.UpdatedAt = newHash, at
+	return nil
+}
+
+func (r *memoryUsers) MarkEmailVerified(_ context.Context, id string, at time.Time) error {
+	return r.update(id, func(u *domain.User) {
+		u.EmailVerified = true
+		u.UpdatedAt = at
+	})
+}
+
+func (r *memoryUsers) UpdateProfile(_ context.Context, u *domain.User) error {
+	r.mu.Lock()
+	for id, existing := range r.users {
+		if u.Username != "" && existing.Username == u.Username && id != u.ID {
+			r.mu.Unlock()
+			return domain.ErrUsernameTaken
+		}
+	}
+	r.mu.Unlock()
+	return r.update(u.ID, func(stored *domain.User) {
+		stored.Username = u.Username
+		stored.FullName = u.FullName
+		stored.AvatarURL = u.AvatarURL
+		stored.Settings = u.Settings
+		stored.UpdatedAt = u.UpdatedAt
+	})
+}
+
+func (r *memoryUsers) RecordLogin(_ context.Context, id string, at time.Time) error {
+	return r.update(id, func(u *domain.User) { u.LastLogin = &at })
+}
+
+func (r *memoryUsers) List(_ context.Context, filter domain.UserFilter) ([]*domain.User, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []*domain.User
+	for _, u := range r.users {
+		if filter.Search != "" && !strings.Contains(u.Email, filter.Search) && !strings.Contains(u.Username, filter.Search) &&
+			!strings.Contains(strings.ToLower(u.FullName), filter.Search) {
+			continue
+		}
+		if (filter.ActiveOnly && !u.IsActive) || (filter.PremiumOnly && !u.HasPremium(filter.Now)) {
+			continue
+		}
+		matched = append(matched, u)
+	}
+	order := func(a, b *domain.User) int {
+		c := a.CreatedAt.Compare(b.CreatedAt)
+		if filter.SortBy == domain.UserSortEmail {
+			c = strings.Compare(a.Email, b.Email)
+		}
+		if c == 0 {
+			c = strings.Compare(a.ID, b.ID)
+		}
+		if filter.Desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(matched, order)
+
+	total := int64(len(matched))
+	start := filter.Offset
+	if filter.After != nil {
+		after := &domain.User{ID: filter.After.ID, Email: filter.After.Value}
+		after.CreatedAt, _ = time.Parse(time.RFC3339Nano, filter.After.Value)
+		start = len(matched)
+		for i, u := range matched {
+			if order(u, after) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+	if filter.SkipCount || filter.After != nil {
+		total = 0
+	}
+
+	page := make([]*domain.User, 0, filter.Limit)
+	for i := start; i < len(matched) && len(page) < filter.Limit; i++ {
+		copied := *matched[i]
+		page = append(page, &copied)
+	}
+	return page, total, nil
+}
+
+func (r *memoryUsers) Ping(context.Context) error {
+	return nil
+}
+
+func (r *memoryUsers) update(id string, fn func(u *domain.User)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return domain.ErrUserNotFound
+	}
+	fn(u)
+	return nil
+}
+
+// memorySessions is an in-memory domain.SessionRepository
+type memorySessions struct {
+	mu       sync.Mutex
+	sessions map[string]*domain.Session
+	next     int
+}
+
+func newMemorySessions() *memorySessions {
+	return &memorySessions{sessions: make(map[string]*domain.Session)}
+}
+
+func (r *memorySessions) Create(_ context.Context, s *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.next++
+	s.ID = fmt.Sprintf("10000000-0000-0000-0000-%012d", r.next)
+	copied := *s
+	r.sessions[s.ID] = &copied
+	return nil
+}
+
+func (r *memorySessions) Get(_ context.Context, id string) (*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, domain.ErrSessionNotFound
+	}
+	copied := *s
+	return &copied, nil
+}
+
+func (r *memorySessions) ListActive(_ context.Context, userID string, now time.Time) ([]*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*domain.Session
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.IsActive(now) {
+			copied := *s
+			out = append(out, &copied)
+		}
+	}
+	slices.SortFunc(out, func(a, b *domain.Session) int { return b.LastUsedAt.Compare(a.LastUsedAt) })
+	return out, nil
+}
+
+func (r *memorySessions) Rotate(_ context.Context, s *domain.Session, oldTokenID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.sessions[s.ID]
+	if !ok || stored.RevokedAt != nil {
+		return domain.ErrSessionNotFound
+	}
+	if stored.TokenID != oldTokenID {
+		return domain.ErrTokenReused
+	}
+	stored.TokenID, stored.LastUsedAt, stored.UserAgent, stored.IPAddress = s.TokenID, s.LastUsedAt, s.UserAgent, s.IPAddress
+	return nil
+}
+
+func (r *memorySessions) Revoke(_ context.Context, userID, id, reason string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[id]
+	if !ok || s.UserID != userID || !s.IsActive(at) {
+		return domain.ErrSessionNotFound
+	}
+	s.RevokedAt, s.RevokeReason = &at, reason
+	return nil
+}
+
+func (r *memorySessions) RevokeAll(_ context.Context, userID, exceptID, reason string, at time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.ID != exceptID && s.IsActive(at) {
+			s.RevokedAt, s.RevokeReason = &at, reason
+			n++
+		}
+	}
+	return n, nil
+}
+
+// stubTokens is a domain.TokenIssuer handing out "type:id" tokens and
+// remembering their claims
+type stubTokens struct {
+	mu     sync.Mutex
+	issued map[string]domain.TokenClaims
+}
+
+func newStubTokens() *stubTokens {
+	return &stubTokens{issued: make(map[string]domain.TokenClaims)}
+}
+
+func (s *stubTokens) Issue(c *domain.TokenClaims) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := c.Type + ":" + c.ID
+	s.issued[token] = *c
+	return token, nil
+}
+
+func (s *stubTokens) Parse(token, typ string, now time.Time) (*domain.TokenClaims, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.issued[token]
+	if !ok || c.Type != typ {
+		return nil, domain.ErrTokenInvalid
+	}
+	if !now.Before(c.ExpiresAt) {
+		return nil, domain.ErrTokenExpired
+	}
+	return &c, nil
+}
+
+// memoryTwoFactors is an in-memory domain.TwoFactorRepository
+type memoryTwoFactors struct {
+	mu      sync.Mutex
+	entries map[string]*domain.TwoFactor
+}
+
+func newMemoryTwoFactors() *memoryTwoFactors {
+	return &memoryTwoFactors{entries: make(map[string]*domain.TwoFactor)}
+}
+
+func (r *memoryTwoFactors) Get(_ context.Context, userID string) (*domain.TwoFactor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.entries[userID]
+	if !ok {
+		return nil, domain.ErrTwoFactorNotFound
+	}
+	copied := *t
+	copied.RecoveryCodes = slices.Clone(t.RecoveryCodes)
+	return &copied, nil
+}
+
+func (r *memoryTwoFactors) SavePending(_ context.Context, t *domain.TwoFactor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old, ok := r.entries[t.UserID]; ok && old.Enabled() {
+		return domain.ErrTwoFactorEnabled
+	}
+	copied := *t
+	r.entries[t.UserID] = &copied
+	return nil
+}
+
+func (r *memoryTwoFactors) Confirm(_ context.Context, userID string, step int64, recoveryCodes []string, at time.Time) error {
+	return r.update(userID, domain.ErrTwoFactorNotFound, func(t *domain.TwoFactor) bool {
+		if t.Enabled() {
+			return false
+		}
+		t.ConfirmedAt, t.LastUsedStep, t.RecoveryCodes = &at, step, recoveryCodes
+		return true
+	})
+}
+
+func (r *memoryTwoFactors) UseStep(_ context.Context, userID string, step int64) error {
+	return r.update(userID, domain.ErrCodeUsed, func(t *domain.TwoFactor) bool {
+		if step <= t.LastUsedStep {
+			return false
+		}
+		t.LastUsedStep = step
+		return true
+	})
+}
+
+func (r *memoryTwoFactors) UseRecoveryCode(_ context.Context, userID, hash string) error {
+	return r.update(userID, domain.ErrCodeUsed, func(t *domain.TwoFactor) bool {
+		i := slices.Index(t.RecoveryCodes, hash)
+		if i < 0 {
+			return false
+		}
+		t.RecoveryCodes = slices.Delete(t.RecoveryCodes, i, i+1)
+		return true
+	})
+}
+
+func (r *memoryTwoFactors) SetRecoveryCodes(_ context.Context, userID string, hashes []string) error {
+	return r.update(userID, domain.ErrTwoFactorNotFound, func(t *domain.TwoFactor) bool {
+		t.RecoveryCodes = hashes
+		return t.Enabled()
+	})
+}
+
+func (r *memoryTwoFactors) Delete(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.entries[userID]; !ok {
+		return domain.ErrTwoFactorNotFound
+	}
+	delete(r.entries, userID)
+	return nil
+}
+
+func (r *memoryTwoFactors) update(userID string, notMatched error, fn func(*domain.TwoFactor) bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.entries[userID]
+	if !ok || !fn(t) {
+		return notMatched
+	}
+	return nil
+}
+
+// plainCipher is a domain.SecretCipher that only tags plaintexts with their aad
+type plainCipher struct{}
+
+func (plainCipher) Seal(plaintext, aad []byte) (string, error) {
+	return string(aad) + "|" + string(plaintext), nil
+}
+
+func (plainCipher) Open(sealed string, aad []byte) ([]byte, error) {
+	owner, plain, ok := strings.Cut(sealed, "|")
+	if !ok || owner != string(aad) {
+		return nil, errors.New("aad mismatch")
+	}
+	return []byte(plain), nil
+}
+
+// countingAttempts is a ratelimit.AttemptLimiter blocking a key after max failures
+type countingAttempts struct {
+	mu       sync.Mutex
+	max      int
+	failures map[string]int
+}
+
+func (a *countingAttempts) Blocked(_ context.Context, key string) (time.Duration, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.failures[key] >= a.max {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (a *countingAttempts) Fail(_ context.Context, key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.failures == nil {
+		a.failures = make(map[string]int)
+	}
+	a.failures[key]++
+	return nil
+}
+
+func (a *countingAttempts) Reset(_ context.Context, key string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.failures, key)
+	return nil
+}
+
+// memoryAccountTokens is an in-memory domain.AccountTokenRepository
+type memoryAccountTokens struct {
+	mu     sync.Mutex
+	tokens map[string]*domain.AccountToken
+}
+
+func newMemoryAccountTokens() *memoryAccountTokens {
+	return &memoryAccountTokens{tokens: make(map[string]*domain.AccountToken)}
+}
+
+func (r *memoryAccountTokens) Create(_ context.Context, t *domain.AccountToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *t
+	r.tokens[t.Hash] = &copied
+	return nil
+}
+
+func (r *memoryAccountTokens) Consume(_ context.Context, purpose, hash string, at time.Time) (*domain.AccountToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tokens[hash]
+	if !ok || t.Purpose != purpose || t.UsedAt != nil {
+		return nil, domain.ErrAccountTokenNotFound
+	}
+	t.UsedAt = &at
+	copied := *t
+	return &copied, nil
+}
+
+func (r *memoryAccountTokens) CountSince(_ context.Context, userID, purpose string, since time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int
+	for _, t := range r.tokens {
+		if t.UserID == userID && t.Purpose == purpose && t.CreatedAt.After(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memoryAccountTokens) RevokeAll(_ context.Context, userID, purpose string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		if t.UserID == userID && t.Purpose == purpose && t.UsedAt == nil {
+			t.UsedAt = &at
+		}
+	}
+	return nil
+}
+
+// memoryMailer is a domain.Mailer keeping the messages sent
+type memoryMailer struct {
+	mu   sync.Mutex
+	sent []*domain.Message
+}
+
+func (m *memoryMailer) Send(_ context.Context, msg *domain.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// count returns how many messages were sent to to
+func (m *memoryMailer) count(to string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var n int
+	for _, msg := range m.sent {
+		if msg.To == to {
+			n++
+		}
+	}
+	return n
+}
+
+// lastToken returns the link token of the latest message sent to to
+func (m *memoryMailer) lastToken(to string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.sent) - 1; i >= 0; i-- {
+		if m.sent[i].To == to {
+			if match := linkToken.FindStringSubmatch(m.sent[i].Text); match != nil {
+				return match[1]
+			}
+		}
+	}
+	return ""
+}
+
+var linkToken = regexp.MustCompile(`[?&]token=([A-Za-z0-9_-]+)`)
+
+// memoryAPIKeys is an in-memory domain.APIKeyRepository
+type memoryAPIKeys struct {
+	mu   sync.Mutex
+	keys []*domain.APIKey
+}
+
+func newMemoryAPIKeys() *memoryAPIKeys {
+	return &memoryAPIKeys{}
+}
+
+func (r *memoryAPIKeys) Create(_ context.Context, k *domain.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k.ID = fmt.Sprintf("20000000-0000-0000-0000-%012d", len(r.keys)+1)
+	copied := *k
+	r.keys = append(r.keys, &copied)
+	return nil
+}
+
+func (r *memoryAPIKeys) List(_ context.Context, filter domain.APIKeyFilter) ([]*domain.APIKey, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matched []*domain.APIKey
+	for _, k := range r.keys {
+		if k.UserID == filter.UserID {
+			matched = append(matched, k)
+		}
+	}
+	order := func(a, b *domain.APIKey) int {
+		c := a.CreatedAt.Compare(b.CreatedAt)
+		if c == 0 {
+			c = strings.Compare(a.ID, b.ID)
+		}
+		if filter.Desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(matched, order)
+
+	total := int64(len(matched))
+	start := filter.Offset
+	if filter.After != nil {
+		after := &domain.APIKey{ID: filter.After.ID, CreatedAt: filter.After.CreatedAt}
+		start = len(matched)
+		for i, k := range matched {
+			if order(k, after) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+	if filter.SkipCount || filter.After != nil {
+		total = 0
+	}
+
+	page := make([]*domain.APIKey, 0, filter.Limit)
+	for i := start; i < len(matched) && len(page) < filter.Limit; i++ {
+		copied := *matched[i]
+		page = append(page, &copied)
+	}
+	return page, total, nil
+}
+
+func (r *memoryAPIKeys) Revoke(_ context.Context, userID, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, k := range r.keys {
+		if k.ID == id && k.UserID == userID && k.RevokedAt == nil {
+			k.RevokedAt = &at
+			return nil
+		}
+	}
+	return domain.ErrAPIKeyNotFound
+}
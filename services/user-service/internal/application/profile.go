@@ -0,0 +1,194 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/fieldmask"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// UpdateUserInput holds data for editing a profile. Without a mask nil fields
+// are left unchanged; with one, masked fields are set even when nil or empty.
+// Cleared timezones and languages fall back to domain.DefaultSettings.
+type UpdateUserInput struct {
+	UserID    string
+	Username  *string // Empty string removes the username
+	FullName  *string
+	AvatarURL *string
+	Settings  *domain.UserSettings // Without a mask, replaces every setting when set
+	Mask      []string             // Field paths to update, e.g. full_name or settings.timezone
+}
+
+// settingFields are the settings.* mask paths in the order they are applied
+var settingFields = []struct {
+	name string
+	set  func(dst *domain.UserSettings, src domain.UserSettings) error
+}{
+	{"public_profile", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		dst.PublicProfile = src.PublicProfile
+		return nil
+	}},
+	{"analytics_enabled", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		dst.AnalyticsEnabled = src.AnalyticsEnabled
+		return nil
+	}},
+	{"email_notifications", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		dst.EmailNotifications = src.EmailNotifications
+		return nil
+	}},
+	{"timezone", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		tz, err := normalizeTimezone(src.Timezone)
+		if err != nil {
+			return err
+		}
+		dst.Timezone = tz
+		return nil
+	}},
+	{"language", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		lang, err := normalizeLanguage(src.Language)
+		if err != nil {
+			return err
+		}
+		dst.Language = lang
+		return nil
+	}},
+	{"default_domain", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		host, err := normalizeDefaultDomain(src.DefaultDomain)
+		if err != nil {
+			return err
+		}
+		dst.DefaultDomain = host
+		return nil
+	}},
+	{"require_https", func(dst *domain.UserSettings, src domain.UserSettings) error {
+		dst.RequireHTTPS = src.RequireHTTPS
+		return nil
+	}},
+}
+
+// UpdateUser edits the profile of a user
+func (s *UserService) UpdateUser(ctx context.Context, in UpdateUserInput) (*domain.User, error) {
+	if in.UserID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+
+	setters := profileSetters(in)
+	mask := in.Mask
+	if len(mask) == 0 {
+		mask = presentProfileFields(in)
+	}
+	// Reject bad masks before touching the repository
+	mask, err := setters.Validate(mask)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.GetUser(ctx, in.UserID, "")
+	if err != nil {
+		return nil, err
+	}
+	wasPublic := u.Settings.PublicProfile
+	if err := setters.Apply(u, mask); err != nil {
+		return nil, err
+	}
+	if u.Settings.PublicProfile && !wasPublic {
+		if err := requireVerifiedEmail(u); err != nil {
+			return nil, err
+		}
+	}
+
+	u.UpdatedAt = s.now()
+	if err := s.repo.UpdateProfile(ctx, u); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			return nil, apperrors.NotFound("user not found")
+		case errors.Is(err, domain.ErrUsernameTaken):
+			return nil, apperrors.Newf(apperrors.CodeUsernameTaken, "username %q is already taken", u.Username).WithField("username")
+		}
+		return nil, s.internal(err, "failed to update user")
+	}
+	return u, nil
+}
+
+// profileSetters maps UpdateUser mask paths to the fields they set; a nil value clears the field
+func profileSetters(in UpdateUserInput) fieldmask.Setters[domain.User] {
+	var settings domain.UserSettings
+	if in.Settings != nil {
+		settings = *in.Settings
+	}
+
+	setters := fieldmask.Setters[domain.User]{
+		"username": func(u *domain.User) error {
+			username := NormalizeUsername(deref(in.Username))
+			if username != "" {
+				if err := ValidateUsername(username); err != nil {
+					return err
+				}
+			}
+			u.Username = username
+			return nil
+		},
+		"full_name": func(u *domain.User) error {
+			name, err := validateFullName(deref(in.FullName))
+			if err != nil {
+				return err
+			}
+			u.FullName = name
+			return nil
+		},
+		"avatar_url": func(u *domain.User) error {
+			avatar, err := normalizeAvatarURL(deref(in.AvatarURL))
+			if err != nil {
+				return err
+			}
+			u.AvatarURL = avatar
+			return nil
+		},
+		"settings": func(u *domain.User) error {
+			// Check every setting before replacing any
+			next := u.Settings
+			for _, f := range settingFields {
+				if err := f.set(&next, settings); err != nil {
+					return err
+				}
+			}
+			u.Settings = next
+			return nil
+		},
+	}
+	for _, f := range settingFields {
+		set := f.set
+		setters["settings."+f.name] = func(u *domain.User) error {
+			return set(&u.Settings, settings)
+		}
+	}
+	return setters
+}
+
+// presentProfileFields is the implicit mask of an UpdateUser request without one
+func presentProfileFields(in UpdateUserInput) []string {
+	var mask []string
+	if in.Username != nil {
+		mask = append(mask, "username")
+	}
+	if in.FullName != nil {
+		mask = append(mask, "full_name")
+	}
+	if in.AvatarURL != nil {
+		mask = append(mask, "avatar_url")
+	}
+	if in.Settings != nil {
+		mask = append(mask, "settings")
+	}
+	return mask
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func newProfileUser(t *testing.T) (*UserService, *memoryUsers, *domain.User) {
+	repo := newMemoryUsers()
+	svc := newTestService(repo)
+	u, err := svc.Register(context.Background(), RegisterInput{Email: "ada@example.com", Password: "correct horse", Username: "ada", FullName: "Ada"})
+	require.NoError(t, err)
+	require.NoError(t, repo.MarkEmailVerified(context.Background(), u.ID, time.Now()))
+	return svc, repo, u
+}
+
+func TestUserService_UpdateUser_PresentFields(t *testing.T) {
+	svc, repo, u := newProfileUser(t)
+	ctx := context.Background()
+	assert.Equal(t, domain.DefaultSettings, u.Settings)
+
+	updated, err := svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, FullName: strPtr(" Ada Lovelace "), AvatarURL: strPtr("https://cdn.example.com/ada.png")})
+	require.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", updated.FullName)
+	assert.Equal(t, "ada", updated.Username)
+	assert.Equal(t, domain.DefaultSettings, updated.Settings)
+
+	// Settings without a mask replace every setting, cleared ones take defaults
+	updated, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, Settings: &domain.UserSettings{PublicProfile: true, DefaultDomain: "Go.Example.COM."}})
+	require.NoError(t, err)
+	assert.Equal(t, domain.UserSettings{PublicProfile: true, Timezone: "UTC", Language: "en", DefaultDomain: "go.example.com"}, updated.Settings)
+
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", stored.FullName)
+	assert.Equal(t, "https://cdn.example.com/ada.png", stored.AvatarURL)
+	assert.Equal(t, updated.Settings, stored.Settings)
+}
+
+func TestUserService_UpdateUser_Mask(t *testing.T) {
+	svc, repo, u := newProfileUser(t)
+	ctx := context.Background()
+
+	// Masked fields are set even when absent, others are left alone
+	updated, err := svc.UpdateUser(ctx, UpdateUserInput{
+		UserID:   u.ID,
+		FullName: strPtr("Ignored"),
+		Settings: &domain.UserSettings{Timezone: "Europe/Berlin", PublicProfile: true},
+		Mask:     []string{"username", "settings.timezone"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, updated.Username)
+	assert.Equal(t, "Ada", updated.FullName)
+	assert.Equal(t, "Europe/Berlin", updated.Settings.Timezone)
+	assert.False(t, updated.Settings.PublicProfile)
+	assert.True(t, updated.Settings.AnalyticsEnabled)
+
+	updated, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, Mask: []string{"settings.analytics_enabled", "settings.language"}})
+	require.NoError(t, err)
+	assert.False(t, updated.Settings.AnalyticsEnabled)
+	assert.Equal(t, "en", updated.Settings.Language)
+	assert.Equal(t, "Europe/Berlin", updated.Settings.Timezone)
+
+	// A bad setting leaves the others untouched
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{
+		UserID:   u.ID,
+		Settings: &domain.UserSettings{PublicProfile: true, Language: "not a language"},
+		Mask:     []string{"settings"},
+	})
+	assertCode(t, err, apperrors.CodeValidation, "settings.language")
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Settings.PublicProfile)
+}
+
+func TestUserService_UpdateUser_RejectsMask(t *testing.T) {
+	svc, repo, u := newProfileUser(t)
+	ctx := context.Background()
+
+	for path, field := range map[string]string{
+		"id":                "id",
+		"user_id":           "user_id",
+		"created_at":        "created_at",
+		"email":             "email",
+		"password_hash":     "password_hash",
+		"settings.unknown":  "settings.unknown",
+		" ":                 "update_mask",
+		"settings.timezone": "settings.timezone",
+	} {
+		t.Run(path, func(t *testing.T) {
+			in := UpdateUserInput{UserID: u.ID, Mask: []string{"full_name", path}, FullName: strPtr("Changed")}
+			if path == "settings.timezone" {
+				in.Settings = &domain.UserSettings{Timezone: "Mars/Olympus"}
+			}
+			_, err := svc.UpdateUser(ctx, in)
+			assertCode(t, err, apperrors.CodeValidation, field)
+		})
+	}
+
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", stored.FullName)
+}
+
+func TestUserService_UpdateUser_Errors(t *testing.T) {
+	svc, _, u := newProfileUser(t)
+	ctx := context.Background()
+	_, err := svc.Register(ctx, RegisterInput{Email: "bob@example.com", Password: "correct horse", Username: "bob"})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, Username: strPtr("Bob")})
+	assertCode(t, err, apperrors.CodeUsernameTaken, "username")
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, Username: strPtr("no spaces allowed")})
+	assertCode(t, err, apperrors.CodeValidation, "username")
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, AvatarURL: strPtr("javascript:alert(1)")})
+	assertCode(t, err, apperrors.CodeValidation, "avatar_url")
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: "00000000-0000-0000-0000-000000000099", FullName: strPtr("Nobody")})
+	assertCode(t, err, apperrors.CodeNotFound, "")
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{FullName: strPtr("Nobody")})
+	assertCode(t, err, apperrors.CodeUnauthorized, "")
+}
+
+func TestUserService_UpdateUser_PublicProfileNeedsVerifiedEmail(t *testing.T) {
+	repo := newMemoryUsers()
+	svc := newTestService(repo)
+	ctx := context.Background()
+	u, err := svc.Register(ctx, RegisterInput{Email: "eve@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+
+	public := &domain.UserSettings{PublicProfile: true}
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, Settings: public, Mask: []string{"settings.public_profile"}})
+	assertCode(t, err, apperrors.CodeEmailNotVerified, "email")
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Settings.PublicProfile)
+
+	// Other changes need no verified address
+	_, err = svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, FullName: strPtr("Eve")})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.MarkEmailVerified(ctx, u.ID, time.Now()))
+	updated, err := svc.UpdateUser(ctx, UpdateUserInput{UserID: u.ID, Settings: public, Mask: []string{"settings.public_profile"}})
+	require.NoError(t, err)
+	assert.True(t, updated.Settings.PublicProfile)
+}
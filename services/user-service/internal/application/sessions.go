@@ -0,0 +1,243 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const (
+	maxUserAgentLength = 512
+	maxIPAddressLength = 45 // IPv6 with an embedded IPv4 address
+)
+
+// TokenPair is a signed access token and the refresh token that renews it
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // Expiry of the access token
+}
+
+// RefreshInput holds a refresh token and the client presenting it
+type RefreshInput struct {
+	RefreshToken string
+	UserAgent    string
+	IPAddress    string
+}
+
+// RefreshToken rotates a session's refresh token and issues a new pair. A
+// token that was already rotated away revokes its whole session: either it or
+// its successor is in the wrong hands. The account is reloaded so disabled
+// users cannot renew, sessions older than a password reset end, and premium
+// changes take effect.
+func (s *UserService) RefreshToken(ctx context.Context, in RefreshInput) (*TokenPair, error) {
+	now := s.now()
+	claims, err := s.tokens.Parse(in.RefreshToken, domain.TokenRefresh, now)
+	if err != nil {
+		return nil, tokenError(err)
+	}
+	if claims.SessionID == "" {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "token is invalid")
+	}
+
+	session, err := s.sessions.Get(ctx, claims.SessionID)
+	if errors.Is(err, domain.ErrSessionNotFound) {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "token is invalid")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load session")
+	}
+	if session.UserID != claims.UserID || !session.IsActive(now) {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "session has ended")
+	}
+	if session.TokenID != claims.ID {
+		return nil, s.reuseDetected(ctx, session, now)
+	}
+
+	u, err := s.repo.GetByID(ctx, claims.UserID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "token is invalid")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load user")
+	}
+	if !u.IsActive {
+		return nil, apperrors.Forbidden("account is disabled")
+	}
+	if u.PasswordChangedAt != nil && session.CreatedAt.Before(*u.PasswordChangedAt) {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "session has ended")
+	}
+
+	if session.TokenID, err = newTokenID(); err != nil {
+		return nil, s.internal(err, "failed to rotate refresh token")
+	}
+	session.LastUsedAt = now
+	if in.UserAgent != "" {
+		session.UserAgent = clip(in.UserAgent, maxUserAgentLength)
+	}
+	if in.IPAddress != "" {
+		session.IPAddress = clip(in.IPAddress, maxIPAddressLength)
+	}
+	if err := s.sessions.Rotate(ctx, session, claims.ID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTokenReused):
+			// A concurrent refresh with the same token won the race
+			return nil, s.reuseDetected(ctx, session, now)
+		case errors.Is(err, domain.ErrSessionNotFound):
+			return nil, apperrors.New(apperrors.CodeInvalidToken, "session has ended")
+		}
+		return nil, s.internal(err, "failed to rotate refresh token")
+	}
+	return s.issuePair(u, session, now)
+}
+
+// ListSessions returns a user's active sessions, most recently used first
+func (s *UserService) ListSessions(ctx context.Context, userID string) ([]*domain.Session, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	sessions, err := s.sessions.ListActive(ctx, userID, s.now())
+	if err != nil {
+		return nil, s.internal(err, "failed to list sessions")
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs a user out of one session
+func (s *UserService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if userID == "" {
+		return apperrors.Unauthorized("authentication required")
+	}
+	if sessionID == "" {
+		return apperrors.Validation("session_id is required").WithField("session_id")
+	}
+	err := s.sessions.Revoke(ctx, userID, sessionID, domain.RevokedByUser, s.now())
+	if errors.Is(err, domain.ErrSessionNotFound) {
+		return apperrors.NotFound("session not found").WithField("session_id")
+	}
+	if err != nil {
+		return s.internal(err, "failed to revoke session")
+	}
+	return nil
+}
+
+// RevokeAllSessions signs a user out everywhere except exceptID, if set, and
+// returns how many sessions ended
+func (s *UserService) RevokeAllSessions(ctx context.Context, userID, exceptID string) (int, error) {
+	if userID == "" {
+		return 0, apperrors.Unauthorized("authentication required")
+	}
+	n, err := s.sessions.RevokeAll(ctx, userID, exceptID, domain.RevokedByUser, s.now())
+	if err != nil {
+		return 0, s.internal(err, "failed to revoke sessions")
+	}
+	return n, nil
+}
+
+// startSession stores the session of a successful login
+func (s *UserService) startSession(ctx context.Context, u *domain.User, in LoginInput, now time.Time) (*domain.Session, error) {
+	tokenID, err := newTokenID()
+	if err != nil {
+		return nil, s.internal(err, "failed to start session")
+	}
+	ttl := s.opts.SessionTTL
+	if in.RememberMe {
+		ttl = s.opts.RememberTTL
+	}
+
+	session := &domain.Session{
+		UserID:     u.ID,
+		TokenID:    tokenID,
+		UserAgent:  clip(in.UserAgent, maxUserAgentLength),
+		IPAddress:  clip(in.IPAddress, maxIPAddressLength),
+		RememberMe: in.RememberMe,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+	if err := s.sessions.Create(ctx, session); err != nil {
+		return nil, s.internal(err, "failed to start session")
+	}
+	return session, nil
+}
+
+// issuePair signs an access token and the session's current refresh token. The
+// refresh token expires with the session, access tokens never outlive it.
+func (s *UserService) issuePair(u *domain.User, session *domain.Session, now time.Time) (*TokenPair, error) {
+	accessID, err := newTokenID()
+	if err != nil {
+		return nil, s.internal(err, "failed to issue access token")
+	}
+	expiresAt := now.Add(s.opts.AccessTTL)
+	if session.ExpiresAt.Before(expiresAt) {
+		expiresAt = session.ExpiresAt
+	}
+
+	access, err := s.tokens.Issue(&domain.TokenClaims{
+		ID:            accessID,
+		Type:          domain.TokenAccess,
+		UserID:        u.ID,
+		SessionID:     session.ID,
+		Email:         u.Email,
+		Roles:         u.Roles,
+		IsPremium:     u.HasPremium(now),
+		EmailVerified: u.EmailVerified,
+		IssuedAt:      now,
+		ExpiresAt:     expiresAt,
+	})
+	if err != nil {
+		return nil, s.internal(err, "failed to issue access token")
+	}
+	refresh, err := s.tokens.Issue(&domain.TokenClaims{
+		ID:        session.TokenID,
+		Type:      domain.TokenRefresh,
+		UserID:    u.ID,
+		SessionID: session.ID,
+		IssuedAt:  now,
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		return nil, s.internal(err, "failed to issue refresh token")
+	}
+	return &TokenPair{AccessToken: access, RefreshToken: refresh, ExpiresAt: expiresAt}, nil
+}
+
+// reuseDetected revokes the family of a refresh token presented after rotation
+func (s *UserService) reuseDetected(ctx context.Context, session *domain.Session, now time.Time) error {
+	s.logger.Warn("refresh token reused, revoking session",
+		zap.String("user_id", session.UserID), zap.String("session_id", session.ID))
+
+	err := s.sessions.Revoke(ctx, session.UserID, session.ID, domain.RevokedOnReuse, now)
+	if err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+		return s.internal(err, "failed to revoke session")
+	}
+	return apperrors.New(apperrors.CodeInvalidToken, "refresh token was already used, the session has been revoked")
+}
+
+// newTokenID returns a random token ID
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// clip cuts s to at most n bytes without splitting a character
+func clip(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+type sessionFixture struct {
+	svc      *UserService
+	users    *memoryUsers
+	sessions *memorySessions
+	now      time.Time
+	userID   string
+}
+
+func newSessionFixture(t *testing.T) *sessionFixture {
+	f := &sessionFixture{
+		users:    newMemoryUsers(),
+		sessions: newMemorySessions(),
+		now:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.svc = newClockedService(f.users, &f.now)
+	f.svc.sessions = f.sessions
+
+	u, err := f.svc.Register(context.Background(), RegisterInput{Email: "ada@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	f.userID = u.ID
+	return f
+}
+
+func (f *sessionFixture) login(t *testing.T, remember bool, agent string) *LoginResult {
+	t.Helper()
+	res, err := f.svc.Login(context.Background(), LoginInput{
+		Email: "ada@example.com", Password: "correct horse", RememberMe: remember, UserAgent: agent, IPAddress: "192.0.2.1",
+	})
+	require.NoError(t, err)
+	return res
+}
+
+func TestUserService_Login_SessionLifetime(t *testing.T) {
+	f := newSessionFixture(t)
+
+	short := f.login(t, false, "phone")
+	assert.Equal(t, f.now.Add(24*time.Hour), short.Session.ExpiresAt)
+	assert.Equal(t, f.now.Add(15*time.Minute), short.Tokens.ExpiresAt)
+
+	long := f.login(t, true, "laptop")
+	assert.Equal(t, f.now.Add(30*24*time.Hour), long.Session.ExpiresAt)
+	assert.NotEqual(t, short.Session.ID, long.Session.ID)
+
+	claims, err := f.svc.ValidateToken(context.Background(), long.Tokens.AccessToken, domain.TokenAccess)
+	require.NoError(t, err)
+	assert.Equal(t, long.Session.ID, claims.SessionID)
+
+	// The session ends on time however often it is refreshed
+	f.now = f.now.Add(24 * time.Hour)
+	_, err = f.svc.RefreshToken(context.Background(), RefreshInput{RefreshToken: short.Tokens.RefreshToken})
+	assertCode(t, err, apperrors.CodeExpiredToken, "")
+	_, err = f.svc.RefreshToken(context.Background(), RefreshInput{RefreshToken: long.Tokens.RefreshToken})
+	assert.NoError(t, err)
+}
+
+func TestUserService_RefreshToken_Rotates(t *testing.T) {
+	f := newSessionFixture(t)
+	ctx := context.Background()
+	res := f.login(t, false, "phone")
+
+	// Premium and verification gained after login show up in renewed access tokens
+	claims, err := f.svc.ValidateToken(ctx, res.Tokens.AccessToken, domain.TokenAccess)
+	require.NoError(t, err)
+	assert.False(t, claims.EmailVerified)
+	f.users.update(f.userID, func(u *domain.User) { u.IsPremium, u.EmailVerified = true, true })
+	f.now = f.now.Add(time.Minute)
+	tokens, err := f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: res.Tokens.RefreshToken, UserAgent: "phone v2", IPAddress: "192.0.2.2"})
+	require.NoError(t, err)
+	assert.NotEqual(t, res.Tokens.RefreshToken, tokens.RefreshToken)
+	claims, err = f.svc.ValidateToken(ctx, tokens.AccessToken, domain.TokenAccess)
+	require.NoError(t, err)
+	assert.True(t, claims.IsPremium)
+	assert.True(t, claims.EmailVerified)
+
+	session, err := f.sessions.Get(ctx, res.Session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, f.now, session.LastUsedAt)
+	assert.Equal(t, "phone v2", session.UserAgent)
+	assert.Equal(t, "192.0.2.2", session.IPAddress)
+
+	// The rotated token keeps working, an access token never refreshes
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: tokens.RefreshToken})
+	require.NoError(t, err)
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: res.Tokens.AccessToken})
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+}
+
+func TestUserService_RefreshToken_ReuseRevokesFamily(t *testing.T) {
+	f := newSessionFixture(t)
+	ctx := context.Background()
+	res := f.login(t, false, "phone")
+	other := f.login(t, false, "laptop")
+
+	stolen := res.Tokens.RefreshToken
+	rotated, err := f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: stolen})
+	require.NoError(t, err)
+
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: stolen})
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+
+	session, err := f.sessions.Get(ctx, res.Session.ID)
+	require.NoError(t, err)
+	require.NotNil(t, session.RevokedAt)
+	assert.Equal(t, domain.RevokedOnReuse, session.RevokeReason)
+
+	// The legitimate holder of the newest token is signed out too, other sessions are not
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: rotated.RefreshToken})
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: other.Tokens.RefreshToken})
+	assert.NoError(t, err)
+}
+
+func TestUserService_RefreshToken_DisabledAccount(t *testing.T) {
+	f := newSessionFixture(t)
+	res := f.login(t, false, "phone")
+
+	f.users.update(f.userID, func(u *domain.User) { u.IsActive = false })
+	_, err := f.svc.RefreshToken(context.Background(), RefreshInput{RefreshToken: res.Tokens.RefreshToken})
+	assertCode(t, err, apperrors.CodeForbidden, "")
+}
+
+func TestUserService_Sessions_ListAndRevoke(t *testing.T) {
+	f := newSessionFixture(t)
+	ctx := context.Background()
+
+	phone := f.login(t, false, "phone")
+	f.now = f.now.Add(time.Minute)
+	laptop := f.login(t, true, "laptop")
+	f.now = f.now.Add(time.Minute)
+	tablet := f.login(t, false, "tablet")
+
+	sessions, err := f.svc.ListSessions(ctx, f.userID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+	assert.Equal(t, "tablet", sessions[0].UserAgent)
+	assert.Equal(t, "192.0.2.1", sessions[0].IPAddress)
+
+	require.NoError(t, f.svc.RevokeSession(ctx, f.userID, phone.Session.ID))
+	_, err = f.svc.RefreshToken(ctx, RefreshInput{RefreshToken: phone.Tokens.RefreshToken})
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+
+	err = f.svc.RevokeSession(ctx, "someone-else", laptop.Session.ID)
+	assertCode(t, err, apperrors.CodeNotFound, "session_id")
+	err = f.svc.RevokeSession(ctx, f.userID, phone.Session.ID)
+	assertCode(t, err, apperrors.CodeNotFound, "session_id")
+
+	n, err := f.svc.RevokeAllSessions(ctx, f.userID, tablet.Session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	sessions, err = f.svc.ListSessions(ctx, f.userID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, tablet.Session.ID, sessions[0].ID)
+
+	_, err = f.svc.ListSessions(ctx, "")
+	assertCode(t, err, apperrors.CodeUnauthorized, "")
+}
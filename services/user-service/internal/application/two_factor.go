@@ -0,0 +1,331 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strings"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/pkg/ratelimit"
+	"github.com/url-shortener-microservices/pkg/totp"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// Recovery codes are 10 base32 characters, about 50 bits, shown as xxxxx-xxxxx
+const (
+	recoveryCodeCount    = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+// codeSkew is how many 30 second steps a code may be off, for clock drift
+const codeSkew = 1
+
+// TwoFactorGuard seals TOTP secrets and throttles wrong codes per account
+type TwoFactorGuard struct {
+	cipher   domain.SecretCipher
+	attempts ratelimit.AttemptLimiter
+	issuer   string
+}
+
+// NewTwoFactorGuard creates a guard; issuer names the service in authenticator apps
+func NewTwoFactorGuard(cipher domain.SecretCipher, attempts ratelimit.AttemptLimiter, issuer string) *TwoFactorGuard {
+	return &TwoFactorGuard{cipher: cipher, attempts: attempts, issuer: issuer}
+}
+
+// blocked returns a rate limit error when the account has seen too many wrong codes
+func (g *TwoFactorGuard) blocked(ctx context.Context, userID, field string) (*apperrors.AppError, error) {
+	wait, err := g.attempts.Blocked(ctx, userID)
+	if err != nil || wait <= 0 {
+		return nil, err
+	}
+	return apperrors.RateLimit("too many wrong two-factor codes, try again later").
+		WithField(field).
+		WithDetail("retry_after", int(math.Ceil(wait.Seconds()))), nil
+}
+
+// TwoFactorSetup is a pending enrolment for the user to add to an authenticator app
+type TwoFactorSetup struct {
+	Secret string // Base32, for manual entry
+	URI    string // otpauth:// URI, for QR codes
+}
+
+// TwoFactorReauthInput proves the account holder is present before 2FA changes
+type TwoFactorReauthInput struct {
+	UserID   string
+	Password string
+	Code     string // Authenticator or recovery code
+}
+
+// BeginTwoFactorSetup starts TOTP enrolment with a new secret, replacing an
+// unconfirmed one. 2FA is not required until ConfirmTwoFactorSetup succeeds.
+// The address must be verified, it is the way back in after a lost device.
+func (s *UserService) BeginTwoFactorSetup(ctx context.Context, userID string) (*TwoFactorSetup, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	u, err := s.GetUser(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := requireVerifiedEmail(u); err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, s.internal(err, "failed to generate two-factor secret")
+	}
+	sealed, err := s.guard.cipher.Seal(secret, []byte(u.ID))
+	if err != nil {
+		return nil, s.internal(err, "failed to encrypt two-factor secret")
+	}
+
+	err = s.twoFactors.SavePending(ctx, &domain.TwoFactor{UserID: u.ID, Secret: sealed, CreatedAt: s.now()})
+	if errors.Is(err, domain.ErrTwoFactorEnabled) {
+		return nil, apperrors.AlreadyExists("two-factor authentication is already enabled")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to save two-factor setup")
+	}
+
+	return &TwoFactorSetup{
+		Secret: totp.EncodeSecret(secret),
+		URI:    totp.URI(s.guard.issuer, u.Email, secret),
+	}, nil
+}
+
+// ConfirmTwoFactorSetup enables 2FA once code proves the authenticator app
+// holds the secret, and returns the recovery codes
+func (s *UserService) ConfirmTwoFactorSetup(ctx context.Context, userID, code string) ([]string, error) {
+	if userID == "" {
+		return nil, apperrors.Unauthorized("authentication required")
+	}
+	if code == "" {
+		return nil, apperrors.Validation("code is required").WithField("code")
+	}
+
+	tf, err := s.twoFactors.Get(ctx, userID)
+	if errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, apperrors.NotFound("no two-factor setup in progress").WithField("code")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load two-factor setup")
+	}
+	if tf.Enabled() {
+		return nil, apperrors.AlreadyExists("two-factor authentication is already enabled")
+	}
+
+	if appErr, err := s.guard.blocked(ctx, userID, "code"); err != nil {
+		return nil, s.internal(err, "failed to check two-factor attempts")
+	} else if appErr != nil {
+		return nil, appErr
+	}
+	secret, err := s.guard.cipher.Open(tf.Secret, []byte(tf.UserID))
+	if err != nil {
+		return nil, s.internal(err, "failed to decrypt two-factor secret")
+	}
+	step, ok := totp.Match(secret, normalizeCode(code), s.now(), codeSkew)
+	if !ok {
+		return nil, s.wrongCode(ctx, userID, "code")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, s.internal(err, "failed to generate recovery codes")
+	}
+	err = s.twoFactors.Confirm(ctx, userID, step, hashes, s.now())
+	if errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, apperrors.NotFound("no two-factor setup in progress").WithField("code")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to enable two-factor authentication")
+	}
+	s.resetAttempts(ctx, userID)
+
+	s.logger.Info("two-factor authentication enabled", zap.String("user_id", userID))
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes
+func (s *UserService) RegenerateRecoveryCodes(ctx context.Context, in TwoFactorReauthInput) ([]string, error) {
+	if err := s.reauthenticate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, s.internal(err, "failed to generate recovery codes")
+	}
+	err = s.twoFactors.SetRecoveryCodes(ctx, in.UserID, hashes)
+	if errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, apperrors.NotFound("two-factor authentication is not enabled")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to save recovery codes")
+	}
+	return codes, nil
+}
+
+// DisableTwoFactor turns 2FA off and forgets the secret and recovery codes
+func (s *UserService) DisableTwoFactor(ctx context.Context, in TwoFactorReauthInput) error {
+	if err := s.reauthenticate(ctx, in); err != nil {
+		return err
+	}
+
+	err := s.twoFactors.Delete(ctx, in.UserID)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return s.internal(err, "failed to disable two-factor authentication")
+	}
+
+	s.logger.Info("two-factor authentication disabled", zap.String("user_id", in.UserID))
+	return nil
+}
+
+// checkTwoFactor requires a valid code at login when the user enabled 2FA
+func (s *UserService) checkTwoFactor(ctx context.Context, u *domain.User, code string) error {
+	tf, err := s.twoFactors.Get(ctx, u.ID)
+	if errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil
+	}
+	if err != nil {
+		return s.internal(err, "failed to load two-factor setup")
+	}
+	if !tf.Enabled() {
+		return nil
+	}
+	if code == "" {
+		return apperrors.New(apperrors.CodeTwoFactorRequired, "two-factor code required").WithField("totp_code")
+	}
+	return s.verifyCode(ctx, tf, code, "totp_code")
+}
+
+// reauthenticate checks the password and a current code of an account with 2FA
+// enabled. Wrong passwords count as wrong codes so neither can be guessed.
+func (s *UserService) reauthenticate(ctx context.Context, in TwoFactorReauthInput) error {
+	if in.UserID == "" {
+		return apperrors.Unauthorized("authentication required")
+	}
+	u, err := s.GetUser(ctx, in.UserID, "")
+	if err != nil {
+		return err
+	}
+	tf, err := s.twoFactors.Get(ctx, u.ID)
+	if errors.Is(err, domain.ErrTwoFactorNotFound) || (err == nil && !tf.Enabled()) {
+		return apperrors.NotFound("two-factor authentication is not enabled")
+	}
+	if err != nil {
+		return s.internal(err, "failed to load two-factor setup")
+	}
+
+	if appErr, err := s.guard.blocked(ctx, u.ID, "code"); err != nil {
+		return s.internal(err, "failed to check two-factor attempts")
+	} else if appErr != nil {
+		return appErr
+	}
+	if err := s.hasher.Verify(in.Password, u.PasswordHash); err != nil {
+		if !errors.Is(err, password.ErrMismatch) {
+			s.logger.Error("unreadable password hash", zap.String("user_id", u.ID), zap.Error(err))
+		}
+		if err := s.guard.attempts.Fail(ctx, u.ID); err != nil {
+			return s.internal(err, "failed to record two-factor attempt")
+		}
+		return apperrors.New(apperrors.CodeInvalidCredentials, "password is incorrect").WithField("password")
+	}
+	return s.verifyCode(ctx, tf, in.Code, "code")
+}
+
+// verifyCode accepts an authenticator code newer than the last one used, or an
+// unused recovery code, which is then spent
+func (s *UserService) verifyCode(ctx context.Context, tf *domain.TwoFactor, code, field string) error {
+	if appErr, err := s.guard.blocked(ctx, tf.UserID, field); err != nil {
+		return s.internal(err, "failed to check two-factor attempts")
+	} else if appErr != nil {
+		return appErr
+	}
+
+	code = normalizeCode(code)
+	var err error
+	switch len(code) {
+	case totp.Digits:
+		secret, openErr := s.guard.cipher.Open(tf.Secret, []byte(tf.UserID))
+		if openErr != nil {
+			return s.internal(openErr, "failed to decrypt two-factor secret")
+		}
+		step, ok := totp.Match(secret, code, s.now(), codeSkew)
+		if !ok {
+			return s.wrongCode(ctx, tf.UserID, field)
+		}
+		err = s.twoFactors.UseStep(ctx, tf.UserID, step)
+	case recoveryCodeLength:
+		err = s.twoFactors.UseRecoveryCode(ctx, tf.UserID, hashRecoveryCode(code))
+		if err == nil {
+			s.logger.Info("recovery code used", zap.String("user_id", tf.UserID),
+				zap.Int("remaining", len(tf.RecoveryCodes)-1))
+		}
+	default:
+		return s.wrongCode(ctx, tf.UserID, field)
+	}
+
+	if errors.Is(err, domain.ErrCodeUsed) {
+		return s.wrongCode(ctx, tf.UserID, field)
+	}
+	if err != nil {
+		return s.internal(err, "failed to record two-factor code")
+	}
+	s.resetAttempts(ctx, tf.UserID)
+	return nil
+}
+
+func (s *UserService) wrongCode(ctx context.Context, userID, field string) error {
+	if err := s.guard.attempts.Fail(ctx, userID); err != nil {
+		return s.internal(err, "failed to record two-factor attempt")
+	}
+	return apperrors.New(apperrors.CodeInvalidTwoFactorCode, "two-factor code is invalid").WithField(field)
+}
+
+// resetAttempts clears wrong codes after a success. Failures are logged only,
+// the counter then just expires with its window.
+func (s *UserService) resetAttempts(ctx context.Context, userID string) {
+	if err := s.guard.attempts.Reset(ctx, userID); err != nil {
+		s.logger.Warn("failed to reset two-factor attempts", zap.String("user_id", userID), zap.Error(err))
+	}
+}
+
+// normalizeCode drops the separators users copy along with codes
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// newRecoveryCodes returns codes to show once and the hashes to store
+func newRecoveryCodes() (codes, hashes []string, err error) {
+	buf := make([]byte, recoveryCodeCount*recoveryCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, nil, err
+	}
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		code := make([]byte, recoveryCodeLength)
+		for j := range code {
+			// 256 is a multiple of the alphabet size, so every character is equally likely
+			code[j] = recoveryCodeAlphabet[int(buf[i*recoveryCodeLength+j])%len(recoveryCodeAlphabet)]
+		}
+		codes = append(codes, string(code[:5])+"-"+string(code[5:]))
+		hashes = append(hashes, hashRecoveryCode(string(code)))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode hashes a normalized recovery code. Codes are random enough
+// that a fast hash does not make them guessable.
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,148 @@
+package application
+
+import (
+	"context"
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/totp"
+)
+
+type twoFactorFixture struct {
+	svc    *UserService
+	now    time.Time
+	userID string
+	secret []byte
+}
+
+// newTwoFactorFixture registers a user and enables 2FA, returning the recovery codes
+func newTwoFactorFixture(t *testing.T) (*twoFactorFixture, []string) {
+	f := &twoFactorFixture{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	users := newMemoryUsers()
+	f.svc = newClockedService(users, &f.now)
+	ctx := context.Background()
+
+	u, err := f.svc.Register(ctx, RegisterInput{Email: "ada@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	require.NoError(t, users.MarkEmailVerified(ctx, u.ID, f.now))
+	f.userID = u.ID
+
+	setup, err := f.svc.BeginTwoFactorSetup(ctx, u.ID)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(setup.URI, "otpauth://totp/"))
+	f.secret, err = base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(setup.Secret)
+	require.NoError(t, err)
+
+	codes, err := f.svc.ConfirmTwoFactorSetup(ctx, u.ID, f.code())
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+	f.tick()
+	return f, codes
+}
+
+// code returns the authenticator code of the current step
+func (f *twoFactorFixture) code() string {
+	return totp.Code(f.secret, totp.Step(f.now))
+}
+
+// tick moves to the next time step
+func (f *twoFactorFixture) tick() {
+	f.now = f.now.Add(totp.Period * time.Second)
+}
+
+func (f *twoFactorFixture) login(code string) (*LoginResult, error) {
+	return f.svc.Login(context.Background(), LoginInput{Email: "ada@example.com", Password: "correct horse", TOTPCode: code})
+}
+
+func TestUserService_TwoFactor_Setup(t *testing.T) {
+	users := newMemoryUsers()
+	svc := newTestService(users)
+	ctx := context.Background()
+	u, err := svc.Register(ctx, RegisterInput{Email: "ada@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+
+	_, err = svc.BeginTwoFactorSetup(ctx, u.ID)
+	assertCode(t, err, apperrors.CodeEmailNotVerified, "email")
+	require.NoError(t, users.MarkEmailVerified(ctx, u.ID, time.Now()))
+
+	// A pending setup is not enforced and can be restarted
+	_, err = svc.BeginTwoFactorSetup(ctx, u.ID)
+	require.NoError(t, err)
+	_, err = svc.Login(ctx, LoginInput{Email: "ada@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	setup, err := svc.BeginTwoFactorSetup(ctx, u.ID)
+	require.NoError(t, err)
+
+	_, err = svc.ConfirmTwoFactorSetup(ctx, u.ID, "000000")
+	assertCode(t, err, apperrors.CodeInvalidTwoFactorCode, "code")
+
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(setup.Secret)
+	require.NoError(t, err)
+	_, err = svc.ConfirmTwoFactorSetup(ctx, u.ID, totp.Code(secret, totp.Step(time.Now())))
+	require.NoError(t, err)
+
+	_, err = svc.BeginTwoFactorSetup(ctx, u.ID)
+	assertCode(t, err, apperrors.CodeAlreadyExists, "")
+	_, err = svc.BeginTwoFactorSetup(ctx, "")
+	assertCode(t, err, apperrors.CodeUnauthorized, "")
+}
+
+func TestUserService_Login_TwoFactor(t *testing.T) {
+	f, recovery := newTwoFactorFixture(t)
+
+	_, err := f.login("")
+	assertCode(t, err, apperrors.CodeTwoFactorRequired, "totp_code")
+	_, err = f.login("000000")
+	assertCode(t, err, apperrors.CodeInvalidTwoFactorCode, "totp_code")
+
+	code := f.code()
+	_, err = f.login(code)
+	require.NoError(t, err)
+	_, err = f.login(code)
+	assertCode(t, err, apperrors.CodeInvalidTwoFactorCode, "totp_code")
+
+	// Recovery codes work once, with or without separators
+	_, err = f.login(strings.ToUpper(strings.ReplaceAll(recovery[0], "-", " ")))
+	require.NoError(t, err)
+	_, err = f.login(recovery[0])
+	assertCode(t, err, apperrors.CodeInvalidTwoFactorCode, "totp_code")
+}
+
+func TestUserService_Login_TwoFactorThrottled(t *testing.T) {
+	f, _ := newTwoFactorFixture(t)
+
+	for i := 0; i < 3; i++ {
+		_, err := f.login("000000")
+		assertCode(t, err, apperrors.CodeInvalidTwoFactorCode, "totp_code")
+	}
+	_, err := f.login(f.code())
+	assertCode(t, err, apperrors.CodeRateLimit, "totp_code")
+}
+
+func TestUserService_TwoFactor_Reauthenticate(t *testing.T) {
+	f, recovery := newTwoFactorFixture(t)
+	ctx := context.Background()
+
+	_, err := f.svc.RegenerateRecoveryCodes(ctx, TwoFactorReauthInput{UserID: f.userID, Password: "wrong password", Code: f.code()})
+	assertCode(t, err, apperrors.CodeInvalidCredentials, "password")
+
+	fresh, err := f.svc.RegenerateRecoveryCodes(ctx, TwoFactorReauthInput{UserID: f.userID, Password: "correct horse", Code: f.code()})
+	require.NoError(t, err)
+	assert.Len(t, fresh, recoveryCodeCount)
+	_, err = f.login(recovery[1])
+	assertCode(t, err, apperrors.CodeInvalidTwoFactorCode, "totp_code")
+
+	err = f.svc.DisableTwoFactor(ctx, TwoFactorReauthInput{UserID: f.userID, Password: "correct horse", Code: fresh[0]})
+	require.NoError(t, err)
+	_, err = f.login("")
+	require.NoError(t, err)
+
+	err = f.svc.DisableTwoFactor(ctx, TwoFactorReauthInput{UserID: f.userID, Password: "correct horse", Code: fresh[1]})
+	assertCode(t, err, apperrors.CodeNotFound, "")
+}
@@ -0,0 +1,317 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/pagination"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// dummyPassword is hashed once at startup; logins for unknown emails verify
+// against it so they take as long as a wrong password
+const dummyPassword = "timing-equalization-placeholder"
+
+// RegisterInput holds data for creating an account
+type RegisterInput struct {
+	Email    string
+	Password string
+	Username string // Optional
+	FullName string
+}
+
+// LoginInput holds credentials for signing in
+type LoginInput struct {
+	Email      string
+	Password   string
+	TOTPCode   string // Authenticator or recovery code, required once 2FA is enabled
+	RememberMe bool   // Start a session lasting RememberTTL instead of SessionTTL
+	UserAgent  string
+	IPAddress  string
+}
+
+// LoginResult holds the signed-in user, their new session and its tokens
+type LoginResult struct {
+	User    *domain.User
+	Session *domain.Session
+	Tokens  TokenPair
+}
+
+// UserOptions configures the user service
+type UserOptions struct {
+	MinPasswordLength int           // Characters required in new passwords
+	AccessTTL         time.Duration // Lifetime of access tokens
+	SessionTTL        time.Duration // Lifetime of sessions started without remember me
+	RememberTTL       time.Duration // Lifetime of sessions started with remember me
+	RequireVerified   bool          // Refuse logins until the email address is verified
+	DefaultListLimit  int           // Page size of list operations when none is requested
+	MaxListLimit      int           // Largest page size of list operations
+}
+
+// UserService implements account registration and authentication
+type UserService struct {
+	repo       domain.UserRepository
+	sessions   domain.SessionRepository
+	twoFactors domain.TwoFactorRepository
+	apiKeys    domain.APIKeyRepository
+	hasher     *password.Hasher
+	guard      *TwoFactorGuard
+	emails     *AccountEmails
+	tokens     domain.TokenIssuer
+	cursors    *pagination.Codec
+	opts       UserOptions
+	logger     *logger.Logger
+	now        func() time.Time
+	dummyHash  string
+}
+
+// NewUserService creates a new user service
+func NewUserService(repo domain.UserRepository, sessions domain.SessionRepository, twoFactors domain.TwoFactorRepository,
+	apiKeys domain.APIKeyRepository, hasher *password.Hasher, guard *TwoFactorGuard, emails *AccountEmails, tokens domain.TokenIssuer,
+	cursors *pagination.Codec, opts UserOptions, log *logger.Logger) *UserService {
+	// A failed hash leaves the dummy empty, unknown emails are then merely rejected faster
+	dummy, _ := hasher.Hash(dummyPassword)
+	return &UserService{
+		repo:       repo,
+		sessions:   sessions,
+		twoFactors: twoFactors,
+		apiKeys:    apiKeys,
+		hasher:     hasher,
+		guard:      guard,
+		emails:     emails,
+		tokens:     tokens,
+		cursors:    cursors,
+		opts:       opts,
+		logger:     log,
+		now:        time.Now,
+		dummyHash:  dummy,
+	}
+}
+
+// Register creates an account with an argon2id hashed password and mails a
+// link to verify its address
+func (s *UserService) Register(ctx context.Context, in RegisterInput) (*domain.User, error) {
+	email := NormalizeEmail(in.Email)
+	if err := ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	username := NormalizeUsername(in.Username)
+	if username != "" {
+		if err := ValidateUsername(username); err != nil {
+			return nil, err
+		}
+	}
+	fullName, appErr := validateFullName(in.FullName)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if err := ValidatePassword(in.Password, s.opts.MinPasswordLength); err != nil {
+		return nil, err
+	}
+
+	hash, err := s.hasher.Hash(in.Password)
+	if err != nil {
+		return nil, s.internal(err, "failed to hash password")
+	}
+
+	now := s.now()
+	u := &domain.User{
+		Email:        email,
+		Username:     username,
+		FullName:     fullName,
+		PasswordHash: hash,
+		IsActive:     true,
+		Roles:        []string{domain.RoleUser},
+		Settings:     domain.DefaultSettings,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.repo.Create(ctx, u); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEmailTaken):
+			return nil, apperrors.New(apperrors.CodeEmailTaken, "email is already registered").WithField("email")
+		case errors.Is(err, domain.ErrUsernameTaken):
+			return nil, apperrors.Newf(apperrors.CodeUsernameTaken, "username %q is already taken", username).WithField("username")
+		}
+		return nil, s.internal(err, "failed to create user")
+	}
+
+	s.logger.Info("user registered", zap.String("user_id", u.ID))
+	s.mailToken(ctx, u, domain.PurposeVerifyEmail)
+	return u, nil
+}
+
+// Login checks credentials, the verified address when required and the
+// two-factor code when 2FA is enabled, then starts a session. Unknown emails and wrong passwords fail alike so responses
+// do not reveal which emails are registered.
+func (s *UserService) Login(ctx context.Context, in LoginInput) (*LoginResult, error) {
+	u, err := s.repo.GetByEmail(ctx, NormalizeEmail(in.Email))
+	if errors.Is(err, domain.ErrUserNotFound) {
+		s.hasher.Verify(in.Password, s.dummyHash)
+		return nil, invalidCredentials()
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load user")
+	}
+
+	if err := s.hasher.Verify(in.Password, u.PasswordHash); err != nil {
+		if !errors.Is(err, password.ErrMismatch) {
+			s.logger.Error("unreadable password hash", zap.String("user_id", u.ID), zap.Error(err))
+		}
+		return nil, invalidCredentials()
+	}
+	if !u.IsActive {
+		return nil, apperrors.Forbidden("account is disabled")
+	}
+	if s.opts.RequireVerified {
+		if err := requireVerifiedEmail(u); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkTwoFactor(ctx, u, in.TOTPCode); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	s.rehash(ctx, u, in.Password, now)
+	if err := s.repo.RecordLogin(ctx, u.ID, now); err != nil {
+		return nil, s.internal(err, "failed to record login")
+	}
+	u.LastLogin = &now
+
+	session, err := s.startSession(ctx, u, in, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordUnchanged(ctx, u, session, now); err != nil {
+		return nil, err
+	}
+	tokens, err := s.issuePair(u, session, now)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{User: u, Session: session, Tokens: *tokens}, nil
+}
+
+// ValidateToken verifies a token of typ, access when empty, and returns its
+// claims. Access tokens are checked statelessly, so one stays valid until it
+// expires even if its session is revoked.
+func (s *UserService) ValidateToken(_ context.Context, token, typ string) (*domain.TokenClaims, error) {
+	if typ == "" {
+		typ = domain.TokenAccess
+	}
+	if typ != domain.TokenAccess && typ != domain.TokenRefresh {
+		return nil, apperrors.Validation("token_type must be access or refresh").WithField("token_type")
+	}
+	if token == "" {
+		return nil, apperrors.Validation("token is required").WithField("token")
+	}
+
+	claims, err := s.tokens.Parse(token, typ, s.now())
+	if err != nil {
+		return nil, tokenError(err)
+	}
+	return claims, nil
+}
+
+// GetUser returns a user by ID, or by email when no ID is given
+func (s *UserService) GetUser(ctx context.Context, userID, email string) (*domain.User, error) {
+	var (
+		u   *domain.User
+		err error
+	)
+	switch {
+	case userID != "":
+		u, err = s.repo.GetByID(ctx, userID)
+	case email != "":
+		u, err = s.repo.GetByEmail(ctx, NormalizeEmail(email))
+	default:
+		return nil, apperrors.Validation("user_id or email is required").WithField("user_id")
+	}
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, apperrors.NotFound("user not found")
+	}
+	if err != nil {
+		return nil, s.internal(err, "failed to load user")
+	}
+	return u, nil
+}
+
+// Health checks the service dependencies
+func (s *UserService) Health(ctx context.Context) error {
+	if err := s.repo.Ping(ctx); err != nil {
+		return apperrors.Wrap(err, apperrors.CodeInternal, "database unavailable")
+	}
+	return nil
+}
+
+// checkPasswordUnchanged ends a session just started by a login if the password
+// was reset since the login checked it. The reset revokes sessions after
+// storing the password, so a session it missed is always caught here.
+func (s *UserService) checkPasswordUnchanged(ctx context.Context, u *domain.User, session *domain.Session, now time.Time) error {
+	current, err := s.repo.GetByID(ctx, u.ID)
+	if err != nil {
+		return s.internal(err, "failed to load user")
+	}
+	if equalTimes(current.PasswordChangedAt, u.PasswordChangedAt) {
+		return nil
+	}
+	if err := s.sessions.Revoke(ctx, u.ID, session.ID, domain.RevokedOnPasswordReset, now); err != nil &&
+		!errors.Is(err, domain.ErrSessionNotFound) {
+		return s.internal(err, "failed to revoke session")
+	}
+	return invalidCredentials()
+}
+
+// equalTimes reports whether a and b are both nil or the same instant
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// rehash upgrades a legacy or outdated hash after a successful login. Failures
+// are logged only, the old hash still verifies. The hash is only replaced if
+// it is still the one checked, so a reset that ran meanwhile is not undone.
+func (s *UserService) rehash(ctx context.Context, u *domain.User, plain string, now time.Time) {
+	if !s.hasher.NeedsRehash(u.PasswordHash) {
+		return
+	}
+	hash, err := s.hasher.Hash(plain)
+	if err == nil {
+		err = s.repo.ReplacePasswordHash(ctx, u.ID, u.PasswordHash, hash, now)
+	}
+	if errors.Is(err, domain.ErrUserNotFound) {
+		// The password changed since it was checked
+		return
+	}
+	if err != nil {
+		s.logger.Warn("failed to upgrade password hash", zap.String("user_id", u.ID), zap.Error(err))
+		return
+	}
+	u.PasswordHash = hash
+}
+
+func invalidCredentials() *apperrors.AppError {
+	return apperrors.New(apperrors.CodeInvalidCredentials, "invalid email or password")
+}
+
+// tokenError converts a TokenIssuer.Parse error
+func tokenError(err error) *apperrors.AppError {
+	if errors.Is(err, domain.ErrTokenExpired) {
+		return apperrors.New(apperrors.CodeExpiredToken, "token has expired")
+	}
+	return apperrors.Wrap(err, apperrors.CodeInvalidToken, "token is invalid")
+}
+
+func (s *UserService) internal(err error, message string) *apperrors.AppError {
+	s.logger.Error(message, zap.Error(err))
+	return apperrors.Wrap(err, apperrors.CodeInternal, message)
+}
@@ -0,0 +1,195 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/logger"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// testHasher keeps argon2id cheap in tests
+var testHasher = password.NewHasher(password.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 8, KeyLength: 16})
+
+var testOptions = UserOptions{
+	MinPasswordLength: 8,
+	AccessTTL:         15 * time.Minute,
+	SessionTTL:        24 * time.Hour,
+	RememberTTL:       30 * 24 * time.Hour,
+}
+
+func newTestService(repo *memoryUsers) *UserService {
+	return NewUserService(repo, newMemorySessions(), newMemoryTwoFactors(), newMemoryAPIKeys(), testHasher, newTestGuard(),
+		newTestEmails(&memoryMailer{}), newStubTokens(), nil, testOptions, logger.Default("test"))
+}
+
+// newClockedService is newTestService with a clock that reads *now, so
+// fixtures can move time by changing it
+func newClockedService(repo *memoryUsers, now *time.Time) *UserService {
+	svc := newTestService(repo)
+	svc.now = func() time.Time { return *now }
+	return svc
+}
+
+func newTestGuard() *TwoFactorGuard {
+	return NewTwoFactorGuard(plainCipher{}, &countingAttempts{max: 3}, "URL Shortener")
+}
+
+func newTestEmails(mailer *memoryMailer) *AccountEmails {
+	return NewAccountEmails(newMemoryAccountTokens(), mailer, EmailOptions{
+		ProductName:     "URL Shortener",
+		VerifyURL:       "https://app.example.com/verify-email",
+		ResetURL:        "https://app.example.com/reset-password?lang=en",
+		VerificationTTL: 48 * time.Hour,
+		ResetTTL:        time.Hour,
+		MaxSends:        3,
+		SendWindow:      time.Hour,
+	})
+}
+
+func assertCode(t *testing.T, err error, code, field string) {
+	t.Helper()
+	appErr := apperrors.AsAppError(err)
+	require.NotNil(t, appErr, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+	assert.Equal(t, field, appErr.Field)
+}
+
+func TestUserService_Register(t *testing.T) {
+	repo := newMemoryUsers()
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	u, err := svc.Register(ctx, RegisterInput{Email: " Ada@Example.com ", Password: "correct horse", Username: "Ada_L", FullName: " Ada "})
+	require.NoError(t, err)
+	assert.NotEmpty(t, u.ID)
+	assert.Equal(t, "ada@example.com", u.Email)
+	assert.Equal(t, "ada_l", u.Username)
+	assert.Equal(t, "Ada", u.FullName)
+	assert.True(t, u.IsActive)
+	assert.Equal(t, []string{domain.RoleUser}, u.Roles)
+
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Contains(t, stored.PasswordHash, "$argon2id$")
+	assert.NoError(t, testHasher.Verify("correct horse", stored.PasswordHash))
+
+	_, err = svc.Register(ctx, RegisterInput{Email: "ADA@example.com", Password: "correct horse"})
+	assertCode(t, err, apperrors.CodeEmailTaken, "email")
+	_, err = svc.Register(ctx, RegisterInput{Email: "bob@example.com", Password: "correct horse", Username: "ada_l"})
+	assertCode(t, err, apperrors.CodeUsernameTaken, "username")
+}
+
+func TestUserService_Register_Rejects(t *testing.T) {
+	svc := newTestService(newMemoryUsers())
+
+	cases := map[string]RegisterInput{
+		"email":     {Email: "Ada <ada@example.com>", Password: "correct horse"},
+		"password":  {Email: "ada@example.com", Password: "short"},
+		"username":  {Email: "ada@example.com", Password: "correct horse", Username: "a!"},
+		"full_name": {Email: "ada@example.com", Password: "correct horse", FullName: string(make([]byte, 101))},
+	}
+	for field, in := range cases {
+		_, err := svc.Register(context.Background(), in)
+		assertCode(t, err, apperrors.CodeValidation, field)
+	}
+}
+
+func TestUserService_Login(t *testing.T) {
+	repo := newMemoryUsers()
+	svc := newTestService(repo)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	u, err := svc.Register(ctx, RegisterInput{Email: "ada@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+
+	res, err := svc.Login(ctx, LoginInput{Email: "ADA@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, res.User.ID)
+	assert.Equal(t, now.Add(15*time.Minute), res.Tokens.ExpiresAt)
+	require.NotNil(t, res.User.LastLogin)
+
+	claims, err := svc.ValidateToken(ctx, res.Tokens.AccessToken, "")
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, claims.UserID)
+
+	// Tokens are not interchangeable between types
+	_, err = svc.ValidateToken(ctx, res.Tokens.RefreshToken, domain.TokenAccess)
+	assertCode(t, err, apperrors.CodeInvalidToken, "")
+
+	for _, in := range []LoginInput{
+		{Email: "ada@example.com", Password: "wrong horse"},
+		{Email: "nobody@example.com", Password: "correct horse"},
+	} {
+		_, err := svc.Login(ctx, in)
+		assertCode(t, err, apperrors.CodeInvalidCredentials, "")
+	}
+
+	repo.update(u.ID, func(u *domain.User) { u.IsActive = false })
+	_, err = svc.Login(ctx, LoginInput{Email: "ada@example.com", Password: "correct horse"})
+	assertCode(t, err, apperrors.CodeForbidden, "")
+}
+
+func TestUserService_Login_UpgradesLegacyHash(t *testing.T) {
+	repo := newMemoryUsers()
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	u := &domain.User{Email: "ada@example.com", PasswordHash: string(legacy), IsActive: true}
+	require.NoError(t, repo.Create(ctx, u))
+
+	_, err = svc.Login(ctx, LoginInput{Email: "ada@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.False(t, testHasher.NeedsRehash(stored.PasswordHash))
+	assert.NoError(t, testHasher.Verify("correct horse", stored.PasswordHash))
+}
+
+// resettingUsers changes the password of a user right before a rehash is
+// stored, as a reset finishing between a login's check and its upgrade would
+type resettingUsers struct {
+	*memoryUsers
+	newHash string
+}
+
+func (r *resettingUsers) ReplacePasswordHash(ctx context.Context, id, oldHash, newHash string, at time.Time) error {
+	if err := r.memoryUsers.UpdatePasswordHash(ctx, id, r.newHash, at); err != nil {
+		return err
+	}
+	return r.memoryUsers.ReplacePasswordHash(ctx, id, oldHash, newHash, at)
+}
+
+func TestUserService_Login_RehashDoesNotUndoReset(t *testing.T) {
+	repo := &resettingUsers{memoryUsers: newMemoryUsers()}
+	svc := newTestService(repo.memoryUsers)
+	svc.repo = repo
+	ctx := context.Background()
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	u := &domain.User{Email: "ada@example.com", PasswordHash: string(legacy), IsActive: true}
+	require.NoError(t, repo.Create(ctx, u))
+	repo.newHash, err = testHasher.Hash("battery staple")
+	require.NoError(t, err)
+
+	// The login checked a password that no longer holds
+	_, err = svc.Login(ctx, LoginInput{Email: "ada@example.com", Password: "correct horse"})
+	assertCode(t, err, apperrors.CodeInvalidCredentials, "")
+
+	stored, err := repo.GetByID(ctx, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, repo.newHash, stored.PasswordHash, "the reset password is kept")
+}
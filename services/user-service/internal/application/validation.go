@@ -0,0 +1,136 @@
+package application
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	"github.com/url-shortener-microservices/pkg/password"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const (
+	maxEmailLength     = 254
+	maxFullNameLength  = 100
+	maxAvatarURLLength = 2048
+	maxHostnameLength  = 253
+)
+
+var (
+	usernamePattern = regexp.MustCompile(`^[a-z0-9_]{3,32}$`)
+	languagePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8}){0,3}$`)
+	hostnamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+)
+
+// NormalizeEmail trims and lower-cases an email address
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizeUsername trims and lower-cases a username
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// ValidateEmail checks that a normalized email is a bare address
+func ValidateEmail(email string) *apperrors.AppError {
+	if email == "" {
+		return apperrors.Validation("email is required").WithField("email")
+	}
+	if len(email) > maxEmailLength {
+		return apperrors.Validationf("email exceeds %d characters", maxEmailLength).WithField("email")
+	}
+	// Display names and comments parse too, only accept the address itself
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return apperrors.Validation("email is invalid").WithField("email")
+	}
+	return nil
+}
+
+// ValidateUsername checks a normalized username
+func ValidateUsername(username string) *apperrors.AppError {
+	if !usernamePattern.MatchString(username) {
+		return apperrors.Validation("username must be 3-32 letters, digits or '_'").WithField("username")
+	}
+	return nil
+}
+
+// ValidatePassword checks the length of a new password
+func ValidatePassword(plain string, minLength int) *apperrors.AppError {
+	if utf8.RuneCountInString(plain) < minLength {
+		return apperrors.Validationf("password must be at least %d characters", minLength).WithField("password")
+	}
+	if len(plain) > password.MaxLength {
+		return apperrors.Validationf("password exceeds %d bytes", password.MaxLength).WithField("password")
+	}
+	return nil
+}
+
+// validateFullName trims a display name and checks its length
+func validateFullName(name string) (string, *apperrors.AppError) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxFullNameLength {
+		return "", apperrors.Validationf("full name exceeds %d characters", maxFullNameLength).WithField("full_name")
+	}
+	return name, nil
+}
+
+// normalizeAvatarURL trims an avatar URL and checks it is an absolute http(s)
+// URL; empty removes the avatar
+func normalizeAvatarURL(raw string) (string, *apperrors.AppError) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	if len(raw) > maxAvatarURLLength {
+		return "", apperrors.Validationf("avatar URL exceeds %d characters", maxAvatarURLLength).WithField("avatar_url")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", apperrors.Validation("avatar URL must be an absolute http(s) URL").WithField("avatar_url")
+	}
+	return raw, nil
+}
+
+// normalizeTimezone checks an IANA time zone name; empty selects the default
+func normalizeTimezone(name string) (string, *apperrors.AppError) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.DefaultSettings.Timezone, nil
+	}
+	// Local names the server's zone, not one the user picked
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+		return "", apperrors.Validationf("unknown time zone %q", name).WithField("settings.timezone")
+	}
+	return name, nil
+}
+
+// normalizeLanguage checks the shape of a BCP 47 language tag; empty selects the default
+func normalizeLanguage(tag string) (string, *apperrors.AppError) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return domain.DefaultSettings.Language, nil
+	}
+	if !languagePattern.MatchString(tag) {
+		return "", apperrors.Validation("language must be a language tag such as en or pt-BR").WithField("settings.language")
+	}
+	return tag, nil
+}
+
+// normalizeDefaultDomain lower-cases a short domain and checks it is a hostname;
+// empty selects the service's own domain
+func normalizeDefaultDomain(host string) (string, *apperrors.AppError) {
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	if host == "" {
+		return "", nil
+	}
+	if len(host) > maxHostnameLength || !hostnamePattern.MatchString(host) {
+		return "", apperrors.Validation("default domain must be a hostname").WithField("settings.default_domain")
+	}
+	return host, nil
+}
@@ -0,0 +1,250 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/url-shortener-microservices/pkg/config"
+)
+
+// ServiceName is used for config lookup and env prefix
+const ServiceName = "user-service"
+
+// Config holds user service configuration
+type Config struct {
+	config.BaseConfig `mapstructure:",squash"`
+	Password          PasswordConfig  `mapstructure:"password"`
+	Session           SessionConfig   `mapstructure:"session"`
+	TwoFactor         TwoFactorConfig `mapstructure:"two_factor"`
+	Email             EmailConfig     `mapstructure:"email"`
+	List              ListConfig      `mapstructure:"list"`
+}
+
+// PasswordConfig holds account password settings
+type PasswordConfig struct {
+	MinLength   int `mapstructure:"min_length"`  // Characters required in new passwords
+	Memory      int `mapstructure:"memory"`      // argon2id memory in KiB
+	Iterations  int `mapstructure:"iterations"`  // argon2id passes over memory
+	Parallelism int `mapstructure:"parallelism"` // argon2id lanes
+}
+
+// SessionConfig holds login session settings. Sessions started with remember
+// me last jwt.refresh_token_expiry instead.
+type SessionConfig struct {
+	TTL string `mapstructure:"ttl"` // Lifetime of sessions started without remember me
+}
+
+// TwoFactorConfig holds TOTP two-factor authentication settings
+type TwoFactorConfig struct {
+	Issuer        string `mapstructure:"issuer"`         // Service name shown in authenticator apps
+	EncryptionKey string `mapstructure:"encryption_key"` // Base64 AES-256 key sealing TOTP secrets at rest
+	MaxAttempts   int    `mapstructure:"max_attempts"`   // Wrong codes per account before it is blocked
+	AttemptWindow string `mapstructure:"attempt_window"` // Window the wrong codes are counted in
+	AttemptStore  string `mapstructure:"attempt_store"`  // redis or memory
+	KeyPrefix     string `mapstructure:"key_prefix"`     // Redis key prefix for attempt counters
+}
+
+// EmailConfig holds account email settings
+type EmailConfig struct {
+	Transport       string     `mapstructure:"transport"`        // smtp or outbox
+	From            string     `mapstructure:"from"`             // Sender address, optionally with a display name
+	OutboxDir       string     `mapstructure:"outbox_dir"`       // Directory the outbox transport writes .eml files to
+	SMTP            SMTPConfig `mapstructure:"smtp"`             // Relay of the smtp transport
+	ProductName     string     `mapstructure:"product_name"`     // Service name shown in emails
+	VerifyURL       string     `mapstructure:"verify_url"`       // Page completing verification, gets a token query parameter
+	ResetURL        string     `mapstructure:"reset_url"`        // Page completing password resets, gets a token query parameter
+	VerificationTTL string     `mapstructure:"verification_ttl"` // Lifetime of verification links
+	ResetTTL        string     `mapstructure:"reset_ttl"`        // Lifetime of password reset links
+	MaxSends        int        `mapstructure:"max_sends"`        // Emails per user and purpose within send_window
+	SendWindow      string     `mapstructure:"send_window"`
+	RequireVerified bool       `mapstructure:"require_verified"` // Refuse logins until the address is verified
+}
+
+// ListConfig holds admin user listing settings
+type ListConfig struct {
+	DefaultLimit int    `mapstructure:"default_limit"` // Page size when none is requested
+	MaxLimit     int    `mapstructure:"max_limit"`     // Largest page size
+	CursorSecret string `mapstructure:"cursor_secret"` // Signs pagination cursors, random per process if empty
+}
+
+// SMTPConfig holds SMTP relay settings. STARTTLS is used when offered.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"` // Empty disables authentication
+	Password string `mapstructure:"password"`
+}
+
+// Validate implements config.Config
+func (c Config) Validate() error {
+	if c.Database.Host == "" {
+		return fmt.Errorf("database.host is required")
+	}
+	if c.Database.Database == "" {
+		return fmt.Errorf("database.database is required")
+	}
+	if len(c.JWT.RefreshTokenSecret) < 32 {
+		return fmt.Errorf("jwt.refresh_token_secret must be at least 32 bytes")
+	}
+	if len(c.JWT.SigningKeys) == 0 {
+		if len(c.JWT.AccessTokenSecret) < 32 {
+			return fmt.Errorf("jwt.access_token_secret must be at least 32 bytes unless jwt.signing_keys are set")
+		}
+		if c.JWT.AccessTokenSecret == c.JWT.RefreshTokenSecret {
+			return fmt.Errorf("jwt.access_token_secret and jwt.refresh_token_secret must differ")
+		}
+	}
+	kids := make(map[string]bool, len(c.JWT.SigningKeys))
+	for i, k := range c.JWT.SigningKeys {
+		if k.ID == "" || kids[k.ID] {
+			return fmt.Errorf("jwt.signing_keys[%d].kid must be set and unique", i)
+		}
+		kids[k.ID] = true
+		if k.PrivateKeyFile == "" {
+			return fmt.Errorf("jwt.signing_keys[%d].private_key_file is required", i)
+		}
+		if k.ActiveFrom != "" {
+			if _, err := time.Parse(time.RFC3339, k.ActiveFrom); err != nil {
+				return fmt.Errorf("jwt.signing_keys[%d].active_from must be an RFC 3339 time", i)
+			}
+		}
+	}
+	access, err := time.ParseDuration(c.JWT.AccessTokenExpiry)
+	if err != nil || access <= 0 {
+		return fmt.Errorf("jwt.access_token_expiry must be a positive duration")
+	}
+	refresh, err := time.ParseDuration(c.JWT.RefreshTokenExpiry)
+	if err != nil || refresh <= access {
+		return fmt.Errorf("jwt.refresh_token_expiry must be a duration longer than jwt.access_token_expiry")
+	}
+	if d, err := time.ParseDuration(c.Session.TTL); err != nil || d < access || d > refresh {
+		return fmt.Errorf("session.ttl must be a duration between jwt.access_token_expiry and jwt.refresh_token_expiry")
+	}
+	if c.JWT.Issuer == "" || c.JWT.Audience == "" {
+		return fmt.Errorf("jwt.issuer and jwt.audience are required")
+	}
+	if c.Password.MinLength < 8 {
+		return fmt.Errorf("password.min_length must be at least 8")
+	}
+	if c.Password.Memory < 8*1024 || c.Password.Iterations < 1 || c.Password.Parallelism < 1 || c.Password.Parallelism > 255 {
+		return fmt.Errorf("password.memory must be at least 8192 KiB, password.iterations and password.parallelism (1-255) positive")
+	}
+	if key, err := base64.StdEncoding.DecodeString(c.TwoFactor.EncryptionKey); err != nil || len(key) != 32 {
+		return fmt.Errorf("two_factor.encryption_key must be a base64 encoded 32 byte key")
+	}
+	if c.TwoFactor.Issuer == "" {
+		return fmt.Errorf("two_factor.issuer is required")
+	}
+	if c.TwoFactor.MaxAttempts <= 0 {
+		return fmt.Errorf("two_factor.max_attempts must be positive")
+	}
+	if d, err := time.ParseDuration(c.TwoFactor.AttemptWindow); err != nil || d < time.Second {
+		return fmt.Errorf("two_factor.attempt_window must be a duration of at least 1s")
+	}
+	if c.TwoFactor.AttemptStore != "redis" && c.TwoFactor.AttemptStore != "memory" {
+		return fmt.Errorf("two_factor.attempt_store must be redis or memory")
+	}
+	if c.List.DefaultLimit <= 0 || c.List.MaxLimit < c.List.DefaultLimit {
+		return fmt.Errorf("list.default_limit must be positive and list.max_limit at least list.default_limit")
+	}
+	if s := c.List.CursorSecret; s != "" && len(s) < 32 {
+		return fmt.Errorf("list.cursor_secret must be at least 32 bytes")
+	}
+	return c.Email.validate()
+}
+
+func (c EmailConfig) validate() error {
+	switch c.Transport {
+	case "smtp":
+		if c.SMTP.Host == "" || c.SMTP.Port <= 0 || c.SMTP.Port > 65535 {
+			return fmt.Errorf("email.smtp.host and email.smtp.port (1-65535) are required for the smtp transport")
+		}
+	case "outbox":
+		if c.OutboxDir == "" {
+			return fmt.Errorf("email.outbox_dir is required for the outbox transport")
+		}
+	default:
+		return fmt.Errorf("email.transport must be smtp or outbox")
+	}
+	if _, err := mail.ParseAddress(c.From); err != nil {
+		return fmt.Errorf("email.from must be an email address")
+	}
+	if c.ProductName == "" {
+		return fmt.Errorf("email.product_name is required")
+	}
+	for key, link := range map[string]string{"email.verify_url": c.VerifyURL, "email.reset_url": c.ResetURL} {
+		if u, err := url.Parse(link); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("%s must be an absolute http(s) URL", key)
+		}
+	}
+	for key, value := range map[string]string{
+		"email.verification_ttl": c.VerificationTTL,
+		"email.reset_ttl":        c.ResetTTL,
+		"email.send_window":      c.SendWindow,
+	} {
+		if d, err := time.ParseDuration(value); err != nil || d < time.Minute {
+			return fmt.Errorf("%s must be a duration of at least 1m", key)
+		}
+	}
+	if c.MaxSends <= 0 {
+		return fmt.Errorf("email.max_sends must be positive")
+	}
+	return nil
+}
+
+// Load loads user service configuration from file and environment variables
+func Load(configPath string) (Config, error) {
+	return config.LoadConfig[Config](configPath, ServiceName)
+}
+
+// SetDefaults implements config.Defaulter
+func (Config) SetDefaults() {
+	viper.SetDefault("service_name", ServiceName)
+	viper.SetDefault("server.port", 8082)
+	viper.SetDefault("grpc.port", 8083)
+
+	// Database defaults
+	viper.SetDefault("database.user", "postgres")
+	viper.SetDefault("database.password", "postgres")
+	viper.SetDefault("database.database", "user_service")
+
+	// Password defaults, argon2id follows the OWASP baseline
+	viper.SetDefault("password.min_length", 8)
+	viper.SetDefault("password.memory", 19*1024)
+	viper.SetDefault("password.iterations", 2)
+	viper.SetDefault("password.parallelism", 1)
+
+	// Session defaults
+	viper.SetDefault("session.ttl", "24h")
+
+	// Two-factor defaults. Memory counters are per replica, use redis when
+	// running more than one.
+	viper.SetDefault("two_factor.issuer", "URL Shortener")
+	viper.SetDefault("two_factor.max_attempts", 5)
+	viper.SetDefault("two_factor.attempt_window", "15m")
+	viper.SetDefault("two_factor.attempt_store", "memory")
+	viper.SetDefault("two_factor.key_prefix", "2fa:")
+
+	// Email defaults. The outbox only writes files, set email.transport to
+	// smtp outside local development.
+	viper.SetDefault("email.transport", "outbox")
+	viper.SetDefault("email.outbox_dir", "tmp/outbox")
+	viper.SetDefault("email.from", "URL Shortener <no-reply@localhost>")
+	viper.SetDefault("email.smtp.port", 587)
+	viper.SetDefault("email.product_name", "URL Shortener")
+	viper.SetDefault("email.verify_url", "http://localhost:3000/verify-email")
+	viper.SetDefault("email.reset_url", "http://localhost:3000/reset-password")
+	viper.SetDefault("email.verification_ttl", "48h")
+	viper.SetDefault("email.reset_ttl", "1h")
+	viper.SetDefault("email.max_sends", 3)
+	viper.SetDefault("email.send_window", "1h")
+
+	// List defaults
+	viper.SetDefault("list.default_limit", 20)
+	viper.SetDefault("list.max_limit", 100)
+}
@@ -0,0 +1,40 @@
+package grpchandler
+
+import (
+	"context"
+
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+)
+
+// VerifyEmail completes email verification with a mailed link token
+func (s *Server) VerifyEmail(ctx context.Context, req *userpb.VerifyEmailRequest) (*userpb.VerifyEmailResponse, error) {
+	u, err := s.service.VerifyEmail(ctx, req.GetToken())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.VerifyEmailResponse{Status: successStatus(), User: toProto(u)}, nil
+}
+
+// ResendVerification mails a new verification link
+func (s *Server) ResendVerification(ctx context.Context, req *userpb.ResendVerificationRequest) (*userpb.ResendVerificationResponse, error) {
+	if err := s.service.ResendVerification(ctx, req.GetEmail()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.ResendVerificationResponse{Status: successStatus()}, nil
+}
+
+// ForgotPassword mails a password reset link
+func (s *Server) ForgotPassword(ctx context.Context, req *userpb.ForgotPasswordRequest) (*userpb.ForgotPasswordResponse, error) {
+	if err := s.service.ForgotPassword(ctx, req.GetEmail()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.ForgotPasswordResponse{Status: successStatus()}, nil
+}
+
+// ResetPassword sets a new password with a mailed link token
+func (s *Server) ResetPassword(ctx context.Context, req *userpb.ResetPasswordRequest) (*userpb.ResetPasswordResponse, error) {
+	if err := s.service.ResetPassword(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.ResetPasswordResponse{Status: successStatus()}, nil
+}
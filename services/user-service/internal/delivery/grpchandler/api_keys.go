@@ -0,0 +1,87 @@
+package grpchandler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/application"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// CreateAPIKey issues an API key, returning the raw key this once
+func (s *Server) CreateAPIKey(ctx context.Context, req *userpb.CreateAPIKeyRequest) (*userpb.CreateAPIKeyResponse, error) {
+	in := application.CreateAPIKeyInput{
+		UserID:      req.GetUserId(),
+		Name:        req.GetName(),
+		Permissions: req.GetPermissions(),
+		Scopes:      req.GetScopes(),
+		RateLimit:   req.GetRateLimit(),
+	}
+	if req.GetExpiresAt() != nil {
+		expires := req.GetExpiresAt().AsTime()
+		in.ExpiresAt = &expires
+	}
+	res, err := s.service.CreateAPIKey(ctx, in)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.CreateAPIKeyResponse{
+		Status: successStatus(),
+		ApiKey: apiKeyToProto(res.Key, time.Now()),
+		RawKey: res.RawKey,
+	}, nil
+}
+
+// ListAPIKeys returns a page of a user's API keys
+func (s *Server) ListAPIKeys(ctx context.Context, req *userpb.ListAPIKeysRequest) (*userpb.ListAPIKeysResponse, error) {
+	res, err := s.service.ListAPIKeys(ctx, application.ListAPIKeysInput{
+		UserID: req.GetUserId(),
+		SortBy: req.GetPagination().GetSortBy(),
+		Desc:   req.GetPagination().GetDesc(),
+		Page:   int(req.GetPagination().GetPage()),
+		Limit:  int(req.GetPagination().GetLimit()),
+		Cursor: req.GetPagination().GetCursor(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	now := time.Now()
+	resp := &userpb.ListAPIKeysResponse{
+		Status:     successStatus(),
+		ApiKeys:    make([]*userpb.APIKey, 0, len(res.Keys)),
+		Pagination: paginationResponse(res.Page, res.Limit, res.Total, res.NextCursor),
+	}
+	for _, k := range res.Keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(k, now))
+	}
+	return resp, nil
+}
+
+// RevokeAPIKey revokes one of a user's API keys
+func (s *Server) RevokeAPIKey(ctx context.Context, req *userpb.RevokeAPIKeyRequest) (*userpb.RevokeAPIKeyResponse, error) {
+	if err := s.service.RevokeAPIKey(ctx, req.GetUserId(), req.GetKeyId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.RevokeAPIKeyResponse{Status: successStatus()}, nil
+}
+
+// apiKeyToProto converts an API key, never including its hash
+func apiKeyToProto(k *domain.APIKey, now time.Time) *userpb.APIKey {
+	return &userpb.APIKey{
+		Id:          k.ID,
+		Name:        k.Name,
+		KeyPrefix:   k.KeyPrefix,
+		Permissions: k.Permissions,
+		Scopes:      k.Scopes,
+		IsActive:    k.IsActive(now),
+		CreatedAt:   timestamppb.New(k.CreatedAt),
+		LastUsed:    toTimestamp(k.LastUsedAt),
+		ExpiresAt:   toTimestamp(k.ExpiresAt),
+		UsageCount:  k.UsageCount,
+		RateLimit:   k.RateLimit,
+	}
+}
@@ -0,0 +1,79 @@
+package grpchandler
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// AccountMethods act on the account named by the request's user_id, so only
+// that user may call them
+var AccountMethods = []string{
+	userpb.UserService_ListSessions_FullMethodName,
+	userpb.UserService_RevokeSession_FullMethodName,
+	userpb.UserService_RevokeAllSessions_FullMethodName,
+	userpb.UserService_BeginTwoFactorSetup_FullMethodName,
+	userpb.UserService_ConfirmTwoFactorSetup_FullMethodName,
+	userpb.UserService_RegenerateRecoveryCodes_FullMethodName,
+	userpb.UserService_DisableTwoFactor_FullMethodName,
+}
+
+// TokenValidator checks tokens, implemented by application.UserService
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, token, typ string) (*domain.TokenClaims, error)
+}
+
+// AuthInterceptor makes calls to methods require the access token of the user
+// named by the request's user_id, sent as "authorization: Bearer <token>"
+// metadata. Other methods pass unchecked: logins and token refreshes have no
+// token yet, and lookups such as GetUser serve other services.
+func AuthInterceptor(tokens TokenValidator, methods ...string) grpc.UnaryServerInterceptor {
+	guarded := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		guarded[m] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !guarded[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if token == "" {
+			return nil, apperrors.Unauthorized("access token required")
+		}
+		claims, err := tokens.ValidateToken(ctx, token, domain.TokenAccess)
+		if err != nil {
+			return nil, toGRPCError(err)
+		}
+
+		var userID string
+		if r, ok := req.(interface{ GetUserId() string }); ok {
+			userID = r.GetUserId()
+		}
+		if userID != claims.UserID {
+			return nil, apperrors.Forbidden("user_id does not match the access token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// bearerToken returns the access token in the authorization metadata, empty if none was sent
+func bearerToken(ctx context.Context) (string, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if len(values) > 1 || !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", apperrors.New(apperrors.CodeInvalidToken, "authorization must be a single Bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
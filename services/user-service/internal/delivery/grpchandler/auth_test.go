@@ -0,0 +1,100 @@
+package grpchandler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// staticTokens accepts the access tokens it maps to user IDs
+type staticTokens map[string]string
+
+func (v staticTokens) ValidateToken(_ context.Context, token, typ string) (*domain.TokenClaims, error) {
+	if token == "expired" {
+		return nil, apperrors.New(apperrors.CodeExpiredToken, "token has expired")
+	}
+	userID, ok := v[token]
+	if !ok || typ != domain.TokenAccess {
+		return nil, apperrors.New(apperrors.CodeInvalidToken, "token is invalid")
+	}
+	return &domain.TokenClaims{Type: typ, UserID: userID}, nil
+}
+
+func callAuth(t *testing.T, method, authorization string, req interface{}) error {
+	t.Helper()
+	ctx := context.Background()
+	if authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+	called := false
+	interceptor := AuthInterceptor(staticTokens{"alice": "u1", "bob": "u2"}, AccountMethods...)
+	_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	assert.Equal(t, err == nil, called, "handler runs only for accepted requests")
+	return err
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	sessions := &userpb.ListSessionsRequest{UserId: "u1"}
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		req           interface{}
+		wantCode      string // Empty when the request is accepted
+	}{
+		{"owner", userpb.UserService_ListSessions_FullMethodName, "Bearer alice", sessions, ""},
+		{"missing token", userpb.UserService_ListSessions_FullMethodName, "", sessions, apperrors.CodeUnauthorized},
+		{"other user", userpb.UserService_ListSessions_FullMethodName, "Bearer bob", sessions, apperrors.CodeForbidden},
+		{"expired", userpb.UserService_ListSessions_FullMethodName, "Bearer expired", sessions, apperrors.CodeExpiredToken},
+		{"forged", userpb.UserService_ListSessions_FullMethodName, "Bearer forged", sessions, apperrors.CodeInvalidToken},
+		{"basic auth", userpb.UserService_ListSessions_FullMethodName, "Basic YWxpY2U6cHc=", sessions, apperrors.CodeInvalidToken},
+		{
+			"revoke for another user", userpb.UserService_RevokeSession_FullMethodName, "Bearer bob",
+			&userpb.RevokeSessionRequest{UserId: "u1", SessionId: "s1"}, apperrors.CodeForbidden,
+		},
+		{
+			"revoke all without token", userpb.UserService_RevokeAllSessions_FullMethodName, "",
+			&userpb.RevokeAllSessionsRequest{UserId: "u1"}, apperrors.CodeUnauthorized,
+		},
+		{
+			"2fa setup for another user", userpb.UserService_BeginTwoFactorSetup_FullMethodName, "Bearer bob",
+			&userpb.BeginTwoFactorSetupRequest{UserId: "u1"}, apperrors.CodeForbidden,
+		},
+		{
+			"2fa confirm without token", userpb.UserService_ConfirmTwoFactorSetup_FullMethodName, "",
+			&userpb.ConfirmTwoFactorSetupRequest{UserId: "u1"}, apperrors.CodeUnauthorized,
+		},
+		{
+			"recovery codes for another user", userpb.UserService_RegenerateRecoveryCodes_FullMethodName, "Bearer bob",
+			&userpb.RegenerateRecoveryCodesRequest{UserId: "u1"}, apperrors.CodeForbidden,
+		},
+		{
+			"2fa disable by owner", userpb.UserService_DisableTwoFactor_FullMethodName, "Bearer alice",
+			&userpb.DisableTwoFactorRequest{UserId: "u1"}, "",
+		},
+		{"unguarded method", userpb.UserService_GetUser_FullMethodName, "", &userpb.GetUserRequest{UserId: "u1"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := callAuth(t, tt.method, tt.authorization, tt.req)
+			if tt.wantCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			appErr := apperrors.AsAppError(err)
+			require.NotNil(t, appErr, "got %v", err)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	commonpb "github.com/url-shortener-microservices/proto/gen/common"
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/application"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// Server implements userpb.UserServiceServer
+type Server struct {
+	userpb.UnimplementedUserServiceServer
+
+	service *application.UserService
+}
+
+// NewServer creates a new user gRPC server
+func NewServer(service *application.UserService) *Server {
+	return &Server{service: service}
+}
+
+// Register creates an account
+func (s *Server) Register(ctx context.Context, req *userpb.RegisterRequest) (*userpb.RegisterResponse, error) {
+	u, err := s.service.Register(ctx, application.RegisterInput{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+		Username: req.GetUsername(),
+		FullName: req.GetFullName(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &userpb.RegisterResponse{
+		Status: successStatus(),
+		User:   toProto(u),
+	}, nil
+}
+
+// Login checks credentials and issues tokens
+func (s *Server) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
+	res, err := s.service.Login(ctx, application.LoginInput{
+		Email:      req.GetEmail(),
+		Password:   req.GetPassword(),
+		TOTPCode:   req.GetTotpCode(),
+		RememberMe: req.GetRememberMe(),
+		UserAgent:  req.GetUserAgent(),
+		IPAddress:  req.GetIpAddress(),
+	})
+	if err != nil {
+		// A missing code is a step of the login, not a failure
+		if appErr := apperrors.AsAppError(err); appErr != nil && appErr.Code == apperrors.CodeTwoFactorRequired {
+			return &userpb.LoginResponse{Status: errorStatus(appErr), TwoFactorRequired: true}, nil
+		}
+		return nil, toGRPCError(err)
+	}
+
+	return &userpb.LoginResponse{
+		Status:       successStatus(),
+		User:         toProto(res.User),
+		AccessToken:  res.Tokens.AccessToken,
+		RefreshToken: res.Tokens.RefreshToken,
+		ExpiresAt:    timestamppb.New(res.Tokens.ExpiresAt),
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new token pair
+func (s *Server) RefreshToken(ctx context.Context, req *userpb.RefreshTokenRequest) (*userpb.RefreshTokenResponse, error) {
+	tokens, err := s.service.RefreshToken(ctx, application.RefreshInput{
+		RefreshToken: req.GetRefreshToken(),
+		UserAgent:    req.GetUserAgent(),
+		IPAddress:    req.GetIpAddress(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &userpb.RefreshTokenResponse{
+		Status:       successStatus(),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    timestamppb.New(tokens.ExpiresAt),
+	}, nil
+}
+
+// ValidateToken verifies a token for other services
+func (s *Server) ValidateToken(ctx context.Context, req *userpb.ValidateTokenRequest) (*userpb.ValidateTokenResponse, error) {
+	claims, err := s.service.ValidateToken(ctx, req.GetToken(), req.GetTokenType())
+	if err != nil {
+		// A rejected token is a normal outcome, not an RPC failure
+		if appErr := apperrors.AsAppError(err); appErr != nil &&
+			(appErr.Code == apperrors.CodeInvalidToken || appErr.Code == apperrors.CodeExpiredToken) {
+			return &userpb.ValidateTokenResponse{Status: errorStatus(appErr)}, nil
+		}
+		return nil, toGRPCError(err)
+	}
+
+	return &userpb.ValidateTokenResponse{
+		Status:  successStatus(),
+		IsValid: true,
+		UserContext: &commonpb.UserContext{
+			UserId:    claims.UserID,
+			Email:     claims.Email,
+			Roles:     claims.Roles,
+			IsPremium: claims.IsPremium,
+		},
+		ExpiresAt: timestamppb.New(claims.ExpiresAt),
+	}, nil
+}
+
+// GetUser returns a user by ID or email
+func (s *Server) GetUser(ctx context.Context, req *userpb.GetUserRequest) (*userpb.GetUserResponse, error) {
+	u, err := s.service.GetUser(ctx, req.GetUserId(), req.GetEmail())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &userpb.GetUserResponse{
+		Status: successStatus(),
+		User:   toProto(u),
+	}, nil
+}
+
+// UpdateUser edits a user's profile and settings
+func (s *Server) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequest) (*userpb.UpdateUserResponse, error) {
+	in := application.UpdateUserInput{
+		UserID:    req.GetUserId(),
+		Username:  req.Username,
+		FullName:  req.FullName,
+		AvatarURL: req.AvatarUrl,
+		Mask:      req.GetUpdateMask().GetPaths(),
+	}
+	if req.Settings != nil {
+		settings := fromSettings(req.GetSettings())
+		in.Settings = &settings
+	}
+
+	u, err := s.service.UpdateUser(ctx, in)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &userpb.UpdateUserResponse{
+		Status: successStatus(),
+		User:   toProto(u),
+	}, nil
+}
+
+// ListUsers returns a page of accounts for administrators
+func (s *Server) ListUsers(ctx context.Context, req *userpb.ListUsersRequest) (*userpb.ListUsersResponse, error) {
+	res, err := s.service.ListUsers(ctx, application.ListUsersInput{
+		Search:      req.GetSearch(),
+		PremiumOnly: req.GetPremiumOnly(),
+		ActiveOnly:  req.GetActiveOnly(),
+		SortBy:      req.GetPagination().GetSortBy(),
+		Desc:        req.GetPagination().GetDesc(),
+		Page:        int(req.GetPagination().GetPage()),
+		Limit:       int(req.GetPagination().GetLimit()),
+		Cursor:      req.GetPagination().GetCursor(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &userpb.ListUsersResponse{
+		Status:     successStatus(),
+		Users:      make([]*userpb.User, 0, len(res.Users)),
+		Pagination: paginationResponse(res.Page, res.Limit, res.Total, res.NextCursor),
+	}
+	for _, u := range res.Users {
+		resp.Users = append(resp.Users, toProto(u))
+	}
+	return resp, nil
+}
+
+// HealthCheck reports service health
+func (s *Server) HealthCheck(ctx context.Context, req *commonpb.HealthCheckRequest) (*commonpb.HealthCheckResponse, error) {
+	resp := &commonpb.HealthCheckResponse{
+		Status:    commonpb.HealthCheckResponse_SERVING,
+		Timestamp: timestamppb.Now(),
+	}
+	if err := s.service.Health(ctx); err != nil {
+		resp.Status = commonpb.HealthCheckResponse_NOT_SERVING
+		resp.Message = err.Error()
+	}
+	return resp, nil
+}
+
+// toProto converts a domain user to its protobuf representation, never
+// including the password hash
+func toProto(u *domain.User) *userpb.User {
+	return &userpb.User{
+		Id:             u.ID,
+		Email:          u.Email,
+		Username:       u.Username,
+		FullName:       u.FullName,
+		AvatarUrl:      u.AvatarURL,
+		EmailVerified:  u.EmailVerified,
+		LastLogin:      toTimestamp(u.LastLogin),
+		IsActive:       u.IsActive,
+		IsPremium:      u.IsPremium,
+		PremiumExpires: toTimestamp(u.PremiumExpires),
+		CreatedAt:      timestamppb.New(u.CreatedAt),
+		UpdatedAt:      timestamppb.New(u.UpdatedAt),
+		Settings: &userpb.UserSettings{
+			PublicProfile:      u.Settings.PublicProfile,
+			AnalyticsEnabled:   u.Settings.AnalyticsEnabled,
+			EmailNotifications: u.Settings.EmailNotifications,
+			Timezone:           u.Settings.Timezone,
+			Language:           u.Settings.Language,
+			DefaultDomain:      u.Settings.DefaultDomain,
+			RequireHttps:       u.Settings.RequireHTTPS,
+		},
+	}
+}
+
+func fromSettings(s *userpb.UserSettings) domain.UserSettings {
+	return domain.UserSettings{
+		PublicProfile:      s.GetPublicProfile(),
+		AnalyticsEnabled:   s.GetAnalyticsEnabled(),
+		EmailNotifications: s.GetEmailNotifications(),
+		Timezone:           s.GetTimezone(),
+		Language:           s.GetLanguage(),
+		DefaultDomain:      s.GetDefaultDomain(),
+		RequireHTTPS:       s.GetRequireHttps(),
+	}
+}
+
+// toGRPCError converts an error into one carrying a gRPC status
+func toGRPCError(err error) error {
+	if appErr := apperrors.AsAppError(err); appErr != nil {
+		return appErr
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return apperrors.Wrap(err, apperrors.CodeTimeout, "request timed out")
+	}
+	return apperrors.Wrap(err, apperrors.CodeInternal, "internal error")
+}
+
+func successStatus() *commonpb.Response {
+	return &commonpb.Response{Success: true}
+}
+
+func errorStatus(appErr *apperrors.AppError) *commonpb.Response {
+	return &commonpb.Response{
+		Success: false,
+		Errors: []*commonpb.Error{{
+			Code:    appErr.Code,
+			Message: appErr.Message,
+			Field:   appErr.Field,
+		}},
+	}
+}
+
+func paginationResponse(page, limit int, total int64, nextCursor string) *commonpb.PaginationResponse {
+	totalPages := int32(0)
+	if limit > 0 {
+		totalPages = int32((total + int64(limit) - 1) / int64(limit))
+	}
+	return &commonpb.PaginationResponse{
+		Page:       int32(page),
+		Limit:      int32(limit),
+		TotalPages: totalPages,
+		TotalItems: total,
+		HasNext:    nextCursor != "" || int32(page) < totalPages,
+		HasPrev:    page > 1,
+		NextCursor: nextCursor,
+	}
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,57 @@
+package grpchandler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// ListSessions returns a user's active sessions
+func (s *Server) ListSessions(ctx context.Context, req *userpb.ListSessionsRequest) (*userpb.ListSessionsResponse, error) {
+	sessions, err := s.service.ListSessions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &userpb.ListSessionsResponse{
+		Status:   successStatus(),
+		Sessions: make([]*userpb.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, sessionToProto(session, req.GetCurrentSessionId()))
+	}
+	return resp, nil
+}
+
+// RevokeSession signs a user out of one session
+func (s *Server) RevokeSession(ctx context.Context, req *userpb.RevokeSessionRequest) (*userpb.RevokeSessionResponse, error) {
+	if err := s.service.RevokeSession(ctx, req.GetUserId(), req.GetSessionId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.RevokeSessionResponse{Status: successStatus()}, nil
+}
+
+// RevokeAllSessions signs a user out of every session but the excepted one
+func (s *Server) RevokeAllSessions(ctx context.Context, req *userpb.RevokeAllSessionsRequest) (*userpb.RevokeAllSessionsResponse, error) {
+	n, err := s.service.RevokeAllSessions(ctx, req.GetUserId(), req.GetExceptSessionId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.RevokeAllSessionsResponse{Status: successStatus(), RevokedCount: int32(n)}, nil
+}
+
+func sessionToProto(s *domain.Session, currentID string) *userpb.Session {
+	return &userpb.Session{
+		Id:         s.ID,
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		RememberMe: s.RememberMe,
+		Current:    currentID != "" && s.ID == currentID,
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+		LastUsedAt: timestamppb.New(s.LastUsedAt),
+		ExpiresAt:  timestamppb.New(s.ExpiresAt),
+	}
+}
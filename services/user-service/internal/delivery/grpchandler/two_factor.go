@@ -0,0 +1,68 @@
+package grpchandler
+
+import (
+	"context"
+
+	qrcode "github.com/skip2/go-qrcode"
+
+	apperrors "github.com/url-shortener-microservices/pkg/errors"
+	userpb "github.com/url-shortener-microservices/proto/gen/user"
+	"github.com/url-shortener-microservices/services/user-service/internal/application"
+)
+
+// qrCodeSize is the width of enrolment QR codes in pixels
+const qrCodeSize = 256
+
+// BeginTwoFactorSetup starts TOTP enrolment
+func (s *Server) BeginTwoFactorSetup(ctx context.Context, req *userpb.BeginTwoFactorSetupRequest) (*userpb.BeginTwoFactorSetupResponse, error) {
+	setup, err := s.service.BeginTwoFactorSetup(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	png, err := qrcode.Encode(setup.URI, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		return nil, toGRPCError(apperrors.Wrap(err, apperrors.CodeInternal, "failed to render QR code"))
+	}
+	return &userpb.BeginTwoFactorSetupResponse{
+		Status:     successStatus(),
+		Secret:     setup.Secret,
+		OtpauthUri: setup.URI,
+		QrCodePng:  png,
+	}, nil
+}
+
+// ConfirmTwoFactorSetup enables 2FA and returns the recovery codes
+func (s *Server) ConfirmTwoFactorSetup(ctx context.Context, req *userpb.ConfirmTwoFactorSetupRequest) (*userpb.ConfirmTwoFactorSetupResponse, error) {
+	codes, err := s.service.ConfirmTwoFactorSetup(ctx, req.GetUserId(), req.GetCode())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.ConfirmTwoFactorSetupResponse{Status: successStatus(), RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes
+func (s *Server) RegenerateRecoveryCodes(ctx context.Context, req *userpb.RegenerateRecoveryCodesRequest) (*userpb.RegenerateRecoveryCodesResponse, error) {
+	codes, err := s.service.RegenerateRecoveryCodes(ctx, application.TwoFactorReauthInput{
+		UserID:   req.GetUserId(),
+		Password: req.GetPassword(),
+		Code:     req.GetCode(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.RegenerateRecoveryCodesResponse{Status: successStatus(), RecoveryCodes: codes}, nil
+}
+
+// DisableTwoFactor turns 2FA off
+func (s *Server) DisableTwoFactor(ctx context.Context, req *userpb.DisableTwoFactorRequest) (*userpb.DisableTwoFactorResponse, error) {
+	err := s.service.DisableTwoFactor(ctx, application.TwoFactorReauthInput{
+		UserID:   req.GetUserId(),
+		Password: req.GetPassword(),
+		Code:     req.GetCode(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &userpb.DisableTwoFactorResponse{Status: successStatus()}, nil
+}
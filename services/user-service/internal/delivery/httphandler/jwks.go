@@ -0,0 +1,35 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/url-shortener-microservices/pkg/jwtauth"
+)
+
+// JWKSPath is where the access token verification keys are published
+const JWKSPath = "/.well-known/jwks.json"
+
+// jwksCacheControl lets verifiers and proxies cache the key set as long as
+// jwtauth.RemoteKeySet does by default
+const jwksCacheControl = "public, max-age=300"
+
+// NewRouter builds the HTTP handler. keys is empty when access tokens are
+// HS256 signed, verifiers then have nothing to fetch.
+func NewRouter(keys jwtauth.JWKS) (http.Handler, error) {
+	if keys.Keys == nil {
+		keys.Keys = []jwtauth.JWK{}
+	}
+	body, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+JWKSPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", jwksCacheControl)
+		w.Write(body)
+	})
+	return mux, nil
+}
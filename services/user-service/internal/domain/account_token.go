@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrAccountTokenNotFound is returned by AccountTokenRepository for unknown or used tokens
+var ErrAccountTokenNotFound = errors.New("account token not found")
+
+// Purposes of account tokens. A token only completes the flow it was issued for.
+const (
+	PurposeVerifyEmail   = "verify_email"
+	PurposeResetPassword = "reset_password"
+)
+
+// AccountToken is a single-use secret mailed to a user to prove they read the
+// address. Only its hash is stored.
+type AccountToken struct {
+	Hash      string // SHA-256 of the mailed token
+	UserID    string
+	Purpose   string
+	Email     string // Address the token was sent to
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// AccountTokenRepository persists account tokens
+type AccountTokenRepository interface {
+	// Create stores a new token
+	Create(ctx context.Context, t *AccountToken) error
+	// Consume marks the unused token of purpose with hash used at at and returns
+	// it, ErrAccountTokenNotFound if there is none. Expired tokens are returned
+	// too, the caller decides whether they still count.
+	Consume(ctx context.Context, purpose, hash string, at time.Time) (*AccountToken, error)
+	// CountSince counts a user's tokens of purpose created after since
+	CountSince(ctx context.Context, userID, purpose string, since time.Time) (int, error)
+	// RevokeAll marks a user's unused tokens of purpose used at at
+	RevokeAll(ctx context.Context, userID, purpose string, at time.Time) error
+}
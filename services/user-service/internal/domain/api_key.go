@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrAPIKeyNotFound is returned by APIKeyRepository for unknown or revoked keys
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+// Permissions an API key can grant
+const (
+	PermissionRead   = "read"
+	PermissionWrite  = "write"
+	PermissionDelete = "delete"
+)
+
+// APIKey grants programmatic access to a user's account. Only the hash of the
+// key is stored; the raw key is shown once when it is created.
+type APIKey struct {
+	ID          string // UUID, assigned by the repository
+	UserID      string
+	Name        string
+	KeyHash     string // SHA-256 of the raw key
+	KeyPrefix   string // Start of the raw key, shown so users can tell keys apart
+	Permissions []string
+	Scopes      []string
+	RateLimit   int64 // Requests per hour, 0 for the account default
+	UsageCount  int64
+	CreatedAt   time.Time
+	LastUsedAt  *time.Time
+	ExpiresAt   *time.Time // Nil for keys that do not expire
+	RevokedAt   *time.Time
+}
+
+// IsActive reports whether the key is accepted at now
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// APIKeyFilter selects a page of a user's API keys ordered by creation time
+type APIKeyFilter struct {
+	UserID    string
+	Desc      bool
+	After     *APIKeyKey // Keyset position; Offset is ignored when set
+	SkipCount bool       // The caller already knows the total
+	Offset    int
+	Limit     int
+}
+
+// APIKeyKey is a keyset position: the creation time of the last key seen and its ID
+type APIKeyKey struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// APIKeyRepository persists API keys
+type APIKeyRepository interface {
+	// Create stores a new key and fills in its ID
+	Create(ctx context.Context, k *APIKey) error
+	// List returns a page of a user's keys, revoked ones included, and the
+	// total number of keys unless filter.SkipCount is set
+	List(ctx context.Context, filter APIKeyFilter) ([]*APIKey, int64, error)
+	// Revoke revokes a user's key at at, returns ErrAPIKeyNotFound if the user
+	// has no such unrevoked key
+	Revoke(ctx context.Context, userID, id string, at time.Time) error
+}
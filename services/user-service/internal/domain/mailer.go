@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+// Message is an email with plain text and HTML alternatives
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer delivers emails
+type Mailer interface {
+	Send(ctx context.Context, m *Message) error
+}
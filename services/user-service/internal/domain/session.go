@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Errors returned by SessionRepository
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrTokenReused     = errors.New("refresh token already used")
+)
+
+// Reasons a session was revoked
+const (
+	RevokedByUser          = "user"
+	RevokedOnReuse         = "reuse"
+	RevokedOnPasswordReset = "password_reset"
+)
+
+// Session is a login and the family of refresh tokens rotated from it. Only
+// the newest token of a family is valid; presenting an older one means it was
+// copied, so the whole family is revoked.
+type Session struct {
+	ID           string // UUID, assigned by the repository
+	UserID       string
+	TokenID      string // ID of the current refresh token
+	UserAgent    string // Device of the latest login or refresh
+	IPAddress    string
+	RememberMe   bool
+	CreatedAt    time.Time
+	LastUsedAt   time.Time
+	ExpiresAt    time.Time // Absolute, rotation does not extend it
+	RevokedAt    *time.Time
+	RevokeReason string
+}
+
+// IsActive reports whether refresh tokens of the session are accepted at now
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionRepository persists sessions
+type SessionRepository interface {
+	// Create stores a new session and fills in its ID
+	Create(ctx context.Context, s *Session) error
+	// Get returns a session or ErrSessionNotFound
+	Get(ctx context.Context, id string) (*Session, error)
+	// ListActive returns a user's sessions active at now, most recently used first
+	ListActive(ctx context.Context, userID string, now time.Time) ([]*Session, error)
+	// Rotate stores the new TokenID and last use of s if its current token is
+	// still oldTokenID, returns ErrTokenReused if another rotation won and
+	// ErrSessionNotFound if the session was revoked
+	Rotate(ctx context.Context, s *Session, oldTokenID string) error
+	// Revoke revokes a user's session, returns ErrSessionNotFound if the user
+	// has no such active session
+	Revoke(ctx context.Context, userID, id, reason string, at time.Time) error
+	// RevokeAll revokes a user's active sessions except exceptID, returning how
+	// many were revoked
+	RevokeAll(ctx context.Context, userID, exceptID, reason string, at time.Time) (int, error)
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Errors returned by TokenIssuer.Parse
+var (
+	ErrTokenInvalid = errors.New("token is invalid")
+	ErrTokenExpired = errors.New("token has expired")
+)
+
+// Token types. Access tokens authorize requests, refresh tokens only obtain
+// new token pairs.
+const (
+	TokenAccess  = "access"
+	TokenRefresh = "refresh"
+)
+
+// TokenClaims are the contents of a token. Refresh tokens carry only the
+// subject and session, the remaining identity claims are set on access tokens.
+type TokenClaims struct {
+	ID            string // Unique token ID (jti)
+	Type          string
+	UserID        string
+	SessionID     string // Session the token belongs to
+	Email         string
+	Roles         []string
+	IsPremium     bool
+	EmailVerified bool
+	IssuedAt      time.Time
+	ExpiresAt     time.Time
+}
+
+// TokenIssuer signs and verifies tokens of each type
+type TokenIssuer interface {
+	// Issue signs a token carrying c
+	Issue(c *TokenClaims) (string, error)
+	// Parse verifies a token of typ, returns ErrTokenExpired or ErrTokenInvalid
+	Parse(token, typ string, now time.Time) (*TokenClaims, error)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Errors returned by TwoFactorRepository
+var (
+	ErrTwoFactorNotFound = errors.New("two-factor authentication not set up")
+	ErrTwoFactorEnabled  = errors.New("two-factor authentication already enabled")
+	ErrCodeUsed          = errors.New("code already used")
+)
+
+// TwoFactor is a user's TOTP enrolment. It is pending until a code from the
+// authenticator app confirms it, and only then required at login.
+type TwoFactor struct {
+	UserID        string
+	Secret        string     // TOTP secret sealed by a SecretCipher
+	RecoveryCodes []string   // SHA-256 hashes of the unused recovery codes
+	LastUsedStep  int64      // Time step of the last accepted code, older ones are replays
+	ConfirmedAt   *time.Time // Nil while pending
+	CreatedAt     time.Time
+}
+
+// Enabled reports whether the enrolment was confirmed
+func (t *TwoFactor) Enabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+// TwoFactorRepository persists TOTP enrolments
+type TwoFactorRepository interface {
+	// Get returns a user's enrolment or ErrTwoFactorNotFound
+	Get(ctx context.Context, userID string) (*TwoFactor, error)
+	// SavePending stores t, replacing a pending enrolment. It returns
+	// ErrTwoFactorEnabled when the user already has a confirmed one.
+	SavePending(ctx context.Context, t *TwoFactor) error
+	// Confirm enables a pending enrolment, ErrTwoFactorNotFound if there is none
+	Confirm(ctx context.Context, userID string, step int64, recoveryCodes []string, at time.Time) error
+	// UseStep records an accepted code, ErrCodeUsed unless step is newer than the last one
+	UseStep(ctx context.Context, userID string, step int64) error
+	// UseRecoveryCode removes a recovery code hash, ErrCodeUsed if it is not among the unused ones
+	UseRecoveryCode(ctx context.Context, userID, hash string) error
+	// SetRecoveryCodes replaces the recovery codes of an enabled enrolment
+	SetRecoveryCodes(ctx context.Context, userID string, hashes []string) error
+	// Delete removes the enrolment, ErrTwoFactorNotFound if there is none
+	Delete(ctx context.Context, userID string) error
+}
+
+// SecretCipher encrypts secrets stored at rest. Ciphertexts are bound to aad,
+// so one copied onto another row does not decrypt.
+type SecretCipher interface {
+	Seal(plaintext, aad []byte) (string, error)
+	Open(sealed string, aad []byte) ([]byte, error)
+}
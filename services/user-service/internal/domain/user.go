@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Errors returned by UserRepository
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrEmailTaken    = errors.New("email already registered")
+	ErrUsernameTaken = errors.New("username already taken")
+)
+
+// RoleUser is granted to every registered account
+const RoleUser = "user"
+
+// Orders accepted by UserRepository.List
+const (
+	UserSortCreatedAt = "created_at"
+	UserSortEmail     = "email"
+)
+
+// User is a registered account. Emails and usernames are stored lower case.
+type User struct {
+	ID                string // UUID, assigned by the repository
+	Email             string
+	Username          string // Optional, empty if unset
+	FullName          string
+	AvatarURL         string
+	PasswordHash      string // argon2id PHC string, or a legacy bcrypt hash
+	EmailVerified     bool
+	IsActive          bool
+	IsPremium         bool
+	PremiumExpires    *time.Time
+	Roles             []string
+	LastLogin         *time.Time
+	Settings          UserSettings
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	PasswordChangedAt *time.Time // Last reset, nil if the password is the one registered with
+}
+
+// UserSettings are preferences the user edits with their profile
+type UserSettings struct {
+	PublicProfile      bool   `json:"public_profile"`
+	AnalyticsEnabled   bool   `json:"analytics_enabled"`
+	EmailNotifications bool   `json:"email_notifications"`
+	Timezone           string `json:"timezone"`       // IANA name, e.g. Europe/Berlin
+	Language           string `json:"language"`       // BCP 47 tag, e.g. en or pt-BR
+	DefaultDomain      string `json:"default_domain"` // Branded short domain, empty for the default
+	RequireHTTPS       bool   `json:"require_https"`
+}
+
+// DefaultSettings are given to new accounts
+var DefaultSettings = UserSettings{
+	AnalyticsEnabled:   true,
+	EmailNotifications: true,
+	Timezone:           "UTC",
+	Language:           "en",
+}
+
+// HasPremium reports whether the premium subscription is in effect at now
+func (u *User) HasPremium(now time.Time) bool {
+	return u.IsPremium && (u.PremiumExpires == nil || now.Before(*u.PremiumExpires))
+}
+
+// UserFilter holds criteria for listing users
+type UserFilter struct {
+	Search      string    // Lower case substring of the email, username or full name
+	PremiumOnly bool      // Only users whose subscription is in effect at Now
+	ActiveOnly  bool      // Only enabled accounts
+	Now         time.Time // Reference time of PremiumOnly
+	SortBy      string    // One of the UserSort constants
+	Desc        bool
+	After       *UserKey // Keyset position; Offset is ignored when set
+	SkipCount   bool     // The caller already knows the total
+	Offset      int
+	Limit       int
+}
+
+// UserKey is a keyset position: the sort value of the last user seen and its ID
+type UserKey struct {
+	Value string
+	ID    string
+}
+
+// UserRepository persists user accounts
+type UserRepository interface {
+	// Create stores a new user and fills in its ID, returns ErrEmailTaken or
+	// ErrUsernameTaken if either is already registered
+	Create(ctx context.Context, u *User) error
+	// GetByID returns a user or ErrUserNotFound
+	GetByID(ctx context.Context, id string) (*User, error)
+	// GetByEmail returns a user by lower case email or ErrUserNotFound
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	// UpdatePasswordHash sets a new password and stamps it changed at
+	UpdatePasswordHash(ctx context.Context, id, hash string, at time.Time) error
+	// ReplacePasswordHash swaps the password hash if it still equals oldHash,
+	// returns ErrUserNotFound if the user is gone or their password changed
+	ReplacePasswordHash(ctx context.Context, id, oldHash, newHash string, at time.Time) error
+	// MarkEmailVerified records that the user proved they read their address
+	MarkEmailVerified(ctx context.Context, id string, at time.Time) error
+	// UpdateProfile stores the username, full name, avatar and settings of an
+	// existing user, returns ErrUsernameTaken if the username is in use
+	UpdateProfile(ctx context.Context, u *User) error
+	// RecordLogin stamps a successful login
+	RecordLogin(ctx context.Context, id string, at time.Time) error
+	// List returns a page of users matching filter and, unless skipped or
+	// paging by key, the number of matches
+	List(ctx context.Context, filter UserFilter) ([]*User, int64, error)
+	// Ping checks the storage is reachable
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,88 @@
+// Package mailer delivers account emails over SMTP or into a local outbox
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// compose renders m as a multipart/alternative MIME message. Addresses are
+// parsed, so header injection through them is not possible.
+func compose(from *mail.Address, m *domain.Message, date time.Time) (to *mail.Address, msg []byte, err error) {
+	to, err = mail.ParseAddress(m.To)
+	if err != nil {
+		return nil, nil, fmt.Errorf("recipient %q: %w", m.To, err)
+	}
+	id, err := messageID(from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	parts := multipart.NewWriter(&buf)
+	header := []struct{ key, value string }{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", m.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"Message-ID", id},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", `multipart/alternative; boundary="` + parts.Boundary() + `"`},
+	}
+	for _, h := range header {
+		fmt.Fprintf(&buf, "%s: %s\r\n", h.key, h.value)
+	}
+	buf.WriteString("\r\n")
+
+	// Clients show the last alternative they support, so HTML goes last
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, nil, err
+	}
+	return to, buf.Bytes(), nil
+}
+
+// messageID returns a unique Message-ID in the sender's domain
+func messageID(from *mail.Address) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate message id: %w", err)
+	}
+	host := "localhost"
+	if i := strings.LastIndexByte(from.Address, '@'); i >= 0 {
+		host = from.Address[i+1:]
+	}
+	return "<" + hex.EncodeToString(b) + "@" + host + ">", nil
+}
@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// Outbox implements domain.Mailer by writing each message to an .eml file in a
+// directory, for local development and tests. Files are readable by the owner
+// only as they hold account links.
+type Outbox struct {
+	dir  string
+	from *mail.Address
+	now  func() time.Time
+}
+
+// NewOutbox creates an outbox in dir, creating the directory if needed
+func NewOutbox(dir, from string) (*Outbox, error) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("sender %q: %w", from, err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create outbox: %w", err)
+	}
+	return &Outbox{dir: dir, from: addr, now: time.Now}, nil
+}
+
+// Send implements domain.Mailer. File names sort by the time messages were sent.
+func (o *Outbox) Send(_ context.Context, m *domain.Message) error {
+	now := o.now()
+	_, msg, err := compose(o.from, m, now)
+	if err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("name outbox file: %w", err)
+	}
+	name := now.UTC().Format("20060102T150405.000000000Z") + "-" + hex.EncodeToString(suffix) + ".eml"
+	if err := os.WriteFile(filepath.Join(o.dir, name), msg, 0o600); err != nil {
+		return fmt.Errorf("write outbox file: %w", err)
+	}
+	return nil
+}
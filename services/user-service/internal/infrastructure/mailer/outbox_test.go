@@ -0,0 +1,70 @@
+package mailer
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+func TestOutbox_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	o, err := NewOutbox(dir, "URL Shortener <no-reply@example.com>")
+	require.NoError(t, err)
+
+	err = o.Send(context.Background(), &domain.Message{
+		To:      "ada@example.com",
+		Subject: "Réinitialisez\r\nBcc: eve@example.com",
+		Text:    "Open https://example.com/reset?token=abc",
+		HTML:    `<a href="https://example.com/reset?token=abc">Reset</a>`,
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+
+	msg, err := mail.ReadMessage(f)
+	require.NoError(t, err)
+	assert.Equal(t, `"URL Shortener" <no-reply@example.com>`, msg.Header.Get("From"))
+	assert.Equal(t, "<ada@example.com>", msg.Header.Get("To"))
+	assert.Empty(t, msg.Header.Get("Bcc"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Réinitialisez\r\nBcc: eve@example.com", subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	var bodies []string
+	for {
+		p, err := parts.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(quotedprintable.NewReader(p))
+		require.NoError(t, err)
+		bodies = append(bodies, p.Header.Get("Content-Type")+" "+string(body))
+	}
+	assert.Equal(t, []string{
+		"text/plain; charset=utf-8 Open https://example.com/reset?token=abc",
+		`text/html; charset=utf-8 <a href="https://example.com/reset?token=abc">Reset</a>`,
+	}, bodies)
+
+	assert.Error(t, o.Send(context.Background(), &domain.Message{To: "ada@example.com\r\nBcc: eve@example.com"}))
+}
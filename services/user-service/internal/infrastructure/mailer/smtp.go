@@ -0,0 +1,90 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// SMTPOptions configures an SMTP relay
+type SMTPOptions struct {
+	Host     string
+	Port     int
+	Username string // Empty disables authentication
+	Password string
+	From     string // Sender address, optionally with a display name
+}
+
+// SMTP implements domain.Mailer with an SMTP relay. STARTTLS is used whenever
+// the server offers it, and credentials are only sent over TLS or to localhost.
+type SMTP struct {
+	opts SMTPOptions
+	from *mail.Address
+}
+
+// NewSMTP creates an SMTP mailer
+func NewSMTP(opts SMTPOptions) (*SMTP, error) {
+	from, err := mail.ParseAddress(opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("sender %q: %w", opts.From, err)
+	}
+	return &SMTP{opts: opts, from: from}, nil
+}
+
+// Send implements domain.Mailer
+func (s *SMTP) Send(ctx context.Context, m *domain.Message) error {
+	to, msg, err := compose(s.from, m, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.opts.Host, strconv.Itoa(s.opts.Port)))
+	if err != nil {
+		return fmt.Errorf("dial smtp: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, s.opts.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.opts.Host}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if s.opts.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.opts.Username, s.opts.Password, s.opts.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// AccountTokenRepository implements domain.AccountTokenRepository on PostgreSQL
+type AccountTokenRepository struct {
+	db *sql.DB
+}
+
+// NewAccountTokenRepository creates a new PostgreSQL account token repository
+func NewAccountTokenRepository(db *sql.DB) *AccountTokenRepository {
+	return &AccountTokenRepository{db: db}
+}
+
+// Create implements domain.AccountTokenRepository
+func (r *AccountTokenRepository) Create(ctx context.Context, t *domain.AccountToken) error {
+	query := `INSERT INTO account_tokens (token_hash, user_id, purpose, email, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := r.db.ExecContext(ctx, query, t.Hash, t.UserID, t.Purpose, t.Email, t.CreatedAt, t.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("insert account token: %w", err)
+	}
+	return nil
+}
+
+// Consume implements domain.AccountTokenRepository
+func (r *AccountTokenRepository) Consume(ctx context.Context, purpose, hash string, at time.Time) (*domain.AccountToken, error) {
+	// Marking and returning in one statement, two requests racing with the same token cannot both win
+	query := `UPDATE account_tokens SET used_at = $3
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL
+		RETURNING token_hash, user_id, purpose, email, created_at, expires_at, used_at`
+
+	var t domain.AccountToken
+	err := r.db.QueryRowContext(ctx, query, hash, purpose, at).Scan(
+		&t.Hash, &t.UserID, &t.Purpose, &t.Email, &t.CreatedAt, &t.ExpiresAt, &t.UsedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAccountTokenNotFound
+		}
+		return nil, fmt.Errorf("consume account token: %w", err)
+	}
+	return &t, nil
+}
+
+// CountSince implements domain.AccountTokenRepository
+func (r *AccountTokenRepository) CountSince(ctx context.Context, userID, purpose string, since time.Time) (int, error) {
+	if !uuidPattern.MatchString(userID) {
+		return 0, nil
+	}
+	query := `SELECT COUNT(*) FROM account_tokens WHERE user_id = $1 AND purpose = $2 AND created_at > $3`
+
+	var n int
+	if err := r.db.QueryRowContext(ctx, query, userID, purpose, since).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count account tokens: %w", err)
+	}
+	return n, nil
+}
+
+// RevokeAll implements domain.AccountTokenRepository
+func (r *AccountTokenRepository) RevokeAll(ctx context.Context, userID, purpose string, at time.Time) error {
+	if !uuidPattern.MatchString(userID) {
+		return nil
+	}
+	query := `UPDATE account_tokens SET used_at = $3 WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, purpose, at); err != nil {
+		return fmt.Errorf("revoke account tokens: %w", err)
+	}
+	return nil
+}
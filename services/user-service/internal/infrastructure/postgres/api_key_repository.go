@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const apiKeyColumns = `id, user_id, name, key_hash, key_prefix, permissions, scopes, rate_limit, usage_count,
+	created_at, last_used_at, expires_at, revoked_at`
+
+// APIKeyRepository implements domain.APIKeyRepository on PostgreSQL
+type APIKeyRepository struct {
+	db *sql.DB
+}
+
+// NewAPIKeyRepository creates a new PostgreSQL API key repository
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+// Create implements domain.APIKeyRepository
+func (r *APIKeyRepository) Create(ctx context.Context, k *domain.APIKey) error {
+	query := `INSERT INTO api_keys (user_id, name, key_hash, key_prefix, permissions, scopes, rate_limit, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id`
+
+	err := r.db.QueryRowContext(ctx, query,
+		k.UserID, k.Name, k.KeyHash, k.KeyPrefix, pq.Array(k.Permissions), pq.Array(k.Scopes), k.RateLimit,
+		k.CreatedAt, k.ExpiresAt,
+	).Scan(&k.ID)
+	if err != nil {
+		return fmt.Errorf("insert api key: %w", err)
+	}
+	return nil
+}
+
+// List implements domain.APIKeyRepository. Keys are ordered by creation time
+// and ID, so keyset pages are stable while keys are added.
+func (r *APIKeyRepository) List(ctx context.Context, filter domain.APIKeyFilter) ([]*domain.APIKey, int64, error) {
+	if !uuidPattern.MatchString(filter.UserID) {
+		return nil, 0, nil
+	}
+	args := []interface{}{filter.UserID}
+	conds := []string{`user_id = $1`}
+
+	dir, cmp := ` ASC`, `>`
+	if filter.Desc {
+		dir, cmp = ` DESC`, `<`
+	}
+	offset := filter.Offset
+	if filter.After != nil {
+		if !uuidPattern.MatchString(filter.After.ID) {
+			return nil, 0, fmt.Errorf("keyset id %q is not an api key id", filter.After.ID)
+		}
+		args = append(args, filter.After.CreatedAt, filter.After.ID)
+		conds = append(conds, fmt.Sprintf(`(created_at, id) %s ($%d, $%d::uuid)`, cmp, len(args)-1, len(args)))
+		offset = 0
+	}
+
+	count := !filter.SkipCount && filter.After == nil
+	columns := apiKeyColumns
+	if count {
+		columns += `, COUNT(*) OVER ()`
+	}
+
+	args = append(args, filter.Limit, offset)
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+columns+` FROM api_keys`+whereClause(conds)+
+			` ORDER BY created_at`+dir+`, id`+dir+
+			fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var total int64
+	var extra []interface{}
+	if count {
+		extra = append(extra, &total)
+	}
+	keys := make([]*domain.APIKey, 0, filter.Limit)
+	for rows.Next() {
+		k, err := scanAPIKey(rows, extra...)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scan api key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate api keys: %w", err)
+	}
+
+	// A page past the end has no rows to carry the total
+	if count && len(keys) == 0 && offset > 0 {
+		if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM api_keys WHERE user_id = $1`, filter.UserID).Scan(&total); err != nil {
+			return nil, 0, fmt.Errorf("count api keys: %w", err)
+		}
+	}
+	return keys, total, nil
+}
+
+// Revoke implements domain.APIKeyRepository
+func (r *APIKeyRepository) Revoke(ctx context.Context, userID, id string, at time.Time) error {
+	if !uuidPattern.MatchString(userID) || !uuidPattern.MatchString(id) {
+		return domain.ErrAPIKeyNotFound
+	}
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE api_keys SET revoked_at = $3 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`,
+		id, userID, at)
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// scanAPIKey reads apiKeyColumns followed by any extra columns into extra
+func scanAPIKey(row rowScanner, extra ...interface{}) (*domain.APIKey, error) {
+	var (
+		k                                domain.APIKey
+		lastUsedAt, expiresAt, revokedAt sql.NullTime
+	)
+	dest := []interface{}{&k.ID, &k.UserID, &k.Name, &k.KeyHash, &k.KeyPrefix, pq.Array(&k.Permissions),
+		pq.Array(&k.Scopes), &k.RateLimit, &k.UsageCount, &k.CreatedAt, &lastUsedAt, &expiresAt, &revokedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if lastUsedAt.Valid {
+		k.LastUsedAt = &lastUsedAt.Time
+	}
+	if expiresAt.Valid {
+		k.ExpiresAt = &expiresAt.Time
+	}
+	if revokedAt.Valid {
+		k.RevokedAt = &revokedAt.Time
+	}
+	return &k, nil
+}
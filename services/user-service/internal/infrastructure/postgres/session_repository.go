@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const sessionColumns = `id, user_id, token_id, user_agent, ip_address, remember_me, created_at, last_used_at,
+	expires_at, revoked_at, revoke_reason`
+
+// SessionRepository implements domain.SessionRepository on PostgreSQL
+type SessionRepository struct {
+	db *sql.DB
+}
+
+// NewSessionRepository creates a new PostgreSQL session repository
+func NewSessionRepository(db *sql.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+// Create implements domain.SessionRepository
+func (r *SessionRepository) Create(ctx context.Context, s *domain.Session) error {
+	query := `INSERT INTO sessions (user_id, token_id, user_agent, ip_address, remember_me, created_at, last_used_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`
+
+	err := r.db.QueryRowContext(ctx, query,
+		s.UserID, s.TokenID, s.UserAgent, s.IPAddress, s.RememberMe, s.CreatedAt, s.LastUsedAt, s.ExpiresAt,
+	).Scan(&s.ID)
+	if err != nil {
+		return fmt.Errorf("insert session: %w", err)
+	}
+	return nil
+}
+
+// Get implements domain.SessionRepository
+func (r *SessionRepository) Get(ctx context.Context, id string) (*domain.Session, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, domain.ErrSessionNotFound
+	}
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE id = $1`
+
+	s, err := scanSession(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("select session: %w", err)
+	}
+	return s, nil
+}
+
+// ListActive implements domain.SessionRepository
+func (r *SessionRepository) ListActive(ctx context.Context, userID string, now time.Time) ([]*domain.Session, error) {
+	if !uuidPattern.MatchString(userID) {
+		return nil, nil
+	}
+	query := `SELECT ` + sessionColumns + ` FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+		ORDER BY last_used_at DESC, id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan session: %w", err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Rotate implements domain.SessionRepository. The token comparison in the
+// update makes concurrent rotations of the same token race for a single row
+// version, so only one of them succeeds.
+func (r *SessionRepository) Rotate(ctx context.Context, s *domain.Session, oldTokenID string) error {
+	query := `UPDATE sessions SET token_id = $3, last_used_at = $4, user_agent = $5, ip_address = $6
+		WHERE id = $1 AND token_id = $2 AND revoked_at IS NULL`
+
+	res, err := r.db.ExecContext(ctx, query, s.ID, oldTokenID, s.TokenID, s.LastUsedAt, s.UserAgent, s.IPAddress)
+	if err != nil {
+		return fmt.Errorf("rotate session: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	// Tell a lost race from a revocation
+	var revoked bool
+	err = r.db.QueryRowContext(ctx, `SELECT revoked_at IS NOT NULL FROM sessions WHERE id = $1`, s.ID).Scan(&revoked)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && revoked) {
+		return domain.ErrSessionNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("select session: %w", err)
+	}
+	return domain.ErrTokenReused
+}
+
+// Revoke implements domain.SessionRepository
+func (r *SessionRepository) Revoke(ctx context.Context, userID, id, reason string, at time.Time) error {
+	if !uuidPattern.MatchString(userID) || !uuidPattern.MatchString(id) {
+		return domain.ErrSessionNotFound
+	}
+	query := `UPDATE sessions SET revoked_at = $3, revoke_reason = $4
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > $3`
+
+	res, err := r.db.ExecContext(ctx, query, id, userID, at, reason)
+	if err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrSessionNotFound
+	}
+	return nil
+}
+
+// RevokeAll implements domain.SessionRepository
+func (r *SessionRepository) RevokeAll(ctx context.Context, userID, exceptID, reason string, at time.Time) (int, error) {
+	if !uuidPattern.MatchString(userID) {
+		return 0, nil
+	}
+	query := `UPDATE sessions SET revoked_at = $3, revoke_reason = $4
+		WHERE user_id = $1 AND id::text <> $2 AND revoked_at IS NULL AND expires_at > $3`
+
+	res, err := r.db.ExecContext(ctx, query, userID, exceptID, at, reason)
+	if err != nil {
+		return 0, fmt.Errorf("revoke sessions: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+	return int(n), nil
+}
+
+func scanSession(row rowScanner) (*domain.Session, error) {
+	var (
+		s         domain.Session
+		revokedAt sql.NullTime
+	)
+	err := row.Scan(&s.ID, &s.UserID, &s.TokenID, &s.UserAgent, &s.IPAddress, &s.RememberMe, &s.CreatedAt,
+		&s.LastUsedAt, &s.ExpiresAt, &revokedAt, &s.RevokeReason)
+	if err != nil {
+		return nil, err
+	}
+	if revokedAt.Valid {
+		s.RevokedAt = &revokedAt.Time
+	}
+	return &s, nil
+}
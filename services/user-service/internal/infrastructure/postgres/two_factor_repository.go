@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// TwoFactorRepository implements domain.TwoFactorRepository on PostgreSQL
+type TwoFactorRepository struct {
+	db *sql.DB
+}
+
+// NewTwoFactorRepository creates a new PostgreSQL two-factor repository
+func NewTwoFactorRepository(db *sql.DB) *TwoFactorRepository {
+	return &TwoFactorRepository{db: db}
+}
+
+// Get implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) Get(ctx context.Context, userID string) (*domain.TwoFactor, error) {
+	if !uuidPattern.MatchString(userID) {
+		return nil, domain.ErrTwoFactorNotFound
+	}
+	query := `SELECT user_id, secret, recovery_codes, last_used_step, confirmed_at, created_at
+		FROM two_factor WHERE user_id = $1`
+
+	var (
+		t           domain.TwoFactor
+		confirmedAt sql.NullTime
+	)
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&t.UserID, &t.Secret, pq.Array(&t.RecoveryCodes), &t.LastUsedStep, &confirmedAt, &t.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTwoFactorNotFound
+		}
+		return nil, fmt.Errorf("select two-factor: %w", err)
+	}
+	if confirmedAt.Valid {
+		t.ConfirmedAt = &confirmedAt.Time
+	}
+	return &t, nil
+}
+
+// SavePending implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) SavePending(ctx context.Context, t *domain.TwoFactor) error {
+	// The conflict update skips confirmed rows, so no row is returned for them
+	query := `INSERT INTO two_factor (user_id, secret, recovery_codes, last_used_step, created_at)
+		VALUES ($1, $2, '{}', 0, $3)
+		ON CONFLICT (user_id) DO UPDATE
+			SET secret = EXCLUDED.secret, recovery_codes = '{}', last_used_step = 0, created_at = EXCLUDED.created_at
+			WHERE two_factor.confirmed_at IS NULL
+		RETURNING user_id`
+
+	var id string
+	err := r.db.QueryRowContext(ctx, query, t.UserID, t.Secret, t.CreatedAt).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrTwoFactorEnabled
+	}
+	if err != nil {
+		return fmt.Errorf("save two-factor: %w", err)
+	}
+	return nil
+}
+
+// Confirm implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) Confirm(ctx context.Context, userID string, step int64, recoveryCodes []string, at time.Time) error {
+	query := `UPDATE two_factor SET confirmed_at = $2, last_used_step = $3, recovery_codes = $4
+		WHERE user_id = $1 AND confirmed_at IS NULL`
+	return r.exec(ctx, domain.ErrTwoFactorNotFound, query, userID, at, step, pq.Array(recoveryCodes))
+}
+
+// UseStep implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) UseStep(ctx context.Context, userID string, step int64) error {
+	// Compare and set, two logins racing with the same code cannot both pass
+	query := `UPDATE two_factor SET last_used_step = $2 WHERE user_id = $1 AND last_used_step < $2`
+	return r.exec(ctx, domain.ErrCodeUsed, query, userID, step)
+}
+
+// UseRecoveryCode implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userID, hash string) error {
+	query := `UPDATE two_factor SET recovery_codes = array_remove(recovery_codes, $2)
+		WHERE user_id = $1 AND $2 = ANY (recovery_codes)`
+	return r.exec(ctx, domain.ErrCodeUsed, query, userID, hash)
+}
+
+// SetRecoveryCodes implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) SetRecoveryCodes(ctx context.Context, userID string, hashes []string) error {
+	query := `UPDATE two_factor SET recovery_codes = $2 WHERE user_id = $1 AND confirmed_at IS NOT NULL`
+	return r.exec(ctx, domain.ErrTwoFactorNotFound, query, userID, pq.Array(hashes))
+}
+
+// Delete implements domain.TwoFactorRepository
+func (r *TwoFactorRepository) Delete(ctx context.Context, userID string) error {
+	query := `DELETE FROM two_factor WHERE user_id = $1`
+	return r.exec(ctx, domain.ErrTwoFactorNotFound, query, userID)
+}
+
+// exec runs a statement on the row of userID, passed as $1, returning notFound
+// if none matched
+func (r *TwoFactorRepository) exec(ctx context.Context, notFound error, query, userID string, args ...interface{}) error {
+	if !uuidPattern.MatchString(userID) {
+		return notFound
+	}
+	res, err := r.db.ExecContext(ctx, query, append([]interface{}{userID}, args...)...)
+	if err != nil {
+		return fmt.Errorf("update two-factor: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return notFound
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/url-shortener-microservices/pkg/database"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+const userColumns = `id, email, COALESCE(username, ''), full_name, avatar_url, password_hash, email_verified,
+	is_active, is_premium, premium_expires, roles, last_login, settings, created_at, updated_at, password_changed_at`
+
+// Unique indexes, told apart to report which value is taken
+const (
+	emailIndex    = "idx_users_email"
+	usernameIndex = "idx_users_username"
+)
+
+// uuidPattern matches the text form of user IDs. Other IDs cannot exist, and
+// Postgres rejects them with an error instead of finding no rows.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// UserRepository implements domain.UserRepository on PostgreSQL
+type UserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository creates a new PostgreSQL user repository
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// Create implements domain.UserRepository
+func (r *UserRepository) Create(ctx context.Context, u *domain.User) error {
+	query := `INSERT INTO users (email, username, full_name, avatar_url, password_hash, email_verified,
+			is_active, is_premium, premium_expires, roles, settings, created_at, updated_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id`
+
+	err := r.db.QueryRowContext(ctx, query,
+		u.Email, u.Username, u.FullName, u.AvatarURL, u.PasswordHash, u.EmailVerified,
+		u.IsActive, u.IsPremium, u.PremiumExpires, pq.Array(u.Roles), settingsJSON(u.Settings), u.CreatedAt, u.UpdatedAt,
+	).Scan(&u.ID)
+	if err != nil {
+		if taken := takenError(err); taken != nil {
+			return taken
+		}
+		return fmt.Errorf("insert user: %w", err)
+	}
+	return nil
+}
+
+// GetByID implements domain.UserRepository
+func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
+	if !uuidPattern.MatchString(id) {
+		return nil, domain.ErrUserNotFound
+	}
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	return r.get(ctx, query, id)
+}
+
+// GetByEmail implements domain.UserRepository
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+	return r.get(ctx, query, email)
+}
+
+func (r *UserRepository) get(ctx context.Context, query string, args ...interface{}) (*domain.User, error) {
+	u, err := scanUser(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("select user: %w", err)
+	}
+	return u, nil
+}
+
+// UpdatePasswordHash implements domain.UserRepository
+func (r *UserRepository) UpdatePasswordHash(ctx context.Context, id, hash string, at time.Time) error {
+	query := `UPDATE users SET password_hash = $2, password_changed_at = $3, updated_at = $3 WHERE id = $1`
+	return r.exec(ctx, query, id, hash, at)
+}
+
+// ReplacePasswordHash implements domain.UserRepository
+func (r *UserRepository) ReplacePasswordHash(ctx context.Context, id, oldHash, newHash string, at time.Time) error {
+	query := `UPDATE users SET password_hash = $3, updated_at = $4 WHERE id = $1 AND password_hash = $2`
+	return r.exec(ctx, query, id, oldHash, newHash, at)
+}
+
+// UpdateProfile implements domain.UserRepository
+func (r *UserRepository) UpdateProfile(ctx context.Context, u *domain.User) error {
+	query := `UPDATE users SET username = NULLIF($2, ''), full_name = $3, avatar_url = $4, settings = $5, updated_at = $6
+		WHERE id = $1`
+	err := r.exec(ctx, query, u.ID, u.Username, u.FullName, u.AvatarURL, settingsJSON(u.Settings), u.UpdatedAt)
+	if taken := takenError(err); taken != nil {
+		return taken
+	}
+	return err
+}
+
+// MarkEmailVerified implements domain.UserRepository
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, id string, at time.Time) error {
+	query := `UPDATE users SET email_verified = TRUE, updated_at = $2 WHERE id = $1`
+	return r.exec(ctx, query, id, at)
+}
+
+// RecordLogin implements domain.UserRepository
+func (r *UserRepository) RecordLogin(ctx context.Context, id string, at time.Time) error {
+	query := `UPDATE users SET last_login = $2 WHERE id = $1`
+	return r.exec(ctx, query, id, at)
+}
+
+// List implements domain.UserRepository
+func (r *UserRepository) List(ctx context.Context, filter domain.UserFilter) ([]*domain.User, int64, error) {
+	var (
+		args  []interface{}
+		conds []string
+	)
+	if filter.Search != "" {
+		// strpos matches the text literally, unlike LIKE patterns
+		args = append(args, filter.Search)
+		conds = append(conds, fmt.Sprintf(
+			`(strpos(email, $%[1]d) > 0 OR strpos(COALESCE(username, ''), $%[1]d) > 0 OR strpos(lower(full_name), $%[1]d) > 0)`,
+			len(args)))
+	}
+	if filter.ActiveOnly {
+		conds = append(conds, `is_active`)
+	}
+	if filter.PremiumOnly {
+		args = append(args, filter.Now)
+		conds = append(conds, fmt.Sprintf(`is_premium AND (premium_expires IS NULL OR premium_expires > $%d)`, len(args)))
+	}
+	countWhere, countArgs := whereClause(conds), len(args)
+
+	offset := filter.Offset
+	if filter.After != nil {
+		clause, err := afterUser(filter, &args)
+		if err != nil {
+			return nil, 0, err
+		}
+		conds = append(conds, clause)
+		offset = 0
+	}
+
+	count := !filter.SkipCount && filter.After == nil
+	columns := userColumns
+	if count {
+		columns += `, COUNT(*) OVER ()`
+	}
+
+	args = append(args, filter.Limit, offset)
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+columns+` FROM users`+whereClause(conds)+
+			` ORDER BY `+userOrderBy(filter)+
+			fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list users: %w", err)
+	}
+	defer rows.Close()
+
+	var total int64
+	var extra []interface{}
+	if count {
+		extra = append(extra, &total)
+	}
+	users := make([]*domain.User, 0, filter.Limit)
+	for rows.Next() {
+		u, err := scanUser(rows, extra...)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate users: %w", err)
+	}
+
+	// A page past the end has no rows to carry the total
+	if count && len(users) == 0 && offset > 0 {
+		if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users`+countWhere, args[:countArgs]...).Scan(&total); err != nil {
+			return nil, 0, fmt.Errorf("count users: %w", err)
+		}
+	}
+	return users, total, nil
+}
+
+// userOrderBy builds the ORDER BY clause, ending with the ID so keys are unique
+func userOrderBy(filter domain.UserFilter) string {
+	dir := ` ASC`
+	if filter.Desc {
+		dir = ` DESC`
+	}
+	expr := `created_at`
+	if filter.SortBy == domain.UserSortEmail {
+		expr = `email`
+	}
+	return expr + dir + `, id` + dir
+}
+
+// afterUser builds the condition selecting users past filter.After
+func afterUser(filter domain.UserFilter, args *[]interface{}) (string, error) {
+	cmp := `>`
+	if filter.Desc {
+		cmp = `<`
+	}
+
+	var expr, param string
+	switch filter.SortBy {
+	case domain.UserSortEmail:
+		expr, param = `email`, `$%d`
+	case domain.UserSortCreatedAt, "":
+		expr, param = `created_at`, `$%d::timestamptz`
+	default:
+		return "", fmt.Errorf("keyset pagination is not supported for sort %q", filter.SortBy)
+	}
+	if !uuidPattern.MatchString(filter.After.ID) {
+		return "", fmt.Errorf("keyset id %q is not a user id", filter.After.ID)
+	}
+
+	*args = append(*args, filter.After.Value, filter.After.ID)
+	return fmt.Sprintf(`(%s, id) %s (`+param+`, $%d::uuid)`, expr, cmp, len(*args)-1, len(*args)), nil
+}
+
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return ` WHERE ` + strings.Join(conds, ` AND `)
+}
+
+// takenError maps a unique violation to the domain error naming the taken value, nil for other errors
+func takenError(err error) error {
+	switch database.UniqueViolationConstraint(err) {
+	case emailIndex:
+		return domain.ErrEmailTaken
+	case usernameIndex:
+		return domain.ErrUsernameTaken
+	}
+	return nil
+}
+
+// settingsJSON encodes the settings column
+func settingsJSON(settings domain.UserSettings) string {
+	// Settings hold only strings and booleans, which always encode
+	data, _ := json.Marshal(settings)
+	return string(data)
+}
+
+// exec runs an update of a single user, returns ErrUserNotFound if none matched
+func (r *UserRepository) exec(ctx context.Context, query string, args ...interface{}) error {
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+// Ping implements domain.UserRepository
+func (r *UserRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUser reads userColumns followed by any extra columns into extra
+func scanUser(row rowScanner, extra ...interface{}) (*domain.User, error) {
+	var (
+		u                 domain.User
+		premiumExpires    sql.NullTime
+		lastLogin         sql.NullTime
+		passwordChangedAt sql.NullTime
+		settings          []byte
+	)
+	dest := []interface{}{&u.ID, &u.Email, &u.Username, &u.FullName, &u.AvatarURL, &u.PasswordHash, &u.EmailVerified,
+		&u.IsActive, &u.IsPremium, &premiumExpires, pq.Array(&u.Roles), &lastLogin, &settings, &u.CreatedAt, &u.UpdatedAt,
+		&passwordChangedAt}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	if premiumExpires.Valid {
+		u.PremiumExpires = &premiumExpires.Time
+	}
+	if lastLogin.Valid {
+		u.LastLogin = &lastLogin.Time
+	}
+	if passwordChangedAt.Valid {
+		u.PasswordChangedAt = &passwordChangedAt.Time
+	}
+	if err := json.Unmarshal(settings, &u.Settings); err != nil {
+		return nil, fmt.Errorf("decode settings: %w", err)
+	}
+	return &u, nil
+}
@@ -0,0 +1,63 @@
+// Package secretbox encrypts secrets stored at rest
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the AES-256 key length in bytes
+const KeySize = 32
+
+// ErrDecrypt is returned for ciphertexts that are malformed, tampered with or
+// sealed with another key or aad
+var ErrDecrypt = errors.New("cannot decrypt secret")
+
+// AESGCM implements domain.SecretCipher with AES-256-GCM. Sealed values are
+// the base64 encoded nonce followed by the ciphertext.
+type AESGCM struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCM creates a cipher from a KeySize byte key
+func NewAESGCM(key []byte) (*AESGCM, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes", KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AESGCM{aead: aead}, nil
+}
+
+// Seal implements domain.SecretCipher
+func (c *AESGCM) Seal(plaintext, aad []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(plaintext)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(c.aead.Seal(nonce, nonce, plaintext, aad)), nil
+}
+
+// Open implements domain.SecretCipher
+func (c *AESGCM) Open(sealed string, aad []byte) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < c.aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,39 @@
+package secretbox
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAESGCM_SealOpen(t *testing.T) {
+	c, err := NewAESGCM(bytes.Repeat([]byte{1}, KeySize))
+	require.NoError(t, err)
+
+	sealed, err := c.Seal([]byte("totp secret"), []byte("user-1"))
+	require.NoError(t, err)
+	again, err := c.Seal([]byte("totp secret"), []byte("user-1"))
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "nonces are random")
+
+	plain, err := c.Open(sealed, []byte("user-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "totp secret", string(plain))
+
+	other, err := NewAESGCM(bytes.Repeat([]byte{2}, KeySize))
+	require.NoError(t, err)
+	for name, open := range map[string]func() ([]byte, error){
+		"other user": func() ([]byte, error) { return c.Open(sealed, []byte("user-2")) },
+		"other key":  func() ([]byte, error) { return other.Open(sealed, []byte("user-1")) },
+		"truncated":  func() ([]byte, error) { return c.Open(sealed[:8], []byte("user-1")) },
+		"garbage":    func() ([]byte, error) { return c.Open("not base64!", []byte("user-1")) },
+	} {
+		_, err := open()
+		assert.ErrorIs(t, err, ErrDecrypt, name)
+	}
+
+	_, err = NewAESGCM([]byte("short"))
+	assert.Error(t, err)
+}
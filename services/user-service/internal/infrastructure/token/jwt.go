@@ -0,0 +1,142 @@
+// Package token signs and verifies JSON Web Tokens
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/url-shortener-microservices/pkg/jwtauth"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+// Options configures the issuer. Each token type has its own key, so a
+// refresh token never verifies as an access token even without the type claim.
+type Options struct {
+	AccessSecret  []byte           // HS256 access token secret, unused with SigningKeys
+	RefreshSecret []byte           // HS256 refresh token secret
+	SigningKeys   *jwtauth.KeyRing // Asymmetric access token keys, optional
+	Issuer        string
+	Audience      string
+}
+
+// JWT implements domain.TokenIssuer. Refresh tokens never leave the user
+// service and are HS256 signed; access tokens are signed with the key ring when
+// one is configured so other services can verify them with public keys only.
+type JWT struct {
+	opts     Options
+	verifier *jwtauth.Verifier
+}
+
+// NewJWT creates an issuer
+func NewJWT(opts Options) *JWT {
+	j := &JWT{opts: opts}
+	if opts.SigningKeys != nil {
+		j.verifier = jwtauth.NewVerifier(opts.SigningKeys, jwtauth.VerifierOptions{Issuer: opts.Issuer, Audience: opts.Audience})
+	}
+	return j
+}
+
+// Issue implements domain.TokenIssuer
+func (j *JWT) Issue(tc *domain.TokenClaims) (string, error) {
+	c := &jwtauth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tc.ID,
+			Issuer:    j.opts.Issuer,
+			Subject:   tc.UserID,
+			Audience:  jwt.ClaimStrings{j.opts.Audience},
+			IssuedAt:  jwt.NewNumericDate(tc.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(tc.ExpiresAt),
+		},
+		Type:          tc.Type,
+		SessionID:     tc.SessionID,
+		Email:         tc.Email,
+		Roles:         tc.Roles,
+		IsPremium:     tc.IsPremium,
+		EmailVerified: tc.EmailVerified,
+	}
+	if j.verifier != nil && tc.Type == domain.TokenAccess {
+		return j.opts.SigningKeys.Sign(c, tc.IssuedAt)
+	}
+
+	secret, err := j.secret(tc.Type)
+	if err != nil {
+		return "", err
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(secret)
+	if err != nil {
+		return "", fmt.Errorf("sign token: %w", err)
+	}
+	return signed, nil
+}
+
+// Parse implements domain.TokenIssuer
+func (j *JWT) Parse(token, typ string, now time.Time) (*domain.TokenClaims, error) {
+	if j.verifier != nil && typ == domain.TokenAccess {
+		c, err := j.verifier.VerifyAt(context.Background(), token, now)
+		switch {
+		case errors.Is(err, jwtauth.ErrExpiredToken):
+			return nil, domain.ErrTokenExpired
+		case err != nil:
+			return nil, fmt.Errorf("%w: %v", domain.ErrTokenInvalid, err)
+		}
+		return toDomain(c), nil
+	}
+
+	secret, err := j.secret(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	var c jwtauth.Claims
+	_, err = jwt.ParseWithClaims(token, &c, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(j.opts.Issuer),
+		jwt.WithAudience(j.opts.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, domain.ErrTokenExpired
+		}
+		return nil, fmt.Errorf("%w: %v", domain.ErrTokenInvalid, err)
+	}
+	if c.Type != typ || c.Subject == "" {
+		return nil, domain.ErrTokenInvalid
+	}
+	return toDomain(&c), nil
+}
+
+func toDomain(c *jwtauth.Claims) *domain.TokenClaims {
+	return &domain.TokenClaims{
+		ID:            c.ID,
+		Type:          c.Type,
+		UserID:        c.Subject,
+		SessionID:     c.SessionID,
+		Email:         c.Email,
+		Roles:         c.Roles,
+		IsPremium:     c.IsPremium,
+		EmailVerified: c.EmailVerified,
+		IssuedAt:      c.IssuedAt.Time,
+		ExpiresAt:     c.ExpiresAt.Time,
+	}
+}
+
+// secret returns the signing secret of a token type
+func (j *JWT) secret(typ string) ([]byte, error) {
+	switch typ {
+	case domain.TokenAccess:
+		return j.opts.AccessSecret, nil
+	case domain.TokenRefresh:
+		return j.opts.RefreshSecret, nil
+	default:
+		return nil, fmt.Errorf("unknown token type %q", typ)
+	}
+}
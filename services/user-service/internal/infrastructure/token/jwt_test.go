@@ -0,0 +1,147 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/url-shortener-microservices/pkg/jwtauth"
+	"github.com/url-shortener-microservices/services/user-service/internal/domain"
+)
+
+func testOptions() Options {
+	return Options{
+		AccessSecret:  []byte("access-secret-access-secret-1234"),
+		RefreshSecret: []byte("refresh-secret-refresh-secret-12"),
+		Issuer:        "url-shortener",
+		Audience:      "url-shortener-users",
+	}
+}
+
+func TestJWT_IssueParse(t *testing.T) {
+	j := NewJWT(testOptions())
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	want := domain.TokenClaims{
+		ID:            "token-1",
+		Type:          domain.TokenAccess,
+		UserID:        "user-1",
+		SessionID:     "session-1",
+		Email:         "ada@example.com",
+		Roles:         []string{domain.RoleUser},
+		IsPremium:     true,
+		EmailVerified: true,
+		IssuedAt:      now,
+		ExpiresAt:     now.Add(15 * time.Minute),
+	}
+	access, err := j.Issue(&want)
+	require.NoError(t, err)
+
+	claims, err := j.Parse(access, domain.TokenAccess, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, want.ID, claims.ID)
+	assert.Equal(t, want.UserID, claims.UserID)
+	assert.Equal(t, want.SessionID, claims.SessionID)
+	assert.Equal(t, want.Email, claims.Email)
+	assert.Equal(t, want.Roles, claims.Roles)
+	assert.True(t, claims.IsPremium)
+	assert.True(t, claims.EmailVerified)
+	assert.True(t, claims.ExpiresAt.Equal(want.ExpiresAt))
+
+	refresh, err := j.Issue(&domain.TokenClaims{
+		ID: "token-2", Type: domain.TokenRefresh, UserID: "user-1", SessionID: "session-1", IssuedAt: now, ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	claims, err = j.Parse(refresh, domain.TokenRefresh, now)
+	require.NoError(t, err)
+	assert.Equal(t, "session-1", claims.SessionID)
+
+	_, err = j.Parse(refresh, domain.TokenAccess, now)
+	assert.ErrorIs(t, err, domain.ErrTokenInvalid)
+	_, err = j.Parse(access, domain.TokenAccess, now.Add(16*time.Minute))
+	assert.ErrorIs(t, err, domain.ErrTokenExpired)
+}
+
+func TestJWT_Parse_Rejects(t *testing.T) {
+	opts := testOptions()
+	j := NewJWT(opts)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	other := opts
+	other.Audience = "someone-else"
+	foreign, err := NewJWT(other).Issue(&domain.TokenClaims{
+		ID: "token-1", Type: domain.TokenAccess, UserID: "user-1", IssuedAt: now, ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"sub": "user-1", "typ": domain.TokenAccess, "iss": opts.Issuer, "aud": opts.Audience,
+		"exp": now.Add(time.Hour).Unix(),
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{"audience": foreign, "alg none": unsigned, "garbage": "not.a.token"} {
+		_, err := j.Parse(token, domain.TokenAccess, now)
+		assert.ErrorIs(t, err, domain.ErrTokenInvalid, name)
+	}
+}
+
+func TestJWT_SigningKeys(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	_, oldKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keys, err := jwtauth.NewKeyRing([]jwtauth.SigningKey{
+		{ID: "2026-01", Key: oldKey},
+		{ID: "2026-03", Key: newKey, ActiveFrom: now.Add(time.Hour)},
+	})
+	require.NoError(t, err)
+
+	opts := testOptions()
+	opts.SigningKeys = keys
+	j := NewJWT(opts)
+	issue := func(typ string, at time.Time) string {
+		token, err := j.Issue(&domain.TokenClaims{
+			ID: "token-1", Type: typ, UserID: "user-1", SessionID: "session-1", IssuedAt: at, ExpiresAt: at.Add(15 * time.Minute),
+		})
+		require.NoError(t, err)
+		return token
+	}
+
+	// Access tokens name their key and verify with the published public key alone
+	verifier := jwtauth.NewVerifier(keys, jwtauth.VerifierOptions{Issuer: opts.Issuer, Audience: opts.Audience})
+	for at, kid := range map[time.Time]string{now: "2026-01", now.Add(time.Hour): "2026-03"} {
+		access := issue(domain.TokenAccess, at)
+		parsed, _, err := jwt.NewParser().ParseUnverified(access, &jwtauth.Claims{})
+		require.NoError(t, err)
+		assert.Equal(t, kid, parsed.Header["kid"])
+
+		claims, err := verifier.VerifyAt(context.Background(), access, at)
+		require.NoError(t, err)
+		assert.Equal(t, "session-1", claims.SessionID)
+		_, err = j.Parse(access, domain.TokenAccess, at)
+		require.NoError(t, err)
+	}
+
+	// Refresh tokens stay HS256 and never pass as access tokens
+	refresh := issue(domain.TokenRefresh, now)
+	_, err = j.Parse(refresh, domain.TokenRefresh, now)
+	require.NoError(t, err)
+	_, err = j.Parse(refresh, domain.TokenAccess, now)
+	assert.ErrorIs(t, err, domain.ErrTokenInvalid)
+
+	// HS256 access tokens are refused once signing keys are configured
+	legacy, err := NewJWT(testOptions()).Issue(&domain.TokenClaims{
+		ID: "token-1", Type: domain.TokenAccess, UserID: "user-1", IssuedAt: now, ExpiresAt: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	_, err = j.Parse(legacy, domain.TokenAccess, now)
+	assert.ErrorIs(t, err, domain.ErrTokenInvalid)
+	_, err = j.Parse(issue(domain.TokenAccess, now), domain.TokenAccess, now.Add(16*time.Minute))
+	assert.ErrorIs(t, err, domain.ErrTokenExpired)
+}
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS users (
+    id              UUID          PRIMARY KEY DEFAULT gen_random_uuid(),
+    email           VARCHAR(254)  NOT NULL,
+    username        VARCHAR(32),
+    full_name       TEXT          NOT NULL DEFAULT '',
+    avatar_url      TEXT          NOT NULL DEFAULT '',
+    password_hash   TEXT          NOT NULL,
+    email_verified  BOOLEAN       NOT NULL DEFAULT FALSE,
+    is_active       BOOLEAN       NOT NULL DEFAULT TRUE,
+    is_premium      BOOLEAN       NOT NULL DEFAULT FALSE,
+    premium_expires TIMESTAMPTZ,
+    roles           TEXT[]        NOT NULL DEFAULT '{user}',
+    last_login      TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+-- Emails and usernames are stored lower case by the service
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users (username) WHERE username IS NOT NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    id            UUID          PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id       UUID          NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token_id      VARCHAR(64)   NOT NULL,
+    user_agent    VARCHAR(512)  NOT NULL DEFAULT '',
+    ip_address    VARCHAR(45)   NOT NULL DEFAULT '',
+    remember_me   BOOLEAN       NOT NULL DEFAULT FALSE,
+    created_at    TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    last_used_at  TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    expires_at    TIMESTAMPTZ   NOT NULL,
+    revoked_at    TIMESTAMPTZ,
+    revoke_reason VARCHAR(16)   NOT NULL DEFAULT ''
+);
+
+-- Revoked sessions are kept until they expire so reused tokens are still recognized
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id_active ON sessions (user_id, last_used_at DESC) WHERE revoked_at IS NULL;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS two_factor (
+    user_id        UUID          PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret         TEXT          NOT NULL,              -- AES-GCM sealed TOTP secret
+    recovery_codes TEXT[]        NOT NULL DEFAULT '{}', -- SHA-256 hashes of unused recovery codes
+    last_used_step BIGINT        NOT NULL DEFAULT 0,
+    confirmed_at   TIMESTAMPTZ,                         -- NULL while enrolment is pending
+    created_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS account_tokens (
+    token_hash  CHAR(64)      PRIMARY KEY,          -- SHA-256 of the mailed token
+    user_id     UUID          NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose     VARCHAR(16)   NOT NULL,             -- verify_email or reset_password
+    email       VARCHAR(254)  NOT NULL,
+    created_at  TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ   NOT NULL,
+    used_at     TIMESTAMPTZ                         -- NULL until used or revoked
+);
+
+-- Used tokens are kept so the number mailed per window can be counted
+CREATE INDEX IF NOT EXISTS idx_account_tokens_user_purpose ON account_tokens (user_id, purpose, created_at DESC);
@@ -0,0 +1,2 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_settings_object;
+ALTER TABLE users DROP COLUMN IF EXISTS settings;
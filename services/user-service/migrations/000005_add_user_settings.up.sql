@@ -0,0 +1,4 @@
+-- Profile preferences edited through UpdateUser
+ALTER TABLE users ADD COLUMN IF NOT EXISTS settings JSONB NOT NULL
+    DEFAULT '{"analytics_enabled": true, "email_notifications": true, "timezone": "UTC", "language": "en"}';
+ALTER TABLE users ADD CONSTRAINT users_settings_object CHECK (jsonb_typeof(settings) = 'object');
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           UUID          PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID          NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name         VARCHAR(100)  NOT NULL,
+    key_hash     CHAR(64)      NOT NULL,          -- SHA-256 of the raw key
+    key_prefix   VARCHAR(16)   NOT NULL,
+    permissions  TEXT[]        NOT NULL DEFAULT '{}',
+    scopes       TEXT[]        NOT NULL DEFAULT '{}',
+    rate_limit   BIGINT        NOT NULL DEFAULT 0,
+    usage_count  BIGINT        NOT NULL DEFAULT 0,
+    created_at   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_key_hash ON api_keys (key_hash);
+
+-- Serves ListAPIKeys keyset pages in both directions
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_created ON api_keys (user_id, created_at, id);
@@ -0,0 +1,2 @@
+-- Sessions started before the password last changed are refused
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMPTZ;